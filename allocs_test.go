@@ -0,0 +1,86 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build !race
+// +build !race
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+// The allocation budgets of the hot paths, with a little headroom for the differences between the Go versions.
+// They are skipped with -race which allocates on its own. Lower a budget when an optimization lands.
+const (
+	entryUnmarshalAllocs   = 6
+	decodeJSONAllocs       = 8
+	newRequestAllocs       = 24
+	listFilesRequestAllocs = 28
+)
+
+func TestAllocs_EntryUnmarshal(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		var entry Entry
+		if err := json.Unmarshal(benchmarkEntry, &entry); err != nil {
+			t.Fatal(err)
+		}
+	})
+	testAllocs(t, allocs, entryUnmarshalAllocs)
+}
+
+func TestAllocs_DecodeJSON(t *testing.T) {
+	allocs := testing.AllocsPerRun(100, func() {
+		var entry Entry
+		if err := decodeJSON(bytes.NewReader(benchmarkEntry), &entry); err != nil {
+			t.Fatal(err)
+		}
+	})
+	testAllocs(t, allocs, decodeJSONAllocs)
+}
+
+func TestAllocs_NewRequest(t *testing.T) {
+	c, _ := NewClient("https://localhost:443", WithToken(token))
+	u, _ := url.Parse("projects/foo/repos/bar/contents")
+	body := &push{
+		CommitMessage: &CommitMessage{Summary: "Add a.json"},
+		Changes:       []*Change{{Path: "/a.json", Type: UpsertJSON, Content: json.RawMessage(`{"a":"b"}`)}},
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := c.newRequest("POST", u, body); err != nil {
+			t.Fatal(err)
+		}
+	})
+	testAllocs(t, allocs, newRequestAllocs)
+}
+
+func TestAllocs_ListFilesRequest(t *testing.T) {
+	c, _ := NewClient("https://localhost:443", WithToken(token))
+	allocs := testing.AllocsPerRun(100, func() {
+		if _, err := c.content.listFilesRequest("foo", "bar", "-1", "/a/**"); err != nil {
+			t.Fatal(err)
+		}
+	})
+	testAllocs(t, allocs, listFilesRequestAllocs)
+}
+
+func testAllocs(t *testing.T, got float64, budget int) {
+	t.Helper()
+	if got > float64(budget) {
+		t.Errorf("allocations: %v, want <= %v", got, budget)
+	}
+}
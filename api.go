@@ -0,0 +1,101 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"time"
+)
+
+// The interfaces below are implemented by Client. The code which depends on them rather than Client can be
+// tested with a fake such as centraldogmatest.FakeClient without any HTTP server. For example:
+//
+//	type ConfigLoader struct {
+//	    content centraldogma.ContentAPI
+//	}
+//
+//	loader := &ConfigLoader{content: client}
+var (
+	_ ProjectAPI = (*Client)(nil)
+	_ RepoAPI    = (*Client)(nil)
+	_ ContentAPI = (*Client)(nil)
+	_ WatchAPI   = (*Client)(nil)
+)
+
+// ProjectAPI manages the projects.
+type ProjectAPI interface {
+	CreateProject(ctx context.Context, name string) (*Project, int, error)
+	CreateProjectWithOptions(ctx context.Context, name string, opts *ProjectOptions) (*Project, int, error)
+	RemoveProject(ctx context.Context, name string) (int, error)
+	PurgeProject(ctx context.Context, name string) (int, error)
+	UnremoveProject(ctx context.Context, name string) (*Project, int, error)
+	ListProjects(ctx context.Context) ([]*Project, int, error)
+	ListRemovedProjects(ctx context.Context) ([]*Project, int, error)
+}
+
+// RepoAPI manages the repositories of the projects.
+type RepoAPI interface {
+	CreateRepository(ctx context.Context, projectName, repoName string) (*Repository, int, error)
+	CreateRepositoryWithOptions(ctx context.Context, projectName, repoName string,
+		opts *RepositoryOptions) (*Repository, int, error)
+	RemoveRepository(ctx context.Context, projectName, repoName string) (int, error)
+	PurgeRepository(ctx context.Context, projectName, repoName string) (int, error)
+	UnremoveRepository(ctx context.Context, projectName, repoName string) (*Repository, int, error)
+	ListRepositories(ctx context.Context, projectName string) ([]*Repository, int, error)
+	ListRemovedRepositories(ctx context.Context, projectName string) ([]*Repository, int, error)
+	NormalizeRevision(ctx context.Context, projectName, repoName, revision string) (int64, int, error)
+	NormalizeRevisions(ctx context.Context, projectName, repoName string, revisions ...string) ([]int64, int, error)
+}
+
+// ContentAPI reads and pushes the files of the repositories.
+type ContentAPI interface {
+	ListFiles(ctx context.Context, projectName, repoName, revision, pathPattern string) ([]*Entry, int, error)
+	GetFile(ctx context.Context, projectName, repoName, revision string, query *Query) (*Entry, int, error)
+	GetBinaryFile(ctx context.Context, projectName, repoName, revision, path string) ([]byte, int, error)
+	GetFiles(ctx context.Context, projectName, repoName, revision, pathPattern string) ([]*Entry, int, error)
+	GetFilesWithJSONPaths(ctx context.Context, projectName, repoName, revision, pathPattern string,
+		jsonPaths ...string) ([]*Entry, int, error)
+	GetMergedEntry(ctx context.Context, projectName, repoName, revision string,
+		mergeQuery *MergeQuery) (*MergedEntry, int, error)
+	GetHistory(ctx context.Context, projectName, repoName, from, to, pathPattern string,
+		maxCommits int) ([]*Commit, int, error)
+	GetHistoryWithOptions(ctx context.Context, projectName, repoName string,
+		opts *HistoryOptions) ([]*Commit, int, error)
+	GetCommit(ctx context.Context, projectName, repoName, revision string) (*CommitDetail, int, error)
+	LastModified(ctx context.Context, projectName, repoName, path, atRevision string) (*Commit, int, error)
+	GetDiff(ctx context.Context, projectName, repoName, from, to string, query *Query) (*Change, int, error)
+	GetDiffs(ctx context.Context, projectName, repoName, from, to, pathPattern string) ([]*Change, int, error)
+	PreviewDiffs(ctx context.Context, projectName, repoName, baseRevision string,
+		changes []*Change) ([]*Change, int, error)
+	Push(ctx context.Context, projectName, repoName, baseRevision string, commitMessage *CommitMessage,
+		changes []*Change) (*PushResult, int, error)
+	PushIfUnchanged(ctx context.Context, projectName, repoName string, baseRevision Revision,
+		commitMessage *CommitMessage, changes []*Change) (*PushResult, int, error)
+	PushWithResultDetail(ctx context.Context, projectName, repoName, baseRevision string,
+		commitMessage *CommitMessage, changes []*Change) (*PushResultDetail, int, error)
+}
+
+// WatchAPI watches the changes of the files and the repositories. The Watchers returned by FileWatcher and
+// RepoWatcher are not covered because they cannot be faked.
+type WatchAPI interface {
+	WatchFile(ctx context.Context, projectName, repoName string, query *Query,
+		timeout time.Duration) (<-chan WatchResult, func(), error)
+	WatchRepository(ctx context.Context, projectName, repoName, pathPattern string,
+		timeout time.Duration) (<-chan WatchResult, func(), error)
+	WatchPaths(ctx context.Context, projectName, repoName string, patterns []string,
+		timeout time.Duration) (<-chan WatchResult, func(), error)
+	WatchRepositoryOnce(ctx context.Context, projectName, repoName, pathPattern string, lastKnownRevision int64,
+		timeout time.Duration) (int64, int, error)
+}
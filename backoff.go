@@ -0,0 +1,74 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"math"
+	"time"
+)
+
+// BackoffPolicy determines how long a Watcher waits before it reconnects to the server after failures.
+type BackoffPolicy interface {
+	// NextDelay returns the delay after the numAttemptsSoFar consecutive failures.
+	NextDelay(numAttemptsSoFar int) time.Duration
+}
+
+// ExponentialBackoff is a BackoffPolicy whose delay starts from Initial and is multiplied by Multiplier on every
+// failure up to Max. The delay is randomized within the range of ±Jitter, e.g. 0.2 for ±20%, so that the
+// clients disconnected at the same time do not reconnect all at once.
+type ExponentialBackoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+	Jitter     float64
+}
+
+// DefaultBackoffPolicy returns the BackoffPolicy used by a Watcher by default, which starts from 2 seconds and
+// doubles up to 1 minute with ±20% jitter.
+func DefaultBackoffPolicy() BackoffPolicy {
+	return &ExponentialBackoff{
+		Initial:    minInterval,
+		Max:        maxInterval,
+		Multiplier: 2.0,
+		Jitter:     jitterRate,
+	}
+}
+
+// NextDelay returns the delay after the numAttemptsSoFar consecutive failures.
+func (b *ExponentialBackoff) NextDelay(numAttemptsSoFar int) time.Duration {
+	var nextDelay time.Duration
+	if numAttemptsSoFar == 1 {
+		nextDelay = b.Initial
+	} else {
+		calculatedDelay := saturatedMultiply(b.Initial, math.Pow(b.Multiplier, float64(numAttemptsSoFar-1)))
+		if calculatedDelay > b.Max {
+			nextDelay = b.Max
+		} else {
+			nextDelay = calculatedDelay
+		}
+	}
+	if b.Jitter <= 0 {
+		return nextDelay
+	}
+	minJitter := int64(float64(nextDelay) * (1 - b.Jitter))
+	maxJitter := int64(float64(nextDelay) * (1 + b.Jitter))
+	bound := maxJitter - minJitter + 1
+	random := random(bound)
+	result := saturatedAdd(minJitter, random)
+	if result < 0 {
+		result = 0
+	}
+	return time.Duration(result)
+}
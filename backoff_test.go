@@ -0,0 +1,83 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestExponentialBackoff(t *testing.T) {
+	backoff := &ExponentialBackoff{Initial: 100 * time.Millisecond, Max: time.Second, Multiplier: 3}
+	want := []time.Duration{100 * time.Millisecond, 300 * time.Millisecond, 900 * time.Millisecond, time.Second}
+	for i, w := range want {
+		if delay := backoff.NextDelay(i + 1); delay != w {
+			t.Errorf("NextDelay(%d): %v, want %v", i+1, delay, w)
+		}
+	}
+
+	backoff.Jitter = 0.5
+	for i := 1; i < 10; i++ {
+		delay := backoff.NextDelay(3)
+		if delay < 450*time.Millisecond || delay > 1350*time.Millisecond {
+			t.Errorf("NextDelay(3): %v, want between 450ms and 1350ms", delay)
+		}
+	}
+}
+
+type countingBackoff struct {
+	count int32
+}
+
+func (b *countingBackoff) NextDelay(numAttemptsSoFar int) time.Duration {
+	atomic.AddInt32(&b.count, 1)
+	return 10 * time.Millisecond
+}
+
+func TestWithBackoffPolicy(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	backoff := &countingBackoff{}
+	c.backoffPolicy = backoff
+
+	var numRequests int32
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&numRequests, 1) < 3 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, response)
+	})
+
+	fw, err := c.FileWatcher("foo", "bar", &Query{Path: "/a.json", Type: Identity})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer fw.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if result := fw.AwaitInitialValueWithContext(ctx); result.Err != nil {
+		t.Fatalf("failed to get the initial value: %v", result.Err)
+	}
+	if count := atomic.LoadInt32(&backoff.count); count != 2 {
+		t.Errorf("NextDelay called %d times, want 2", count)
+	}
+}
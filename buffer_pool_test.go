@@ -0,0 +1,62 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+var benchmarkEntry = []byte(`{"path":"/a.txt","type":"TEXT","revision":2,"content":` +
+	strings.Repeat(`"line\n\"quoted\" é 中\t`, 1) + strings.Repeat("a", 4096) + `"}`)
+
+func BenchmarkEntryUnmarshal(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var entry Entry
+		if err := json.Unmarshal(benchmarkEntry, &entry); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkDecodeJSON(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		var entry Entry
+		if err := decodeJSON(bytes.NewReader(benchmarkEntry), &entry); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkNewRequest(b *testing.B) {
+	c, _ := NewClient("https://localhost:443", WithToken(token))
+	u, _ := url.Parse("projects/foo/repos/bar/contents")
+	body := &push{
+		CommitMessage: &CommitMessage{Summary: "Add a.json"},
+		Changes:       []*Change{{Path: "/a.json", Type: UpsertJSON, Content: json.RawMessage(`{"a":"b"}`)}},
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := c.newRequest("POST", u, body); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
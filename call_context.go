@@ -0,0 +1,190 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/oauth2"
+)
+
+type (
+	callTokenKey   struct{}
+	callTimeoutKey struct{}
+	callBaseURLKey struct{}
+)
+
+// ContextWithToken returns a copy of the ctx which makes the requests sent with it carry the token instead of
+// the one of the Client, so that a proxy serving several tenants can use a single Client. For example:
+//
+//	ctx := centraldogma.ContextWithToken(ctx, tenant.Token)
+//	entry, _, err := client.GetFile(ctx, "foo", "bar", "-1", query)
+//
+// The token replaces the credentials of WithToken, WithTokenProvider, WithTokenSource and WithPasswordLogin.
+// The requests fail with ErrCallTokenNotSupported if the credentials cannot be removed, so that they are never
+// sent with the credentials of the Client silently. It happens when the transport which attaches
+// the credentials is wrapped by another one, e.g. by WithVCR, or when the transport specified by WithTransport
+// or WithHTTPClient is not known to send the requests as they are. The response cache is not used for
+// the requests sent with the returned context.
+func ContextWithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, callTokenKey{}, token)
+}
+
+// ContextWithCallTimeout returns a copy of the ctx whose non-watch requests time out after the timeout instead
+// of the timeout specified by WithRequestTimeout or WithDefaultTimeout.
+func ContextWithCallTimeout(ctx context.Context, timeout time.Duration) context.Context {
+	return context.WithValue(ctx, callTimeoutKey{}, timeout)
+}
+
+// ContextWithBaseURL returns a copy of the ctx which makes the requests sent with it go to the baseURL instead
+// of the one of the Client, e.g. to read from a specific replica. The requests carry the credentials of
+// the Client, so the host of the baseURL must be the one of the Client or of the endpoints specified by
+// WithEndpoints; otherwise the requests fail with ErrBaseURLNotAllowed. The scheme of the baseURL must be
+// the same as the one of the Client because the transport is configured for it. The failover among
+// the endpoints, the response cache and the revision cache are not used for the requests sent with
+// the returned context.
+func ContextWithBaseURL(ctx context.Context, baseURL string) context.Context {
+	return context.WithValue(ctx, callBaseURLKey{}, baseURL)
+}
+
+func callTokenFromContext(ctx context.Context) (string, bool) {
+	token, ok := ctx.Value(callTokenKey{}).(string)
+	return token, ok
+}
+
+func callTimeoutFromContext(ctx context.Context) (time.Duration, bool) {
+	timeout, ok := ctx.Value(callTimeoutKey{}).(time.Duration)
+	return timeout, ok
+}
+
+func callBaseURLFromContext(ctx context.Context) (string, bool) {
+	baseURL, ok := ctx.Value(callBaseURLKey{}).(string)
+	return baseURL, ok
+}
+
+// hasCallCredentialsOrBaseURL returns whether the requests sent with the ctx may get different responses from
+// the other requests of the Client, so they must not share the caches.
+func hasCallCredentialsOrBaseURL(ctx context.Context) bool {
+	_, hasToken := callTokenFromContext(ctx)
+	_, hasBaseURL := callBaseURLFromContext(ctx)
+	return hasToken || hasBaseURL
+}
+
+// applyCallOverrides applies the token and the base URL in the ctx to the request.
+func (c *Client) applyCallOverrides(ctx context.Context, req *http.Request) (rebased bool, err error) {
+	if token, ok := callTokenFromContext(ctx); ok {
+		if c.unauthenticated == nil {
+			return false, ErrCallTokenNotSupported
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rawBaseURL, ok := callBaseURLFromContext(ctx)
+	if !ok {
+		return false, nil
+	}
+	baseURL, err := normalizeURL(rawBaseURL)
+	if err != nil {
+		return false, err
+	}
+	if baseURL.Scheme != c.baseURL.Scheme {
+		return false, ErrBaseURLSchemeMismatch
+	}
+	if !c.isKnownHost(baseURL.Host) {
+		return false, ErrBaseURLNotAllowed
+	}
+	rawURL := req.URL.String()
+	if !strings.HasPrefix(rawURL, c.baseURL.String()) {
+		return false, nil // an absolute URL which is not for the Central Dogma server
+	}
+	u, err := url.Parse(baseURL.String() + strings.TrimPrefix(rawURL, c.baseURL.String()))
+	if err != nil {
+		return false, err
+	}
+	req.URL = u
+	req.Host = u.Host
+	return true, nil
+}
+
+// isKnownHost returns whether the host is the one of the base URL of the Client or of its endpoints.
+func (c *Client) isKnownHost(host string) bool {
+	if host == c.baseURL.Host {
+		return true
+	}
+	if c.endpoints != nil {
+		for _, e := range c.endpoints.endpoints {
+			if host == e.url.Host {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// withoutAuthentication returns the client which sends the requests with the token set by ContextWithToken
+// instead of the credentials attached by the transport of the client, or nil if the credentials cannot be
+// removed from the requests.
+func withoutAuthentication(client *http.Client) *http.Client {
+	if transport := unwrapAuthentication(client.Transport); transport != nil {
+		return &http.Client{Transport: transport, CheckRedirect: client.CheckRedirect, Jar: client.Jar}
+	}
+	if attachesNoCredentials(client.Transport) {
+		return client
+	}
+	return nil
+}
+
+func unwrapAuthentication(transport http.RoundTripper) http.RoundTripper {
+	switch t := transport.(type) {
+	case *compressionTransport:
+		if base := unwrapAuthentication(t.base); base != nil {
+			return &compressionTransport{base: base}
+		}
+		return nil
+	case *oauth2.Transport:
+		if t.Base == nil {
+			return http.DefaultTransport
+		}
+		return t.Base
+	case *tokenProviderTransport:
+		return t.base
+	case *sessionTransport:
+		return t.base
+	default:
+		return nil
+	}
+}
+
+// attachesNoCredentials returns whether the transport is known to send the requests without attaching any
+// credentials, so that the authorization header of the requests is sent as it is.
+func attachesNoCredentials(transport http.RoundTripper) bool {
+	switch t := transport.(type) {
+	case nil, *http.Transport, *http2.Transport:
+		return true
+	case *compressionTransport:
+		return attachesNoCredentials(t.base)
+	case *dnsRefreshTransport:
+		return attachesNoCredentials(t.base)
+	case *vcrTransport:
+		return attachesNoCredentials(t.base)
+	default:
+		return false
+	}
+}
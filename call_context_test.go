@@ -0,0 +1,224 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestContextWithToken(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"name":%q}]`, r.Header.Get("Authorization"))
+	})
+
+	projects, _, err := c.ListProjects(ContextWithToken(context.Background(), "tenantToken"))
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "Bearer tenantToken", "authorization")
+
+	projects, _, err = c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "Bearer "+token, "authorization")
+}
+
+func TestContextWithToken_TokenProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"name":%q}]`, r.Header.Get("Authorization"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport),
+		WithTokenProvider(NewStaticTokenProvider("token1")), WithCompression(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, _, err := c.ListProjects(ContextWithToken(context.Background(), "tenantToken"))
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "Bearer tenantToken", "authorization")
+}
+
+func TestContextWithToken_BypassesCache(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithCache(10, time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.Header.Get("Authorization") == "Bearer forbidden" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, `{"path":"/a.json", "type":"JSON", "content":{"a":"b"}, "revision":2}`)
+	})
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	if _, _, err = c.GetFile(context.Background(), "foo", "bar", "2", query); err != nil {
+		t.Fatal(err)
+	}
+	ctx := ContextWithToken(context.Background(), "forbidden")
+	_, httpStatusCode, _ := c.GetFile(ctx, "foo", "bar", "2", query)
+	testStatusCode(t, httpStatusCode, http.StatusForbidden)
+	if requests != 2 {
+		t.Errorf("sent %v requests, want %v", requests, 2)
+	}
+}
+
+func TestContextWithCallTimeout(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		fmt.Fprint(w, `[]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithRequestTimeout(10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err == nil {
+		t.Errorf("ListProjects succeeded, want the timeout of the client")
+	}
+	if _, _, err = c.ListProjects(ContextWithCallTimeout(context.Background(), 10*time.Second)); err != nil {
+		t.Errorf("ListProjects returned error: %v", err)
+	}
+
+	c, err = NewClient(server.URL, WithTransport(http.DefaultTransport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(ContextWithCallTimeout(context.Background(), 10*time.Millisecond)); err == nil {
+		t.Errorf("ListProjects succeeded, want the timeout of the call")
+	}
+}
+
+func TestContextWithBaseURL(t *testing.T) {
+	newServer := func(name string) *httptest.Server {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, `[{"name":%q}]`, name)
+		})
+		return httptest.NewServer(mux)
+	}
+	server1, server2 := newServer("server1"), newServer("server2")
+	defer server1.Close()
+	defer server2.Close()
+
+	c, err := NewClient(server1.URL, WithTransport(http.DefaultTransport), WithEndpoints(server2.URL))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	projects, _, err := c.ListProjects(ContextWithBaseURL(context.Background(), server2.URL))
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "server2", "project")
+
+	projects, _, err = c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "server1", "project")
+
+	_, httpStatusCode, err := c.ListProjects(ContextWithBaseURL(context.Background(), "https://localhost:36462"))
+	testStatusCode(t, httpStatusCode, UnknownHttpStatusCode)
+	if err != ErrBaseURLSchemeMismatch {
+		t.Errorf("ListProjects returned %v, want %v", err, ErrBaseURLSchemeMismatch)
+	}
+
+	// The credentials of the client are not sent to an unknown host.
+	_, httpStatusCode, err = c.ListProjects(ContextWithBaseURL(context.Background(), "http://localhost:36462"))
+	testStatusCode(t, httpStatusCode, UnknownHttpStatusCode)
+	if err != ErrBaseURLNotAllowed {
+		t.Errorf("ListProjects returned %v, want %v", err, ErrBaseURLNotAllowed)
+	}
+}
+
+func TestContextWithToken_NotSupported(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"name":%q}]`, r.Header.Get("Authorization"))
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	// The transport may attach its own credentials.
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		req.Header.Set("Authorization", "Bearer custom")
+		return http.DefaultTransport.RoundTrip(req)
+	})
+	c, err := NewClient(server.URL, WithHTTPClient(&http.Client{Transport: transport}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := ContextWithToken(context.Background(), "tenantToken")
+	_, httpStatusCode, err := c.ListProjects(ctx)
+	testStatusCode(t, httpStatusCode, UnknownHttpStatusCode)
+	if err != ErrCallTokenNotSupported {
+		t.Errorf("ListProjects returned %v, want %v", err, ErrCallTokenNotSupported)
+	}
+
+	// The transport which attaches the credentials is wrapped by the VCR transport.
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c, err = NewClient(server.URL, WithTransport(http.DefaultTransport), WithToken(token),
+		WithVCR(filepath.Join(dir, "cassette.json")), WithVCRMode(VCRModeRecord))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(ctx); err != ErrCallTokenNotSupported {
+		t.Errorf("ListProjects returned %v, want %v", err, ErrCallTokenNotSupported)
+	}
+
+	// The transport does not attach any credentials.
+	c, err = NewClient(server.URL, WithTransport(http.DefaultTransport),
+		WithVCR(filepath.Join(dir, "cassette2.json")), WithVCRMode(VCRModeRecord))
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, _, err := c.ListProjects(ctx)
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "Bearer tenantToken", "authorization")
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"go.linecorp.com/centraldogma"
+)
+
+// The benchmarks run the client against the in-memory Server, so that the regressions in the serialization and
+// the URL building of the client show up without the noise of a real server. Run them with:
+//
+//	go test -run '^$' -bench . -benchmem ./centraldogmatest
+
+func BenchmarkGetFile(b *testing.B) {
+	server, client := newTestRepository(b)
+	defer server.Close()
+	upsert, _ := centraldogma.NewUpsertText("/a.txt", strings.Repeat("line\n", 1000))
+	push(b, client, "Add a.txt", upsert)
+	query := &centraldogma.Query{Path: "/a.txt", Type: centraldogma.Identity}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, _, err := client.GetFile(context.Background(), "foo", "bar", "-1", query); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkListFiles(b *testing.B) {
+	for _, n := range []int{1000, 10000} {
+		b.Run(fmt.Sprintf("entries=%d", n), func(b *testing.B) {
+			server, client := newTestRepository(b)
+			defer server.Close()
+			push(b, client, "Add the files", newUpserts(n)...)
+
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				entries, _, err := client.ListFiles(context.Background(), "foo", "bar", "-1", "/**")
+				if err != nil {
+					b.Fatal(err)
+				}
+				if len(entries) < n {
+					b.Fatalf("ListFiles returned %v entries, want %v", len(entries), n)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkPush(b *testing.B) {
+	server, client := newTestRepository(b)
+	defer server.Close()
+	changes := newUpserts(100)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// The content is changed every time so that the push is not rejected as an empty commit.
+		changes[0], _ = centraldogma.NewUpsertJSON("/0.json", map[string]interface{}{"n": i})
+		push(b, client, "Update the files", changes...)
+	}
+}
+
+// BenchmarkWatchChurn measures a watcher which is created, gets its initial value and is closed, as the
+// short-lived watchers of a request handler are.
+func BenchmarkWatchChurn(b *testing.B) {
+	server, client := newTestRepository(b)
+	defer server.Close()
+	upsert, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 1})
+	push(b, client, "Add a.json", upsert)
+	query := &centraldogma.Query{Path: "/a.json", Type: centraldogma.Identity}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		watcher, err := client.FileWatcher("foo", "bar", query)
+		if err != nil {
+			b.Fatal(err)
+		}
+		if result := watcher.AwaitInitialValueWith(5 * time.Second); result.Err != nil {
+			b.Fatal(result.Err)
+		}
+		if err = watcher.CloseAndWait(context.Background()); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func newUpserts(n int) []*centraldogma.Change {
+	changes := make([]*centraldogma.Change, n)
+	for i := range changes {
+		changes[i], _ = centraldogma.NewUpsertJSON(fmt.Sprintf("/%d.json", i), map[string]interface{}{"n": i})
+	}
+	return changes
+}
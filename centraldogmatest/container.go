@@ -0,0 +1,182 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build integration
+// +build integration
+
+package centraldogmatest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"testing"
+	"time"
+
+	"go.linecorp.com/centraldogma"
+)
+
+const (
+	// DefaultImage is the docker image which StartContainer runs if ContainerOptions.Image is not set.
+	// It can be overridden with the CENTRALDOGMA_IMAGE environment variable.
+	DefaultImage = "line/centraldogma:latest"
+
+	containerPort          = "36462/tcp"
+	defaultStartupTimeout  = 2 * time.Minute
+	readinessCheckInterval = 500 * time.Millisecond
+)
+
+// ContainerOptions specifies the image of a Container and the project and the repository which are created
+// when it is started.
+type ContainerOptions struct {
+	Image string
+	// Project is created when the container is ready if it is not empty.
+	Project string
+	// Repository is created in the Project when the container is ready if it is not empty.
+	Repository string
+	// StartupTimeout is the time to wait until the server is ready. The default is 2 minutes.
+	StartupTimeout time.Duration
+	// ClientOptions configure the Client of the Container.
+	ClientOptions []centraldogma.ClientOption
+}
+
+// Container is a real Central Dogma server which runs in a docker container for the integration tests.
+// The files which use it should have the integration build tag, so that the tests are run only with
+// "go test -tags integration" on a machine which has docker.
+type Container struct {
+	// ID is the ID of the docker container.
+	ID string
+	// URL is the base URL of the server, e.g. "http://127.0.0.1:49153".
+	URL string
+	// Client sends the requests to the server.
+	Client *centraldogma.Client
+}
+
+// StartContainer runs the Central Dogma image with the docker command and waits until the server is ready.
+// The caller should call Terminate when finished.
+func StartContainer(ctx context.Context, opts *ContainerOptions) (*Container, error) {
+	if opts == nil {
+		opts = &ContainerOptions{}
+	}
+	image := opts.Image
+	if len(image) == 0 {
+		image = os.Getenv("CENTRALDOGMA_IMAGE")
+	}
+	if len(image) == 0 {
+		image = DefaultImage
+	}
+	startupTimeout := opts.StartupTimeout
+	if startupTimeout <= 0 {
+		startupTimeout = defaultStartupTimeout
+	}
+
+	id, err := docker(ctx, "run", "-d", "--rm", "-p", "127.0.0.1::"+containerPort, image)
+	if err != nil {
+		return nil, err
+	}
+	c := &Container{ID: id}
+
+	if err = c.start(ctx, opts, startupTimeout); err != nil {
+		c.Terminate(context.Background())
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Container) start(ctx context.Context, opts *ContainerOptions, startupTimeout time.Duration) error {
+	address, err := docker(ctx, "port", c.ID, containerPort)
+	if err != nil {
+		return err
+	}
+	// The port may be printed for both IPv4 and IPv6.
+	c.URL = "http://" + strings.Fields(address)[0]
+
+	if c.Client, err = centraldogma.NewClient(c.URL, opts.ClientOptions...); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, startupTimeout)
+	defer cancel()
+	if err = c.waitUntilReady(ctx); err != nil {
+		return err
+	}
+
+	if len(opts.Project) != 0 {
+		if _, _, err = c.Client.CreateProject(ctx, opts.Project); err != nil {
+			return fmt.Errorf("failed to create the project %s: %v", opts.Project, err)
+		}
+		if len(opts.Repository) != 0 {
+			if _, _, err = c.Client.CreateRepository(ctx, opts.Project, opts.Repository); err != nil {
+				return fmt.Errorf("failed to create the repository %s/%s: %v", opts.Project, opts.Repository, err)
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Container) waitUntilReady(ctx context.Context) error {
+	ticker := time.NewTicker(readinessCheckInterval)
+	defer ticker.Stop()
+	for {
+		if healthy, _, _ := c.Client.ServerHealthy(ctx); healthy {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			logs, _ := docker(context.Background(), "logs", "--tail", "20", c.ID)
+			return fmt.Errorf("the server is not ready: %v, logs:\n%s", ctx.Err(), logs)
+		case <-ticker.C:
+		}
+	}
+}
+
+// Terminate stops and removes the container.
+func (c *Container) Terminate(ctx context.Context) error {
+	_, err := docker(ctx, "rm", "-f", c.ID)
+	return err
+}
+
+// MustStartContainer starts a Container for the test. The test is skipped if docker is not available and
+// fails if the container cannot be started. For example:
+//
+//	container := centraldogmatest.MustStartContainer(t, &centraldogmatest.ContainerOptions{
+//	    Project: "foo", Repository: "bar",
+//	})
+//	defer container.Terminate(context.Background())
+func MustStartContainer(t testing.TB, opts *ContainerOptions) *Container {
+	t.Helper()
+	if _, err := exec.LookPath("docker"); err != nil {
+		t.Skip("docker is not available")
+	}
+	c, err := StartContainer(context.Background(), opts)
+	if err != nil {
+		t.Fatalf("failed to start Central Dogma: %v", err)
+	}
+	return c
+}
+
+// docker runs the docker command and returns its trimmed output.
+func docker(ctx context.Context, args ...string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "docker", args...)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return "", fmt.Errorf("docker %s: %v: %s", args[0], err, strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(stdout.String()), nil
+}
@@ -0,0 +1,47 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build integration
+// +build integration
+
+package centraldogmatest
+
+import (
+	"context"
+	"testing"
+
+	"go.linecorp.com/centraldogma"
+)
+
+func TestContainer(t *testing.T) {
+	container := MustStartContainer(t, &ContainerOptions{Project: "foo", Repository: "bar"})
+	defer container.Terminate(context.Background())
+	ctx := context.Background()
+
+	upsert, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 1})
+	result, _, err := container.Client.Push(ctx, "foo", "bar", "-1",
+		&centraldogma.CommitMessage{Summary: "Add a.json"}, []*centraldogma.Change{upsert})
+	if err != nil {
+		t.Fatalf("Push returned error: %v", err)
+	}
+
+	entry, _, err := container.Client.GetFile(ctx, "foo", "bar", "-1",
+		&centraldogma.Query{Path: "/a.json", Type: centraldogma.Identity})
+	if err != nil {
+		t.Fatalf("GetFile returned error: %v", err)
+	}
+	if entry.Revision != result.Revision {
+		t.Errorf("GetFile returned the revision %v, want %v", entry.Revision, result.Revision)
+	}
+}
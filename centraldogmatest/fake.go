@@ -0,0 +1,63 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"errors"
+)
+
+//go:generate go run ../internal/fakegen -source ../api.go -output fake_client.go
+
+// ErrNotScripted is returned by the methods of FakeClient whose funcs are not set.
+var ErrNotScripted = errors.New("the method of the fake client is not scripted")
+
+// Call represents a call of a method of FakeClient.
+type Call struct {
+	Method string
+	Args   []interface{}
+}
+
+func (f *FakeClient) record(method string, args ...interface{}) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.calls = append(f.calls, Call{Method: method, Args: args})
+}
+
+// Calls returns the calls of the methods in the order in which they were made.
+func (f *FakeClient) Calls() []Call {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]Call(nil), f.calls...)
+}
+
+// CallsTo returns the calls of the method in the order in which they were made.
+func (f *FakeClient) CallsTo(method string) []Call {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	var calls []Call
+	for _, call := range f.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls which were recorded so far.
+func (f *FakeClient) Reset() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.calls = nil
+}
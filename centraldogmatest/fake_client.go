@@ -0,0 +1,388 @@
+// Code generated by fakegen. DO NOT EDIT.
+
+package centraldogmatest
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.linecorp.com/centraldogma"
+)
+
+// FakeClient is a fake of centraldogma.ProjectAPI, centraldogma.RepoAPI, centraldogma.ContentAPI and
+// centraldogma.WatchAPI. It records the calls of its methods and returns the results of the funcs set to the
+// fields named after the methods. ErrNotScripted is returned if the func is not set. The funcs should be set
+// before the methods are called.
+type FakeClient struct {
+	lock  sync.Mutex
+	calls []Call
+
+	CreateProjectFunc               func(ctx context.Context, name string) (*centraldogma.Project, int, error)
+	CreateProjectWithOptionsFunc    func(ctx context.Context, name string, opts *centraldogma.ProjectOptions) (*centraldogma.Project, int, error)
+	RemoveProjectFunc               func(ctx context.Context, name string) (int, error)
+	PurgeProjectFunc                func(ctx context.Context, name string) (int, error)
+	UnremoveProjectFunc             func(ctx context.Context, name string) (*centraldogma.Project, int, error)
+	ListProjectsFunc                func(ctx context.Context) ([]*centraldogma.Project, int, error)
+	ListRemovedProjectsFunc         func(ctx context.Context) ([]*centraldogma.Project, int, error)
+	CreateRepositoryFunc            func(ctx context.Context, projectName string, repoName string) (*centraldogma.Repository, int, error)
+	CreateRepositoryWithOptionsFunc func(ctx context.Context, projectName string, repoName string, opts *centraldogma.RepositoryOptions) (*centraldogma.Repository, int, error)
+	RemoveRepositoryFunc            func(ctx context.Context, projectName string, repoName string) (int, error)
+	PurgeRepositoryFunc             func(ctx context.Context, projectName string, repoName string) (int, error)
+	UnremoveRepositoryFunc          func(ctx context.Context, projectName string, repoName string) (*centraldogma.Repository, int, error)
+	ListRepositoriesFunc            func(ctx context.Context, projectName string) ([]*centraldogma.Repository, int, error)
+	ListRemovedRepositoriesFunc     func(ctx context.Context, projectName string) ([]*centraldogma.Repository, int, error)
+	NormalizeRevisionFunc           func(ctx context.Context, projectName string, repoName string, revision string) (int64, int, error)
+	NormalizeRevisionsFunc          func(ctx context.Context, projectName string, repoName string, revisions ...string) ([]int64, int, error)
+	ListFilesFunc                   func(ctx context.Context, projectName string, repoName string, revision string, pathPattern string) ([]*centraldogma.Entry, int, error)
+	GetFileFunc                     func(ctx context.Context, projectName string, repoName string, revision string, query *centraldogma.Query) (*centraldogma.Entry, int, error)
+	GetBinaryFileFunc               func(ctx context.Context, projectName string, repoName string, revision string, path string) ([]byte, int, error)
+	GetFilesFunc                    func(ctx context.Context, projectName string, repoName string, revision string, pathPattern string) ([]*centraldogma.Entry, int, error)
+	GetFilesWithJSONPathsFunc       func(ctx context.Context, projectName string, repoName string, revision string, pathPattern string, jsonPaths ...string) ([]*centraldogma.Entry, int, error)
+	GetMergedEntryFunc              func(ctx context.Context, projectName string, repoName string, revision string, mergeQuery *centraldogma.MergeQuery) (*centraldogma.MergedEntry, int, error)
+	GetHistoryFunc                  func(ctx context.Context, projectName string, repoName string, from string, to string, pathPattern string, maxCommits int) ([]*centraldogma.Commit, int, error)
+	GetHistoryWithOptionsFunc       func(ctx context.Context, projectName string, repoName string, opts *centraldogma.HistoryOptions) ([]*centraldogma.Commit, int, error)
+	GetCommitFunc                   func(ctx context.Context, projectName string, repoName string, revision string) (*centraldogma.CommitDetail, int, error)
+	LastModifiedFunc                func(ctx context.Context, projectName string, repoName string, path string, atRevision string) (*centraldogma.Commit, int, error)
+	GetDiffFunc                     func(ctx context.Context, projectName string, repoName string, from string, to string, query *centraldogma.Query) (*centraldogma.Change, int, error)
+	GetDiffsFunc                    func(ctx context.Context, projectName string, repoName string, from string, to string, pathPattern string) ([]*centraldogma.Change, int, error)
+	PreviewDiffsFunc                func(ctx context.Context, projectName string, repoName string, baseRevision string, changes []*centraldogma.Change) ([]*centraldogma.Change, int, error)
+	PushFunc                        func(ctx context.Context, projectName string, repoName string, baseRevision string, commitMessage *centraldogma.CommitMessage, changes []*centraldogma.Change) (*centraldogma.PushResult, int, error)
+	PushIfUnchangedFunc             func(ctx context.Context, projectName string, repoName string, baseRevision centraldogma.Revision, commitMessage *centraldogma.CommitMessage, changes []*centraldogma.Change) (*centraldogma.PushResult, int, error)
+	PushWithResultDetailFunc        func(ctx context.Context, projectName string, repoName string, baseRevision string, commitMessage *centraldogma.CommitMessage, changes []*centraldogma.Change) (*centraldogma.PushResultDetail, int, error)
+	WatchFileFunc                   func(ctx context.Context, projectName string, repoName string, query *centraldogma.Query, timeout time.Duration) (<-chan centraldogma.WatchResult, func(), error)
+	WatchRepositoryFunc             func(ctx context.Context, projectName string, repoName string, pathPattern string, timeout time.Duration) (<-chan centraldogma.WatchResult, func(), error)
+	WatchPathsFunc                  func(ctx context.Context, projectName string, repoName string, patterns []string, timeout time.Duration) (<-chan centraldogma.WatchResult, func(), error)
+	WatchRepositoryOnceFunc         func(ctx context.Context, projectName string, repoName string, pathPattern string, lastKnownRevision int64, timeout time.Duration) (int64, int, error)
+}
+
+var (
+	_ centraldogma.ProjectAPI = (*FakeClient)(nil)
+	_ centraldogma.RepoAPI    = (*FakeClient)(nil)
+	_ centraldogma.ContentAPI = (*FakeClient)(nil)
+	_ centraldogma.WatchAPI   = (*FakeClient)(nil)
+)
+
+// CreateProject records the call and calls CreateProjectFunc.
+func (f *FakeClient) CreateProject(ctx context.Context, name string) (*centraldogma.Project, int, error) {
+	f.record("CreateProject", ctx, name)
+	if f.CreateProjectFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.CreateProjectFunc(ctx, name)
+}
+
+// CreateProjectWithOptions records the call and calls CreateProjectWithOptionsFunc.
+func (f *FakeClient) CreateProjectWithOptions(ctx context.Context, name string, opts *centraldogma.ProjectOptions) (*centraldogma.Project, int, error) {
+	f.record("CreateProjectWithOptions", ctx, name, opts)
+	if f.CreateProjectWithOptionsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.CreateProjectWithOptionsFunc(ctx, name, opts)
+}
+
+// RemoveProject records the call and calls RemoveProjectFunc.
+func (f *FakeClient) RemoveProject(ctx context.Context, name string) (int, error) {
+	f.record("RemoveProject", ctx, name)
+	if f.RemoveProjectFunc == nil {
+		return 0, ErrNotScripted
+	}
+	return f.RemoveProjectFunc(ctx, name)
+}
+
+// PurgeProject records the call and calls PurgeProjectFunc.
+func (f *FakeClient) PurgeProject(ctx context.Context, name string) (int, error) {
+	f.record("PurgeProject", ctx, name)
+	if f.PurgeProjectFunc == nil {
+		return 0, ErrNotScripted
+	}
+	return f.PurgeProjectFunc(ctx, name)
+}
+
+// UnremoveProject records the call and calls UnremoveProjectFunc.
+func (f *FakeClient) UnremoveProject(ctx context.Context, name string) (*centraldogma.Project, int, error) {
+	f.record("UnremoveProject", ctx, name)
+	if f.UnremoveProjectFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.UnremoveProjectFunc(ctx, name)
+}
+
+// ListProjects records the call and calls ListProjectsFunc.
+func (f *FakeClient) ListProjects(ctx context.Context) ([]*centraldogma.Project, int, error) {
+	f.record("ListProjects", ctx)
+	if f.ListProjectsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.ListProjectsFunc(ctx)
+}
+
+// ListRemovedProjects records the call and calls ListRemovedProjectsFunc.
+func (f *FakeClient) ListRemovedProjects(ctx context.Context) ([]*centraldogma.Project, int, error) {
+	f.record("ListRemovedProjects", ctx)
+	if f.ListRemovedProjectsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.ListRemovedProjectsFunc(ctx)
+}
+
+// CreateRepository records the call and calls CreateRepositoryFunc.
+func (f *FakeClient) CreateRepository(ctx context.Context, projectName string, repoName string) (*centraldogma.Repository, int, error) {
+	f.record("CreateRepository", ctx, projectName, repoName)
+	if f.CreateRepositoryFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.CreateRepositoryFunc(ctx, projectName, repoName)
+}
+
+// CreateRepositoryWithOptions records the call and calls CreateRepositoryWithOptionsFunc.
+func (f *FakeClient) CreateRepositoryWithOptions(ctx context.Context, projectName string, repoName string, opts *centraldogma.RepositoryOptions) (*centraldogma.Repository, int, error) {
+	f.record("CreateRepositoryWithOptions", ctx, projectName, repoName, opts)
+	if f.CreateRepositoryWithOptionsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.CreateRepositoryWithOptionsFunc(ctx, projectName, repoName, opts)
+}
+
+// RemoveRepository records the call and calls RemoveRepositoryFunc.
+func (f *FakeClient) RemoveRepository(ctx context.Context, projectName string, repoName string) (int, error) {
+	f.record("RemoveRepository", ctx, projectName, repoName)
+	if f.RemoveRepositoryFunc == nil {
+		return 0, ErrNotScripted
+	}
+	return f.RemoveRepositoryFunc(ctx, projectName, repoName)
+}
+
+// PurgeRepository records the call and calls PurgeRepositoryFunc.
+func (f *FakeClient) PurgeRepository(ctx context.Context, projectName string, repoName string) (int, error) {
+	f.record("PurgeRepository", ctx, projectName, repoName)
+	if f.PurgeRepositoryFunc == nil {
+		return 0, ErrNotScripted
+	}
+	return f.PurgeRepositoryFunc(ctx, projectName, repoName)
+}
+
+// UnremoveRepository records the call and calls UnremoveRepositoryFunc.
+func (f *FakeClient) UnremoveRepository(ctx context.Context, projectName string, repoName string) (*centraldogma.Repository, int, error) {
+	f.record("UnremoveRepository", ctx, projectName, repoName)
+	if f.UnremoveRepositoryFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.UnremoveRepositoryFunc(ctx, projectName, repoName)
+}
+
+// ListRepositories records the call and calls ListRepositoriesFunc.
+func (f *FakeClient) ListRepositories(ctx context.Context, projectName string) ([]*centraldogma.Repository, int, error) {
+	f.record("ListRepositories", ctx, projectName)
+	if f.ListRepositoriesFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.ListRepositoriesFunc(ctx, projectName)
+}
+
+// ListRemovedRepositories records the call and calls ListRemovedRepositoriesFunc.
+func (f *FakeClient) ListRemovedRepositories(ctx context.Context, projectName string) ([]*centraldogma.Repository, int, error) {
+	f.record("ListRemovedRepositories", ctx, projectName)
+	if f.ListRemovedRepositoriesFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.ListRemovedRepositoriesFunc(ctx, projectName)
+}
+
+// NormalizeRevision records the call and calls NormalizeRevisionFunc.
+func (f *FakeClient) NormalizeRevision(ctx context.Context, projectName string, repoName string, revision string) (int64, int, error) {
+	f.record("NormalizeRevision", ctx, projectName, repoName, revision)
+	if f.NormalizeRevisionFunc == nil {
+		return 0, 0, ErrNotScripted
+	}
+	return f.NormalizeRevisionFunc(ctx, projectName, repoName, revision)
+}
+
+// NormalizeRevisions records the call and calls NormalizeRevisionsFunc.
+func (f *FakeClient) NormalizeRevisions(ctx context.Context, projectName string, repoName string, revisions ...string) ([]int64, int, error) {
+	f.record("NormalizeRevisions", ctx, projectName, repoName, revisions)
+	if f.NormalizeRevisionsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.NormalizeRevisionsFunc(ctx, projectName, repoName, revisions...)
+}
+
+// ListFiles records the call and calls ListFilesFunc.
+func (f *FakeClient) ListFiles(ctx context.Context, projectName string, repoName string, revision string, pathPattern string) ([]*centraldogma.Entry, int, error) {
+	f.record("ListFiles", ctx, projectName, repoName, revision, pathPattern)
+	if f.ListFilesFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.ListFilesFunc(ctx, projectName, repoName, revision, pathPattern)
+}
+
+// GetFile records the call and calls GetFileFunc.
+func (f *FakeClient) GetFile(ctx context.Context, projectName string, repoName string, revision string, query *centraldogma.Query) (*centraldogma.Entry, int, error) {
+	f.record("GetFile", ctx, projectName, repoName, revision, query)
+	if f.GetFileFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetFileFunc(ctx, projectName, repoName, revision, query)
+}
+
+// GetBinaryFile records the call and calls GetBinaryFileFunc.
+func (f *FakeClient) GetBinaryFile(ctx context.Context, projectName string, repoName string, revision string, path string) ([]byte, int, error) {
+	f.record("GetBinaryFile", ctx, projectName, repoName, revision, path)
+	if f.GetBinaryFileFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetBinaryFileFunc(ctx, projectName, repoName, revision, path)
+}
+
+// GetFiles records the call and calls GetFilesFunc.
+func (f *FakeClient) GetFiles(ctx context.Context, projectName string, repoName string, revision string, pathPattern string) ([]*centraldogma.Entry, int, error) {
+	f.record("GetFiles", ctx, projectName, repoName, revision, pathPattern)
+	if f.GetFilesFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetFilesFunc(ctx, projectName, repoName, revision, pathPattern)
+}
+
+// GetFilesWithJSONPaths records the call and calls GetFilesWithJSONPathsFunc.
+func (f *FakeClient) GetFilesWithJSONPaths(ctx context.Context, projectName string, repoName string, revision string, pathPattern string, jsonPaths ...string) ([]*centraldogma.Entry, int, error) {
+	f.record("GetFilesWithJSONPaths", ctx, projectName, repoName, revision, pathPattern, jsonPaths)
+	if f.GetFilesWithJSONPathsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetFilesWithJSONPathsFunc(ctx, projectName, repoName, revision, pathPattern, jsonPaths...)
+}
+
+// GetMergedEntry records the call and calls GetMergedEntryFunc.
+func (f *FakeClient) GetMergedEntry(ctx context.Context, projectName string, repoName string, revision string, mergeQuery *centraldogma.MergeQuery) (*centraldogma.MergedEntry, int, error) {
+	f.record("GetMergedEntry", ctx, projectName, repoName, revision, mergeQuery)
+	if f.GetMergedEntryFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetMergedEntryFunc(ctx, projectName, repoName, revision, mergeQuery)
+}
+
+// GetHistory records the call and calls GetHistoryFunc.
+func (f *FakeClient) GetHistory(ctx context.Context, projectName string, repoName string, from string, to string, pathPattern string, maxCommits int) ([]*centraldogma.Commit, int, error) {
+	f.record("GetHistory", ctx, projectName, repoName, from, to, pathPattern, maxCommits)
+	if f.GetHistoryFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetHistoryFunc(ctx, projectName, repoName, from, to, pathPattern, maxCommits)
+}
+
+// GetHistoryWithOptions records the call and calls GetHistoryWithOptionsFunc.
+func (f *FakeClient) GetHistoryWithOptions(ctx context.Context, projectName string, repoName string, opts *centraldogma.HistoryOptions) ([]*centraldogma.Commit, int, error) {
+	f.record("GetHistoryWithOptions", ctx, projectName, repoName, opts)
+	if f.GetHistoryWithOptionsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetHistoryWithOptionsFunc(ctx, projectName, repoName, opts)
+}
+
+// GetCommit records the call and calls GetCommitFunc.
+func (f *FakeClient) GetCommit(ctx context.Context, projectName string, repoName string, revision string) (*centraldogma.CommitDetail, int, error) {
+	f.record("GetCommit", ctx, projectName, repoName, revision)
+	if f.GetCommitFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetCommitFunc(ctx, projectName, repoName, revision)
+}
+
+// LastModified records the call and calls LastModifiedFunc.
+func (f *FakeClient) LastModified(ctx context.Context, projectName string, repoName string, path string, atRevision string) (*centraldogma.Commit, int, error) {
+	f.record("LastModified", ctx, projectName, repoName, path, atRevision)
+	if f.LastModifiedFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.LastModifiedFunc(ctx, projectName, repoName, path, atRevision)
+}
+
+// GetDiff records the call and calls GetDiffFunc.
+func (f *FakeClient) GetDiff(ctx context.Context, projectName string, repoName string, from string, to string, query *centraldogma.Query) (*centraldogma.Change, int, error) {
+	f.record("GetDiff", ctx, projectName, repoName, from, to, query)
+	if f.GetDiffFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetDiffFunc(ctx, projectName, repoName, from, to, query)
+}
+
+// GetDiffs records the call and calls GetDiffsFunc.
+func (f *FakeClient) GetDiffs(ctx context.Context, projectName string, repoName string, from string, to string, pathPattern string) ([]*centraldogma.Change, int, error) {
+	f.record("GetDiffs", ctx, projectName, repoName, from, to, pathPattern)
+	if f.GetDiffsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.GetDiffsFunc(ctx, projectName, repoName, from, to, pathPattern)
+}
+
+// PreviewDiffs records the call and calls PreviewDiffsFunc.
+func (f *FakeClient) PreviewDiffs(ctx context.Context, projectName string, repoName string, baseRevision string, changes []*centraldogma.Change) ([]*centraldogma.Change, int, error) {
+	f.record("PreviewDiffs", ctx, projectName, repoName, baseRevision, changes)
+	if f.PreviewDiffsFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.PreviewDiffsFunc(ctx, projectName, repoName, baseRevision, changes)
+}
+
+// Push records the call and calls PushFunc.
+func (f *FakeClient) Push(ctx context.Context, projectName string, repoName string, baseRevision string, commitMessage *centraldogma.CommitMessage, changes []*centraldogma.Change) (*centraldogma.PushResult, int, error) {
+	f.record("Push", ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	if f.PushFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.PushFunc(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+}
+
+// PushIfUnchanged records the call and calls PushIfUnchangedFunc.
+func (f *FakeClient) PushIfUnchanged(ctx context.Context, projectName string, repoName string, baseRevision centraldogma.Revision, commitMessage *centraldogma.CommitMessage, changes []*centraldogma.Change) (*centraldogma.PushResult, int, error) {
+	f.record("PushIfUnchanged", ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	if f.PushIfUnchangedFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.PushIfUnchangedFunc(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+}
+
+// PushWithResultDetail records the call and calls PushWithResultDetailFunc.
+func (f *FakeClient) PushWithResultDetail(ctx context.Context, projectName string, repoName string, baseRevision string, commitMessage *centraldogma.CommitMessage, changes []*centraldogma.Change) (*centraldogma.PushResultDetail, int, error) {
+	f.record("PushWithResultDetail", ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	if f.PushWithResultDetailFunc == nil {
+		return nil, 0, ErrNotScripted
+	}
+	return f.PushWithResultDetailFunc(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+}
+
+// WatchFile records the call and calls WatchFileFunc.
+func (f *FakeClient) WatchFile(ctx context.Context, projectName string, repoName string, query *centraldogma.Query, timeout time.Duration) (<-chan centraldogma.WatchResult, func(), error) {
+	f.record("WatchFile", ctx, projectName, repoName, query, timeout)
+	if f.WatchFileFunc == nil {
+		return nil, nil, ErrNotScripted
+	}
+	return f.WatchFileFunc(ctx, projectName, repoName, query, timeout)
+}
+
+// WatchRepository records the call and calls WatchRepositoryFunc.
+func (f *FakeClient) WatchRepository(ctx context.Context, projectName string, repoName string, pathPattern string, timeout time.Duration) (<-chan centraldogma.WatchResult, func(), error) {
+	f.record("WatchRepository", ctx, projectName, repoName, pathPattern, timeout)
+	if f.WatchRepositoryFunc == nil {
+		return nil, nil, ErrNotScripted
+	}
+	return f.WatchRepositoryFunc(ctx, projectName, repoName, pathPattern, timeout)
+}
+
+// WatchPaths records the call and calls WatchPathsFunc.
+func (f *FakeClient) WatchPaths(ctx context.Context, projectName string, repoName string, patterns []string, timeout time.Duration) (<-chan centraldogma.WatchResult, func(), error) {
+	f.record("WatchPaths", ctx, projectName, repoName, patterns, timeout)
+	if f.WatchPathsFunc == nil {
+		return nil, nil, ErrNotScripted
+	}
+	return f.WatchPathsFunc(ctx, projectName, repoName, patterns, timeout)
+}
+
+// WatchRepositoryOnce records the call and calls WatchRepositoryOnceFunc.
+func (f *FakeClient) WatchRepositoryOnce(ctx context.Context, projectName string, repoName string, pathPattern string, lastKnownRevision int64, timeout time.Duration) (int64, int, error) {
+	f.record("WatchRepositoryOnce", ctx, projectName, repoName, pathPattern, lastKnownRevision, timeout)
+	if f.WatchRepositoryOnceFunc == nil {
+		return 0, 0, ErrNotScripted
+	}
+	return f.WatchRepositoryOnceFunc(ctx, projectName, repoName, pathPattern, lastKnownRevision, timeout)
+}
@@ -0,0 +1,74 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"go.linecorp.com/centraldogma"
+)
+
+// loadPort is the code under test which depends on centraldogma.ContentAPI.
+func loadPort(ctx context.Context, content centraldogma.ContentAPI) (int, error) {
+	entry, _, err := content.GetFile(ctx, "foo", "bar", "-1",
+		&centraldogma.Query{Path: "/server.json", Type: centraldogma.Identity})
+	if err != nil {
+		return 0, err
+	}
+	var config struct {
+		Port int `json:"port"`
+	}
+	if err = entry.UnmarshalTo(&config); err != nil {
+		return 0, err
+	}
+	return config.Port, nil
+}
+
+func TestFakeClient(t *testing.T) {
+	fake := &FakeClient{}
+	ctx := context.Background()
+
+	if _, err := loadPort(ctx, fake); err != ErrNotScripted {
+		t.Errorf("loadPort returned %v, want %v", err, ErrNotScripted)
+	}
+
+	fake.GetFileFunc = func(ctx context.Context, projectName, repoName, revision string,
+		query *centraldogma.Query) (*centraldogma.Entry, int, error) {
+		return &centraldogma.Entry{Path: query.Path, Type: centraldogma.JSON, Content: []byte(`{"port":8080}`)},
+			http.StatusOK, nil
+	}
+	port, err := loadPort(ctx, fake)
+	if err != nil || port != 8080 {
+		t.Errorf("loadPort returned %v, %v, want %v", port, err, 8080)
+	}
+
+	fake.NormalizeRevisions(ctx, "foo", "bar", "-1", "-2")
+	calls := fake.CallsTo("GetFile")
+	if len(calls) != 2 || calls[1].Args[1] != "foo" || calls[1].Args[3] != "-1" {
+		t.Errorf("CallsTo(GetFile) returned %+v", calls)
+	}
+	if calls := fake.Calls(); len(calls) != 3 || calls[2].Method != "NormalizeRevisions" ||
+		!reflect.DeepEqual(calls[2].Args[3], []string{"-1", "-2"}) {
+		t.Errorf("Calls returned %+v", calls)
+	}
+
+	fake.Reset()
+	if calls := fake.Calls(); len(calls) != 0 {
+		t.Errorf("Calls returned %+v after Reset", calls)
+	}
+}
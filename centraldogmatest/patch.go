@@ -0,0 +1,314 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"go.linecorp.com/centraldogma"
+)
+
+// applyJSONPatch applies the JSON patch operations to the value decoded from JSON. The value may be modified.
+func applyJSONPatch(value interface{}, operations []centraldogma.JSONPatchOperation) (interface{}, error) {
+	var err error
+	for _, operation := range operations {
+		var tokens, from []string
+		if tokens, err = parseJSONPointer(operation.Path); err != nil {
+			return nil, err
+		}
+		switch operation.Op {
+		case "add":
+			value, err = addValue(value, tokens, operation.Value)
+		case "remove":
+			value, err = removeValue(value, tokens)
+		case "replace":
+			value, err = replaceValue(value, tokens, operation.Value)
+		case "test":
+			var actual interface{}
+			if actual, err = getValue(value, tokens); err == nil && !reflect.DeepEqual(actual, operation.Value) {
+				err = fmt.Errorf("the value at %s is not %v", operation.Path, operation.Value)
+			}
+		case "move", "copy":
+			if from, err = parseJSONPointer(operation.From); err != nil {
+				return nil, err
+			}
+			var moved interface{}
+			if moved, err = getValue(value, from); err != nil {
+				return nil, err
+			}
+			if operation.Op == "move" {
+				if value, err = removeValue(value, from); err != nil {
+					return nil, err
+				}
+			} else {
+				// The copied value should not share the containers with the original one.
+				if err = remarshal(moved, &moved); err != nil {
+					return nil, err
+				}
+			}
+			value, err = addValue(value, tokens, moved)
+		default:
+			err = fmt.Errorf("unsupported operation: %s", operation.Op)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return value, nil
+}
+
+func parseJSONPointer(pointer string) ([]string, error) {
+	if len(pointer) == 0 {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer: %s", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.Replace(strings.Replace(token, "~1", "/", -1), "~0", "~", -1)
+	}
+	return tokens, nil
+}
+
+// arrayIndex parses the index of an array whose length is n. If inclusive is true, n is also a valid index.
+func arrayIndex(token string, n int, inclusive bool) (int, error) {
+	if inclusive && token == "-" {
+		return n, nil
+	}
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || i > n || (!inclusive && i == n) {
+		return 0, fmt.Errorf("invalid array index: %s", token)
+	}
+	return i, nil
+}
+
+func getValue(value interface{}, tokens []string) (interface{}, error) {
+	for _, token := range tokens {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			child, ok := node[token]
+			if !ok {
+				return nil, fmt.Errorf("no such member: %s", token)
+			}
+			value = child
+		case []interface{}:
+			i, err := arrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			value = node[i]
+		default:
+			return nil, fmt.Errorf("no such path: %s", token)
+		}
+	}
+	return value, nil
+}
+
+// updateValue updates the parent container of the value at the tokens with the func and returns the updated
+// value.
+func updateValue(value interface{}, tokens []string,
+	update func(parent interface{}, token string) (interface{}, error)) (interface{}, error) {
+	if len(tokens) == 1 {
+		return update(value, tokens[0])
+	}
+	switch node := value.(type) {
+	case map[string]interface{}:
+		child, ok := node[tokens[0]]
+		if !ok {
+			return nil, fmt.Errorf("no such member: %s", tokens[0])
+		}
+		updated, err := updateValue(child, tokens[1:], update)
+		if err != nil {
+			return nil, err
+		}
+		node[tokens[0]] = updated
+		return node, nil
+	case []interface{}:
+		i, err := arrayIndex(tokens[0], len(node), false)
+		if err != nil {
+			return nil, err
+		}
+		updated, err := updateValue(node[i], tokens[1:], update)
+		if err != nil {
+			return nil, err
+		}
+		node[i] = updated
+		return node, nil
+	default:
+		return nil, fmt.Errorf("no such path: %s", tokens[0])
+	}
+}
+
+func addValue(value interface{}, tokens []string, added interface{}) (interface{}, error) {
+	if len(tokens) == 0 {
+		return added, nil
+	}
+	return updateValue(value, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[token] = added
+			return node, nil
+		case []interface{}:
+			i, err := arrayIndex(token, len(node), true)
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, nil)
+			copy(node[i+1:], node[i:])
+			node[i] = added
+			return node, nil
+		default:
+			return nil, fmt.Errorf("no such path: %s", token)
+		}
+	})
+}
+
+func removeValue(value interface{}, tokens []string) (interface{}, error) {
+	if len(tokens) == 0 {
+		return nil, fmt.Errorf("cannot remove the root")
+	}
+	return updateValue(value, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := node[token]; !ok {
+				return nil, fmt.Errorf("no such member: %s", token)
+			}
+			delete(node, token)
+			return node, nil
+		case []interface{}:
+			i, err := arrayIndex(token, len(node), false)
+			if err != nil {
+				return nil, err
+			}
+			return append(node[:i], node[i+1:]...), nil
+		default:
+			return nil, fmt.Errorf("no such path: %s", token)
+		}
+	})
+}
+
+func replaceValue(value interface{}, tokens []string, replaced interface{}) (interface{}, error) {
+	if _, err := getValue(value, tokens); err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return replaced, nil
+	}
+	return updateValue(value, tokens, func(parent interface{}, token string) (interface{}, error) {
+		switch node := parent.(type) {
+		case map[string]interface{}:
+			node[token] = replaced
+			return node, nil
+		case []interface{}:
+			i, _ := arrayIndex(token, len(node), false)
+			node[i] = replaced
+			return node, nil
+		default:
+			return nil, fmt.Errorf("no such path: %s", token)
+		}
+	})
+}
+
+// applyTextPatch applies the unified diff to the text.
+func applyTextPatch(text, patch string) (string, error) {
+	source := splitLines(text)
+	patchLines := splitLines(patch)
+
+	var result []string
+	pos := 0 // the index of the next line of the source
+	inHunk := false
+	for i, line := range patchLines {
+		if strings.HasPrefix(line, "@@") {
+			start, count, err := parseHunkHeader(line)
+			if err != nil {
+				return "", err
+			}
+			// The start of an empty hunk is the line before the hunk.
+			index := start - 1
+			if count == 0 {
+				index = start
+			}
+			if index < pos || index > len(source) {
+				return "", fmt.Errorf("invalid hunk: %s", strings.TrimSpace(line))
+			}
+			result = append(result, source[pos:index]...)
+			pos = index
+			inHunk = true
+			continue
+		}
+		if !inHunk || len(line) == 0 || line[0] == '\\' {
+			// The header of the patch or "\ No newline at end of file"
+			continue
+		}
+
+		content := line[1:]
+		if line == "\n" {
+			// An empty context line whose leading space was trimmed.
+			content = line
+		}
+		if i+1 < len(patchLines) && strings.HasPrefix(patchLines[i+1], "\\") {
+			content = strings.TrimSuffix(content, "\n")
+		}
+		switch line[0] {
+		case ' ', '\n', '-':
+			if pos >= len(source) || source[pos] != content {
+				return "", fmt.Errorf("the patch does not apply at line %v", pos+1)
+			}
+			if line[0] != '-' {
+				result = append(result, content)
+			}
+			pos++
+		case '+':
+			result = append(result, content)
+		default:
+			return "", fmt.Errorf("invalid line of the patch: %q", line)
+		}
+	}
+	result = append(result, source[pos:]...)
+	return strings.Join(result, ""), nil
+}
+
+// splitLines splits the text into the lines which end with their newlines except the last line.
+func splitLines(text string) []string {
+	lines := strings.SplitAfter(text, "\n")
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// parseHunkHeader parses the start and the number of the original lines from the hunk header, e.g.
+// "@@ -1,3 +1,4 @@".
+func parseHunkHeader(header string) (start, count int, err error) {
+	fields := strings.Fields(header)
+	if len(fields) < 3 || !strings.HasPrefix(fields[1], "-") {
+		return 0, 0, fmt.Errorf("invalid hunk header: %s", strings.TrimSpace(header))
+	}
+	original := strings.SplitN(fields[1][1:], ",", 2)
+	if start, err = strconv.Atoi(original[0]); err != nil {
+		return 0, 0, fmt.Errorf("invalid hunk header: %s", strings.TrimSpace(header))
+	}
+	count = 1
+	if len(original) == 2 {
+		if count, err = strconv.Atoi(original[1]); err != nil {
+			return 0, 0, fmt.Errorf("invalid hunk header: %s", strings.TrimSpace(header))
+		}
+	}
+	return start, count, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.linecorp.com/centraldogma"
+)
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		document   string
+		operations []centraldogma.JSONPatchOperation
+		want       string
+	}{
+		{`{"a":1}`, []centraldogma.JSONPatchOperation{{Op: "add", Path: "/b", Value: 2}}, `{"a":1,"b":2}`},
+		{`{"a":[1,3]}`, []centraldogma.JSONPatchOperation{{Op: "add", Path: "/a/1", Value: 2}}, `{"a":[1,2,3]}`},
+		{`{"a":[1,2]}`, []centraldogma.JSONPatchOperation{{Op: "remove", Path: "/a/0"}}, `{"a":[2]}`},
+		{`{"a":{"b":1}}`, []centraldogma.JSONPatchOperation{{Op: "replace", Path: "/a/b", Value: "x"}},
+			`{"a":{"b":"x"}}`},
+		{`{"a":1}`, []centraldogma.JSONPatchOperation{{Op: "move", From: "/a", Path: "/b"}}, `{"b":1}`},
+		{`{"a":[1]}`, []centraldogma.JSONPatchOperation{{Op: "copy", From: "/a", Path: "/b"}},
+			`{"a":[1],"b":[1]}`},
+		{`{"a/b":1}`, []centraldogma.JSONPatchOperation{{Op: "test", Path: "/a~1b", Value: float64(1)}},
+			`{"a/b":1}`},
+		{`null`, []centraldogma.JSONPatchOperation{{Op: "add", Path: "", Value: map[string]interface{}{}}}, `{}`},
+		{`{"a":1}`, []centraldogma.JSONPatchOperation{{Op: "test", Path: "/a", Value: float64(2)}}, ""},
+		{`{"a":1}`, []centraldogma.JSONPatchOperation{{Op: "replace", Path: "/b", Value: 2}}, ""},
+		{`{"a":[1]}`, []centraldogma.JSONPatchOperation{{Op: "remove", Path: "/a/1"}}, ""},
+	}
+
+	for _, test := range tests {
+		var document interface{}
+		if err := json.Unmarshal([]byte(test.document), &document); err != nil {
+			t.Fatal(err)
+		}
+		patched, err := applyJSONPatch(document, test.operations)
+		if len(test.want) == 0 {
+			if err == nil {
+				t.Errorf("applyJSONPatch(%s, %+v) should fail", test.document, test.operations)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("applyJSONPatch(%s, %+v) returned error: %v", test.document, test.operations, err)
+			continue
+		}
+		if got, _ := json.Marshal(patched); string(got) != test.want {
+			t.Errorf("applyJSONPatch(%s, %+v) = %s, want %s", test.document, test.operations, got, test.want)
+		}
+	}
+}
+
+func TestApplyTextPatch(t *testing.T) {
+	tests := []struct {
+		text  string
+		patch string
+		want  string
+	}{
+		{"a\nb\nc\n", "--- /a.txt\n+++ /a.txt\n@@ -1,3 +1,3 @@\n a\n-b\n+x\n c\n", "a\nx\nc\n"},
+		{"", "@@ -0,0 +1,2 @@\n+a\n+b\n", "a\nb\n"},
+		{"a\nb", "@@ -1,2 +1,2 @@\n a\n-b\n\\ No newline at end of file\n+b\n", "a\nb\n"},
+		{"a\n", "@@ -1 +1,2 @@\n a\n+b\n\\ No newline at end of file\n", "a\nb"},
+		{"a\nb\nc\nd\n", "@@ -4 +4 @@\n-d\n+e\n", "a\nb\nc\ne\n"},
+		{"a\n", "@@ -1 +1 @@\n-x\n+y\n", ""},
+	}
+
+	for _, test := range tests {
+		got, err := applyTextPatch(test.text, test.patch)
+		if len(test.want) == 0 {
+			if err == nil {
+				t.Errorf("applyTextPatch(%q, %q) should fail", test.text, test.patch)
+			}
+			continue
+		}
+		if err != nil || got != test.want {
+			t.Errorf("applyTextPatch(%q, %q) = %q, %v, want %q", test.text, test.patch, got, err, test.want)
+		}
+	}
+}
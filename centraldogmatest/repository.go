@@ -0,0 +1,385 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"go.linecorp.com/centraldogma"
+)
+
+const exceptionPrefix = "com.linecorp.centraldogma.common."
+
+// apiError is the error which is sent to the client as the error response of the server.
+type apiError struct {
+	statusCode int
+	exception  string
+	message    string
+}
+
+func (e *apiError) Error() string {
+	return e.message
+}
+
+func newAPIError(statusCode int, exception, format string, args ...interface{}) *apiError {
+	return &apiError{statusCode: statusCode, exception: exception, message: fmt.Sprintf(format, args...)}
+}
+
+func badRequest(format string, args ...interface{}) *apiError {
+	return newAPIError(http.StatusBadRequest, "IllegalArgumentException", format, args...)
+}
+
+func changeConflict(format string, args ...interface{}) *apiError {
+	return newAPIError(http.StatusConflict, "ChangeConflictException", format, args...)
+}
+
+type project struct {
+	name      string
+	createdAt time.Time
+	removed   bool
+	repos     map[string]*repository
+}
+
+type repository struct {
+	name      string
+	createdAt time.Time
+	removed   bool
+	commits   []*commit // commits[i] is the commit of the revision i+1.
+}
+
+type commit struct {
+	revision      int64
+	author        centraldogma.Author
+	commitMessage centraldogma.CommitMessage
+	pushedAt      time.Time
+	files         map[string]*file // the files at the revision, which are never modified.
+	changedPaths  []string
+}
+
+type file struct {
+	entryType centraldogma.EntryType // JSON or Text
+	content   []byte                 // the compact JSON or the text
+}
+
+func (f *file) equal(other *file) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	return f.entryType == other.entryType && bytes.Equal(f.content, other.content)
+}
+
+func newRepository(name string, author centraldogma.Author, now time.Time) *repository {
+	return &repository{
+		name:      name,
+		createdAt: now,
+		commits: []*commit{{
+			revision:      int64(centraldogma.Init),
+			author:        author,
+			commitMessage: centraldogma.CommitMessage{Summary: "Create a new repository"},
+			pushedAt:      now,
+			files:         map[string]*file{},
+		}},
+	}
+}
+
+func (r *repository) head() *commit {
+	return r.commits[len(r.commits)-1]
+}
+
+// normalize returns the absolute revision of the revision. An empty revision is the head revision.
+func (r *repository) normalize(revision string) (int64, error) {
+	parsed, err := centraldogma.ParseRevision(revision)
+	if err != nil {
+		return 0, badRequest("%v", err)
+	}
+	head := r.head().revision
+	normalized := int64(parsed)
+	if parsed.IsRelative() {
+		normalized = head + normalized + 1
+	}
+	if normalized <= 0 || normalized > head {
+		return 0, newAPIError(http.StatusNotFound, "RevisionNotFoundException",
+			"revision %v does not exist (head: %v)", revision, head)
+	}
+	return normalized, nil
+}
+
+// commitAt returns the commit of the revision which is normalized.
+func (r *repository) commitAt(revision string) (*commit, error) {
+	normalized, err := r.normalize(revision)
+	if err != nil {
+		return nil, err
+	}
+	return r.commits[normalized-1], nil
+}
+
+// apply applies the changes to the files at the base revision and returns the files after the changes.
+func (r *repository) apply(base *commit, changes []*centraldogma.Change) (map[string]*file, error) {
+	if len(changes) == 0 {
+		return nil, badRequest("no changes")
+	}
+
+	head := r.head()
+	files := make(map[string]*file, len(head.files))
+	for p, f := range head.files {
+		files[p] = f
+	}
+	for _, change := range changes {
+		if base != head {
+			// The change conflicts if the files which it modifies were modified after the base revision.
+			for _, p := range modifiedPaths(change) {
+				if !base.files[p].equal(head.files[p]) {
+					return nil, changeConflict("%s was modified after the base revision %v", p, base.revision)
+				}
+			}
+		}
+		if err := applyChange(files, change); err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+func modifiedPaths(change *centraldogma.Change) []string {
+	if change.Type == centraldogma.Rename {
+		if newPath, ok := change.Content.(string); ok {
+			return []string{change.Path, newPath}
+		}
+	}
+	return []string{change.Path}
+}
+
+func applyChange(files map[string]*file, change *centraldogma.Change) error {
+	if !strings.HasPrefix(change.Path, "/") || strings.HasSuffix(change.Path, "/") {
+		return badRequest("invalid file path: %s", change.Path)
+	}
+
+	existing := files[change.Path]
+	switch change.Type {
+	case centraldogma.UpsertJSON:
+		if !strings.HasSuffix(strings.ToLower(change.Path), ".json") {
+			return badRequest("the extension of the JSON file should be .json: %s", change.Path)
+		}
+		content, err := json.Marshal(change.Content)
+		if err != nil {
+			return badRequest("invalid JSON content: %s, err=%v", change.Path, err)
+		}
+		files[change.Path] = &file{entryType: centraldogma.JSON, content: content}
+	case centraldogma.UpsertText:
+		text, ok := change.Content.(string)
+		if !ok {
+			return badRequest("the content of the text file should be a string: %s", change.Path)
+		}
+		files[change.Path] = &file{entryType: centraldogma.Text, content: []byte(text)}
+	case centraldogma.Remove:
+		if existing == nil {
+			return changeConflict("non-existent file: %s", change.Path)
+		}
+		delete(files, change.Path)
+	case centraldogma.Rename:
+		newPath, ok := change.Content.(string)
+		if !ok || !strings.HasPrefix(newPath, "/") {
+			return badRequest("invalid new path: %v", change.Content)
+		}
+		if existing == nil {
+			return changeConflict("non-existent file: %s", change.Path)
+		}
+		if files[newPath] != nil {
+			return changeConflict("a file exists at the new path: %s", newPath)
+		}
+		delete(files, change.Path)
+		files[newPath] = existing
+	case centraldogma.ApplyJSONPatch:
+		var value interface{}
+		if existing != nil {
+			if existing.entryType != centraldogma.JSON {
+				return changeConflict("not a JSON file: %s", change.Path)
+			}
+			if err := json.Unmarshal(existing.content, &value); err != nil {
+				return err
+			}
+		}
+		var operations []centraldogma.JSONPatchOperation
+		if err := remarshal(change.Content, &operations); err != nil {
+			return badRequest("invalid JSON patch: %s, err=%v", change.Path, err)
+		}
+		patched, err := applyJSONPatch(value, operations)
+		if err != nil {
+			return changeConflict("failed to apply the JSON patch to %s: %v", change.Path, err)
+		}
+		content, err := json.Marshal(patched)
+		if err != nil {
+			return err
+		}
+		files[change.Path] = &file{entryType: centraldogma.JSON, content: content}
+	case centraldogma.ApplyTextPatch:
+		patch, ok := change.Content.(string)
+		if !ok {
+			return badRequest("the text patch should be a string: %s", change.Path)
+		}
+		var text string
+		if existing != nil {
+			if existing.entryType != centraldogma.Text {
+				return changeConflict("not a text file: %s", change.Path)
+			}
+			text = string(existing.content)
+		}
+		patched, err := applyTextPatch(text, patch)
+		if err != nil {
+			return changeConflict("failed to apply the text patch to %s: %v", change.Path, err)
+		}
+		files[change.Path] = &file{entryType: centraldogma.Text, content: []byte(patched)}
+	default:
+		return badRequest("unsupported change type: %v", change.Type)
+	}
+	return nil
+}
+
+// remarshal converts the value decoded from JSON into the value pointed to by v.
+func remarshal(value interface{}, v interface{}) error {
+	b, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// push adds a commit which consists of the files if they are different from the files at the head revision.
+func (r *repository) push(files map[string]*file, author centraldogma.Author,
+	commitMessage centraldogma.CommitMessage, now time.Time) (*commit, error) {
+	head := r.head()
+	changedPaths := diffPaths(head.files, files, "/**")
+	if len(changedPaths) == 0 {
+		return nil, newAPIError(http.StatusConflict, "RedundantChangeException", "no changes were made")
+	}
+
+	c := &commit{
+		revision:      head.revision + 1,
+		author:        author,
+		commitMessage: commitMessage,
+		pushedAt:      now,
+		files:         files,
+		changedPaths:  changedPaths,
+	}
+	r.commits = append(r.commits, c)
+	return c, nil
+}
+
+// diffPaths returns the sorted paths of the files which match the path pattern and differ between from and to.
+func diffPaths(from, to map[string]*file, pathPattern string) []string {
+	var paths []string
+	for p, f := range from {
+		if !f.equal(to[p]) && matchPathPattern(pathPattern, p) {
+			paths = append(paths, p)
+		}
+	}
+	for p := range to {
+		if from[p] == nil && matchPathPattern(pathPattern, p) {
+			paths = append(paths, p)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// diff returns the change which turns the file at the path in from into the one in to, or nil if they are
+// the same.
+func diff(from, to map[string]*file, filePath string) *centraldogma.Change {
+	before, after := from[filePath], to[filePath]
+	switch {
+	case before.equal(after):
+		return nil
+	case after == nil:
+		return &centraldogma.Change{Path: filePath, Type: centraldogma.Remove}
+	case after.entryType == centraldogma.JSON:
+		return &centraldogma.Change{Path: filePath, Type: centraldogma.UpsertJSON,
+			Content: json.RawMessage(after.content)}
+	default:
+		return &centraldogma.Change{Path: filePath, Type: centraldogma.UpsertText, Content: string(after.content)}
+	}
+}
+
+func diffs(from, to map[string]*file, pathPattern string) []*centraldogma.Change {
+	changes := []*centraldogma.Change{}
+	for _, p := range diffPaths(from, to, pathPattern) {
+		changes = append(changes, diff(from, to, p))
+	}
+	return changes
+}
+
+// directories returns the sorted paths of the directories which contain the files.
+func directories(files map[string]*file) []string {
+	seen := make(map[string]bool)
+	var dirs []string
+	for p := range files {
+		for dir := path.Dir(p); dir != "/" && !seen[dir]; dir = path.Dir(dir) {
+			seen[dir] = true
+			dirs = append(dirs, dir)
+		}
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// isPathPattern returns whether the path is a pattern which may match more than one file.
+func isPathPattern(p string) bool {
+	return strings.ContainsAny(p, "*?[,")
+}
+
+// matchPathPattern returns whether the file path matches the path pattern which is a variant of glob,
+// e.g. "/**/*.json". The path pattern can consist of the comma-separated patterns.
+func matchPathPattern(pathPattern, filePath string) bool {
+	for _, pattern := range strings.Split(pathPattern, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if len(pattern) == 0 {
+			continue
+		}
+		if !strings.HasPrefix(pattern, "/") {
+			pattern = "/**/" + pattern
+		}
+		if matchSegments(strings.Split(pattern[1:], "/"), strings.Split(filePath[1:], "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(patterns, segments []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(patterns[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if matched, err := path.Match(patterns[0], segments[0]); err != nil || !matched {
+			return false
+		}
+		patterns, segments = patterns[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
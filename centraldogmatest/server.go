@@ -0,0 +1,701 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// Package centraldogmatest provides an in-memory Central Dogma server for testing the code which uses
+// the Client. For example:
+//
+//	server := centraldogmatest.NewServer()
+//	defer server.Close()
+//
+//	client, err := server.NewClient()
+//	if err != nil {
+//	    panic(err)
+//	}
+//	client.CreateProject(ctx, "foo")
+//	client.CreateRepository(ctx, "foo", "bar")
+//	client.Push(ctx, "foo", "bar", "-1", commitMessage, changes)
+//
+// The server implements the REST API of the projects, the repositories, the contents, the pushes,
+// the watches and the history. The JSON path queries, the merge queries, the metadata, the tokens and
+// the mirrors are not supported. The diffs are returned as UpsertJSON, UpsertText and Remove changes
+// rather than patches.
+package centraldogmatest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.linecorp.com/centraldogma"
+)
+
+const (
+	pathPrefix = "/api/v1/projects"
+
+	defaultMaxCommits   = 100
+	defaultWatchTimeout = time.Minute
+)
+
+// DefaultAuthor is the author of the commits and the creator of the projects and the repositories.
+var DefaultAuthor = centraldogma.Author{Name: "dogma", Email: "dogma@localhost.localdomain"}
+
+// Server is an in-memory Central Dogma server which listens on a local address.
+type Server struct {
+	// URL is the base URL of the server, e.g. "http://127.0.0.1:1234".
+	URL string
+
+	server *httptest.Server
+
+	lock     sync.Mutex
+	projects map[string]*project
+	updated  chan struct{} // closed and replaced whenever a commit is added.
+}
+
+// NewServer starts and returns a new Server. The caller should call Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		projects: make(map[string]*project),
+		updated:  make(chan struct{}),
+	}
+	s.server = httptest.NewServer(s)
+	s.URL = s.server.URL
+	return s
+}
+
+// Close shuts down the server and blocks until all the outstanding requests have completed.
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// NewClient returns a new Client which sends the requests to the server.
+func (s *Server) NewClient(opts ...centraldogma.ClientOption) (*centraldogma.Client, error) {
+	opts = append([]centraldogma.ClientOption{centraldogma.WithTransport(http.DefaultTransport)}, opts...)
+	return centraldogma.NewClient(s.URL, opts...)
+}
+
+// ServeHTTP implements http.Handler.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != pathPrefix && !strings.HasPrefix(r.URL.Path, pathPrefix+"/") {
+		writeError(w, newAPIError(http.StatusNotFound, "", "unsupported path: %s", r.URL.Path))
+		return
+	}
+
+	// {project}/repos/{repo}/{action}/{rest}
+	segments := strings.SplitN(strings.TrimPrefix(r.URL.Path, pathPrefix), "/", 6)[1:]
+	switch {
+	case len(segments) == 0 || (len(segments) == 1 && len(segments[0]) == 0):
+		s.serveProjects(w, r)
+	case len(segments) == 1:
+		s.serveProject(w, r, segments[0], false)
+	case len(segments) == 2 && segments[1] == "removed":
+		s.serveProject(w, r, segments[0], true)
+	case segments[1] != "repos":
+		writeError(w, newAPIError(http.StatusNotFound, "", "unsupported path: %s", r.URL.Path))
+	case len(segments) == 2:
+		s.serveRepos(w, r, segments[0])
+	case len(segments) == 3:
+		s.serveRepo(w, r, segments[0], segments[2], false)
+	case len(segments) == 4 && segments[3] == "removed":
+		s.serveRepo(w, r, segments[0], segments[2], true)
+	case len(segments) == 4:
+		s.serveContents(w, r, segments[0], segments[2], segments[3], "")
+	default:
+		s.serveContents(w, r, segments[0], segments[2], segments[3], "/"+segments[4])
+	}
+}
+
+func (s *Server) serveProjects(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		removed := r.URL.Query().Get("status") == "removed"
+		projects := []*centraldogma.Project{}
+		for _, p := range s.projects {
+			if p.removed == removed {
+				projects = append(projects, projectResponse(p))
+			}
+		}
+		sort.Slice(projects, func(i, j int) bool { return projects[i].Name < projects[j].Name })
+		writeJSON(w, http.StatusOK, paginate(r, projects))
+	case http.MethodPost:
+		var body struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Name) == 0 {
+			writeError(w, badRequest("invalid project name"))
+			return
+		}
+		if _, ok := s.projects[body.Name]; ok {
+			writeError(w, newAPIError(http.StatusConflict, "ProjectExistsException",
+				"project %s already exists", body.Name))
+			return
+		}
+		p := &project{name: body.Name, createdAt: time.Now(), repos: make(map[string]*repository)}
+		s.projects[p.name] = p
+		writeJSON(w, http.StatusCreated, projectResponse(p))
+	default:
+		writeError(w, methodNotAllowed(r))
+	}
+}
+
+// paginate returns the page of the projects which is specified by the offset and limit parameters.
+func paginate(r *http.Request, projects []*centraldogma.Project) []*centraldogma.Project {
+	offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+	if offset < 0 || offset > len(projects) {
+		offset = len(projects)
+	}
+	projects = projects[offset:]
+	if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit >= 0 && limit < len(projects) {
+		projects = projects[:limit]
+	}
+	return projects
+}
+
+func (s *Server) serveProject(w http.ResponseWriter, r *http.Request, projectName string, purge bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	p, ok := s.projects[projectName]
+	if !ok || (p.removed != (purge || r.Method == http.MethodPatch)) {
+		writeError(w, newAPIError(http.StatusNotFound, "ProjectNotFoundException",
+			"project %s does not exist", projectName))
+		return
+	}
+	switch {
+	case r.Method == http.MethodDelete && purge:
+		delete(s.projects, projectName)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		p.removed = true
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPatch && !purge:
+		p.removed = false
+		writeJSON(w, http.StatusOK, projectResponse(p))
+	default:
+		writeError(w, methodNotAllowed(r))
+	}
+}
+
+func (s *Server) serveRepos(w http.ResponseWriter, r *http.Request, projectName string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	p, err := s.project(projectName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		removed := r.URL.Query().Get("status") == "removed"
+		repos := []*centraldogma.Repository{}
+		for _, repo := range p.repos {
+			if repo.removed == removed {
+				repos = append(repos, repositoryResponse(p, repo))
+			}
+		}
+		sort.Slice(repos, func(i, j int) bool { return repos[i].Name < repos[j].Name })
+		writeJSON(w, http.StatusOK, repos)
+	case http.MethodPost:
+		var body struct {
+			Name string `json:"name"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body.Name) == 0 {
+			writeError(w, badRequest("invalid repository name"))
+			return
+		}
+		if _, ok := p.repos[body.Name]; ok {
+			writeError(w, newAPIError(http.StatusConflict, "RepositoryExistsException",
+				"repository %s/%s already exists", projectName, body.Name))
+			return
+		}
+		repo := newRepository(body.Name, DefaultAuthor, time.Now())
+		p.repos[repo.name] = repo
+		s.notifyUpdate()
+		writeJSON(w, http.StatusCreated, repositoryResponse(p, repo))
+	default:
+		writeError(w, methodNotAllowed(r))
+	}
+}
+
+func (s *Server) serveRepo(w http.ResponseWriter, r *http.Request, projectName, repoName string, purge bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	p, err := s.project(projectName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	repo, ok := p.repos[repoName]
+	if !ok || (repo.removed != (purge || r.Method == http.MethodPatch)) {
+		writeError(w, newAPIError(http.StatusNotFound, "RepositoryNotFoundException",
+			"repository %s/%s does not exist", projectName, repoName))
+		return
+	}
+	switch {
+	case r.Method == http.MethodDelete && purge:
+		delete(p.repos, repoName)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodDelete:
+		repo.removed = true
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPatch && !purge:
+		repo.removed = false
+		writeJSON(w, http.StatusOK, repositoryResponse(p, repo))
+	default:
+		writeError(w, methodNotAllowed(r))
+	}
+}
+
+func (s *Server) serveContents(w http.ResponseWriter, r *http.Request,
+	projectName, repoName, action, rest string) {
+	if action == "contents" && r.Method == http.MethodGet && len(r.Header.Get("If-None-Match")) != 0 {
+		s.serveWatch(w, r, projectName, repoName, rest)
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	repo, err := s.repository(projectName, repoName)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	query := r.URL.Query()
+	if len(query["jsonpath"]) != 0 {
+		writeError(w, newAPIError(http.StatusBadRequest, "QueryExecutionException",
+			"JSON path queries are not supported"))
+		return
+	}
+
+	var result interface{}
+	switch {
+	case action == "revision" && r.Method == http.MethodGet:
+		var revision int64
+		if revision, err = repo.normalize(strings.TrimPrefix(rest, "/")); err == nil {
+			result = map[string]int64{"revision": revision}
+		}
+	case action == "list" && r.Method == http.MethodGet:
+		result, err = listEntries(repo, query.Get("revision"), rest)
+	case action == "contents" && r.Method == http.MethodGet:
+		result, err = getEntries(r, repo, query.Get("revision"), rest)
+	case action == "contents" && r.Method == http.MethodPost:
+		result, err = s.push(r, repo)
+	case action == "commits" && r.Method == http.MethodGet:
+		result, err = getHistory(repo, strings.TrimPrefix(rest, "/"), query.Get("to"), query.Get("path"),
+			query.Get("maxCommits"))
+	case action == "compare" && r.Method == http.MethodGet:
+		result, err = compare(repo, query.Get("from"), query.Get("to"), query.Get("path"), query.Get("pathPattern"))
+	case action == "preview" && r.Method == http.MethodPost:
+		result, err = preview(r, repo)
+	default:
+		err = newAPIError(http.StatusNotFound, "", "unsupported request: %s %s", r.Method, r.URL.Path)
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if raw, ok := result.(rawContent); ok {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.Write(raw)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
+
+// rawContent is the content of a text file which is requested with the viewRaw parameter.
+type rawContent []byte
+
+func (s *Server) project(projectName string) (*project, error) {
+	p, ok := s.projects[projectName]
+	if !ok || p.removed {
+		return nil, newAPIError(http.StatusNotFound, "ProjectNotFoundException",
+			"project %s does not exist", projectName)
+	}
+	return p, nil
+}
+
+func (s *Server) repository(projectName, repoName string) (*repository, error) {
+	p, err := s.project(projectName)
+	if err != nil {
+		return nil, err
+	}
+	repo, ok := p.repos[repoName]
+	if !ok || repo.removed {
+		return nil, newAPIError(http.StatusNotFound, "RepositoryNotFoundException",
+			"repository %s/%s does not exist", projectName, repoName)
+	}
+	return repo, nil
+}
+
+// notifyUpdate wakes up the watches. It must be called with the lock held.
+func (s *Server) notifyUpdate() {
+	close(s.updated)
+	s.updated = make(chan struct{})
+}
+
+func listEntries(repo *repository, revision, pathPattern string) (interface{}, error) {
+	c, err := repo.commitAt(revision)
+	if err != nil {
+		return nil, err
+	}
+	if len(pathPattern) == 0 || pathPattern == "/" {
+		pathPattern = "/**"
+	}
+
+	entries := []*entryResponse{}
+	for _, dir := range directories(c.files) {
+		if matchPathPattern(pathPattern, dir) {
+			entries = append(entries, &entryResponse{Path: dir, Type: centraldogma.Directory.String()})
+		}
+	}
+	for _, p := range sortedPaths(c.files) {
+		if matchPathPattern(pathPattern, p) {
+			entries = append(entries, &entryResponse{Path: p, Type: c.files[p].entryType.String()})
+		}
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Path < entries[j].Path })
+	return entries, nil
+}
+
+func getEntries(r *http.Request, repo *repository, revision, filePath string) (interface{}, error) {
+	c, err := repo.commitAt(revision)
+	if err != nil {
+		return nil, err
+	}
+
+	if isPathPattern(filePath) {
+		entries := []*entryResponse{}
+		for _, p := range sortedPaths(c.files) {
+			if matchPathPattern(filePath, p) {
+				entries = append(entries, newEntryResponse(r, p, c))
+			}
+		}
+		return entries, nil
+	}
+
+	f, ok := c.files[filePath]
+	if !ok {
+		return nil, entryNotFound(filePath, c.revision)
+	}
+	if r.URL.Query().Get("viewRaw") == "true" && f.entryType == centraldogma.Text {
+		return rawContent(f.content), nil
+	}
+	return newEntryResponse(r, filePath, c), nil
+}
+
+func entryNotFound(filePath string, revision int64) error {
+	return newAPIError(http.StatusNotFound, "EntryNotFoundException",
+		"entry %s does not exist (revision: %v)", filePath, revision)
+}
+
+func (s *Server) push(r *http.Request, repo *repository) (interface{}, error) {
+	base, err := repo.commitAt(r.URL.Query().Get("revision"))
+	if err != nil {
+		return nil, err
+	}
+	var body struct {
+		CommitMessage *centraldogma.CommitMessage `json:"commitMessage"`
+		Changes       []*centraldogma.Change      `json:"changes"`
+	}
+	if err = json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return nil, badRequest("invalid push: %v", err)
+	}
+	if body.CommitMessage == nil || len(body.CommitMessage.Summary) == 0 {
+		return nil, badRequest("the summary of the commit message should not be empty")
+	}
+
+	files, err := repo.apply(base, body.Changes)
+	if err != nil {
+		return nil, err
+	}
+	c, err := repo.push(files, DefaultAuthor, *body.CommitMessage, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	s.notifyUpdate()
+	return &centraldogma.PushResult{Revision: c.revision, PushedAt: formatTime(c.pushedAt)}, nil
+}
+
+func preview(r *http.Request, repo *repository) (interface{}, error) {
+	base, err := repo.commitAt(r.URL.Query().Get("revision"))
+	if err != nil {
+		return nil, err
+	}
+	var changes []*centraldogma.Change
+	if err = json.NewDecoder(r.Body).Decode(&changes); err != nil {
+		return nil, badRequest("invalid changes: %v", err)
+	}
+
+	files, err := repo.apply(base, changes)
+	if err != nil {
+		return nil, err
+	}
+	return diffs(repo.head().files, files, "/**"), nil
+}
+
+func getHistory(repo *repository, from, to, pathPattern, maxCommits string) (interface{}, error) {
+	fromRevision, err := repo.normalize(from)
+	if err != nil {
+		return nil, err
+	}
+	toRevision := int64(centraldogma.Init)
+	if len(to) != 0 {
+		if toRevision, err = repo.normalize(to); err != nil {
+			return nil, err
+		}
+	}
+	limit := defaultMaxCommits
+	if len(maxCommits) != 0 {
+		if limit, err = strconv.Atoi(maxCommits); err != nil || limit <= 0 {
+			return nil, badRequest("invalid maxCommits: %s", maxCommits)
+		}
+	}
+	if len(pathPattern) == 0 {
+		pathPattern = "/**"
+	}
+
+	step := int64(-1)
+	if fromRevision < toRevision {
+		step = 1
+	}
+	commits := []*centraldogma.Commit{}
+	for revision := fromRevision; len(commits) < limit; revision += step {
+		c := repo.commits[revision-1]
+		if pathPattern == "/**" || matchesAny(pathPattern, c.changedPaths) {
+			commits = append(commits, &centraldogma.Commit{
+				Revision:      c.revision,
+				Author:        c.author,
+				CommitMessage: c.commitMessage,
+				PushedAt:      formatTime(c.pushedAt),
+			})
+		}
+		if revision == toRevision {
+			break
+		}
+	}
+	return commits, nil
+}
+
+func matchesAny(pathPattern string, paths []string) bool {
+	for _, p := range paths {
+		if matchPathPattern(pathPattern, p) {
+			return true
+		}
+	}
+	return false
+}
+
+func compare(repo *repository, from, to, filePath, pathPattern string) (interface{}, error) {
+	fromCommit, err := repo.commitAt(from)
+	if err != nil {
+		return nil, err
+	}
+	toCommit, err := repo.commitAt(to)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(filePath) == 0 {
+		if len(pathPattern) == 0 {
+			pathPattern = "/**"
+		}
+		return diffs(fromCommit.files, toCommit.files, pathPattern), nil
+	}
+
+	if change := diff(fromCommit.files, toCommit.files, filePath); change != nil {
+		return change, nil
+	}
+	f := toCommit.files[filePath]
+	switch {
+	case f == nil:
+		return nil, entryNotFound(filePath, toCommit.revision)
+	case f.entryType == centraldogma.JSON:
+		return &centraldogma.Change{Path: filePath, Type: centraldogma.ApplyJSONPatch, Content: []interface{}{}}, nil
+	default:
+		return &centraldogma.Change{Path: filePath, Type: centraldogma.ApplyTextPatch, Content: ""}, nil
+	}
+}
+
+// serveWatch responds when the watched file or the files which match the path pattern are modified after
+// the last known revision, or responds with 304 Not Modified when the watch times out.
+func (s *Server) serveWatch(w http.ResponseWriter, r *http.Request, projectName, repoName, pathPattern string) {
+	lastKnownRevision := r.Header.Get("If-None-Match")
+	timeout := defaultWatchTimeout
+	notifyEntryNotFound := false
+	for _, preference := range strings.Split(r.Header.Get("Prefer"), ",") {
+		preference = strings.TrimSpace(preference)
+		if strings.HasPrefix(preference, "wait=") {
+			if seconds, err := strconv.ParseFloat(strings.TrimPrefix(preference, "wait="), 64); err == nil {
+				timeout = time.Duration(seconds * float64(time.Second))
+			}
+		} else if preference == "notify-entry-not-found=true" {
+			notifyEntryNotFound = true
+		}
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		s.lock.Lock()
+		result, err := s.checkWatch(r, projectName, repoName, lastKnownRevision, pathPattern, notifyEntryNotFound)
+		updated := s.updated
+		s.lock.Unlock()
+
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if result != nil {
+			writeJSON(w, http.StatusOK, result)
+			return
+		}
+		select {
+		case <-updated:
+		case <-timer.C:
+			w.WriteHeader(http.StatusNotModified)
+			return
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+type watchResponse struct {
+	Revision int64          `json:"revision"`
+	Entry    *entryResponse `json:"entry,omitempty"`
+}
+
+// checkWatch returns the response of the watch if the files are modified after the last known revision.
+// It must be called with the lock held.
+func (s *Server) checkWatch(r *http.Request, projectName, repoName, lastKnownRevision, pathPattern string,
+	notifyEntryNotFound bool) (*watchResponse, error) {
+	repo, err := s.repository(projectName, repoName)
+	if err != nil {
+		return nil, err
+	}
+	lastKnown, err := repo.commitAt(lastKnownRevision)
+	if err != nil {
+		return nil, err
+	}
+	head := repo.head()
+
+	if isPathPattern(pathPattern) {
+		if len(diffPaths(lastKnown.files, head.files, pathPattern)) == 0 {
+			return nil, nil
+		}
+		return &watchResponse{Revision: head.revision}, nil
+	}
+
+	f := head.files[pathPattern]
+	if f == nil {
+		if notifyEntryNotFound {
+			return nil, entryNotFound(pathPattern, head.revision)
+		}
+		return nil, nil
+	}
+	if f.equal(lastKnown.files[pathPattern]) {
+		return nil, nil
+	}
+	return &watchResponse{Revision: head.revision, Entry: newEntryResponse(r, pathPattern, head)}, nil
+}
+
+// entryResponse is the wire format of centraldogma.Entry. The content of a JSON entry is a JSON value and
+// the content of a text entry is a string.
+type entryResponse struct {
+	Path     string      `json:"path"`
+	Type     string      `json:"type"`
+	Content  interface{} `json:"content,omitempty"`
+	Revision int64       `json:"revision,omitempty"`
+	URL      string      `json:"url,omitempty"`
+}
+
+func newEntryResponse(r *http.Request, filePath string, c *commit) *entryResponse {
+	f := c.files[filePath]
+	entry := &entryResponse{
+		Path:     filePath,
+		Type:     f.entryType.String(),
+		Revision: c.revision,
+		URL:      strings.SplitN(r.URL.Path, "/contents/", 2)[0] + "/contents" + filePath,
+	}
+	if f.entryType == centraldogma.JSON {
+		entry.Content = json.RawMessage(f.content)
+	} else {
+		entry.Content = string(f.content)
+	}
+	return entry
+}
+
+func projectResponse(p *project) *centraldogma.Project {
+	return &centraldogma.Project{
+		Name:      p.name,
+		Creator:   DefaultAuthor,
+		URL:       pathPrefix + "/" + p.name,
+		CreatedAt: formatTime(p.createdAt),
+	}
+}
+
+func repositoryResponse(p *project, repo *repository) *centraldogma.Repository {
+	return &centraldogma.Repository{
+		Name:         repo.name,
+		Creator:      DefaultAuthor,
+		HeadRevision: repo.head().revision,
+		URL:          pathPrefix + "/" + p.name + "/repos/" + repo.name,
+		CreatedAt:    formatTime(repo.createdAt),
+	}
+}
+
+func sortedPaths(files map[string]*file) []string {
+	paths := make([]string, 0, len(files))
+	for p := range files {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func methodNotAllowed(r *http.Request) error {
+	return newAPIError(http.StatusMethodNotAllowed, "", "method not allowed: %s %s", r.Method, r.URL.Path)
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(statusCode)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	e, ok := err.(*apiError)
+	if !ok {
+		e = newAPIError(http.StatusInternalServerError, "", "%v", err)
+	}
+	exception := ""
+	if len(e.exception) != 0 {
+		exception = exceptionPrefix + e.exception
+	}
+	writeJSON(w, e.statusCode, map[string]string{"exception": exception, "message": e.message})
+}
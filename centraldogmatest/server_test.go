@@ -0,0 +1,406 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.linecorp.com/centraldogma"
+)
+
+func newTestRepository(t testing.TB) (*Server, *centraldogma.Client) {
+	server := NewServer()
+	client, err := server.NewClient()
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	if _, _, err = client.CreateProject(context.Background(), "foo"); err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	if _, _, err = client.CreateRepository(context.Background(), "foo", "bar"); err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+// isAPIError is used instead of errors.Is which is not available in Go 1.12.
+func isAPIError(err error, target error) bool {
+	apiError, ok := err.(*centraldogma.APIError)
+	return ok && apiError.Is(target)
+}
+
+func push(t testing.TB, client *centraldogma.Client, summary string, changes ...*centraldogma.Change) int64 {
+	result, _, err := client.Push(context.Background(), "foo", "bar", "-1",
+		&centraldogma.CommitMessage{Summary: summary}, changes)
+	if err != nil {
+		t.Fatalf("Push returned error: %v", err)
+	}
+	return result.Revision
+}
+
+func TestProjects(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client, err := server.NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+
+	project, _, err := client.CreateProject(ctx, "foo")
+	if err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if project.Name != "foo" || project.Creator != DefaultAuthor {
+		t.Errorf("CreateProject returned %+v", project)
+	}
+	if _, _, err = client.CreateProject(ctx, "foo"); !isAPIError(err, centraldogma.ErrProjectExists) {
+		t.Errorf("CreateProject returned %v, want %v", err, centraldogma.ErrProjectExists)
+	}
+
+	repo, _, err := client.CreateRepository(ctx, "foo", "bar")
+	if err != nil {
+		t.Fatalf("CreateRepository returned error: %v", err)
+	}
+	if repo.Name != "bar" || repo.HeadRevision != 1 {
+		t.Errorf("CreateRepository returned %+v", repo)
+	}
+	if _, _, err = client.CreateRepository(ctx, "qux", "bar"); !isAPIError(err, centraldogma.ErrProjectNotFound) {
+		t.Errorf("CreateRepository returned %v, want %v", err, centraldogma.ErrProjectNotFound)
+	}
+
+	if _, err = client.RemoveProject(ctx, "foo"); err != nil {
+		t.Fatalf("RemoveProject returned error: %v", err)
+	}
+	projects, _, _ := client.ListProjects(ctx)
+	removed, _, _ := client.ListRemovedProjects(ctx)
+	if len(projects) != 0 || len(removed) != 1 || removed[0].Name != "foo" {
+		t.Errorf("ListProjects returned %v and ListRemovedProjects returned %v after the removal", projects, removed)
+	}
+	if _, _, err = client.UnremoveProject(ctx, "foo"); err != nil {
+		t.Fatalf("UnremoveProject returned error: %v", err)
+	}
+	repos, _, err := client.ListRepositories(ctx, "foo")
+	if err != nil || len(repos) != 1 || repos[0].Name != "bar" {
+		t.Errorf("ListRepositories returned %v, %v", repos, err)
+	}
+
+	client.RemoveProject(ctx, "foo")
+	if _, err = client.PurgeProject(ctx, "foo"); err != nil {
+		t.Fatalf("PurgeProject returned error: %v", err)
+	}
+	if removed, _, _ = client.ListRemovedProjects(ctx); len(removed) != 0 {
+		t.Errorf("ListRemovedProjects returned %v after the purge", removed)
+	}
+}
+
+func TestContents(t *testing.T) {
+	server, client := newTestRepository(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	upsertJSON, _ := centraldogma.NewUpsertJSON("/a/b.json", map[string]interface{}{"a": 1})
+	upsertText, _ := centraldogma.NewUpsertText("/c.txt", "hello\n")
+	if revision := push(t, client, "Add files", upsertJSON, upsertText); revision != 2 {
+		t.Errorf("Push returned revision %v, want %v", revision, 2)
+	}
+
+	entry, _, err := client.GetFile(ctx, "foo", "bar", "-1",
+		&centraldogma.Query{Path: "/a/b.json", Type: centraldogma.Identity})
+	if err != nil {
+		t.Fatalf("GetFile returned error: %v", err)
+	}
+	var value map[string]interface{}
+	if err = entry.UnmarshalTo(&value); err != nil || value["a"] != float64(1) || entry.Revision != 2 {
+		t.Errorf("GetFile returned %+v, %v", entry, err)
+	}
+	_, _, err = client.GetFile(ctx, "foo", "bar", "1",
+		&centraldogma.Query{Path: "/a/b.json", Type: centraldogma.Identity})
+	if !isAPIError(err, centraldogma.ErrEntryNotFound) {
+		t.Errorf("GetFile returned %v, want %v", err, centraldogma.ErrEntryNotFound)
+	}
+
+	entries, _, err := client.GetFiles(ctx, "foo", "bar", "-1", "/**")
+	if err != nil || len(entries) != 2 || entries[0].Path != "/a/b.json" || entries[1].Content.String() != "hello\n" {
+		t.Errorf("GetFiles returned %v, %v", entries, err)
+	}
+
+	entries, _, err = client.ListFiles(ctx, "foo", "bar", "-1", "/**")
+	if err != nil {
+		t.Fatalf("ListFiles returned error: %v", err)
+	}
+	var listed []string
+	for _, e := range entries {
+		listed = append(listed, e.Path+":"+e.Type.String())
+	}
+	if want := []string{"/a:DIRECTORY", "/a/b.json:JSON", "/c.txt:TEXT"}; !reflect.DeepEqual(listed, want) {
+		t.Errorf("ListFiles returned %v, want %v", listed, want)
+	}
+
+	normalized, _, err := client.NormalizeRevision(ctx, "foo", "bar", "-1")
+	if err != nil || normalized != 2 {
+		t.Errorf("NormalizeRevision returned %v, %v, want %v", normalized, err, 2)
+	}
+}
+
+// contentsRequestCounter is an http.RoundTripper which counts the requests which retrieve the contents.
+type contentsRequestCounter struct {
+	count int32
+}
+
+func (c *contentsRequestCounter) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.Contains(req.URL.Path, "/contents/") {
+		atomic.AddInt32(&c.count, 1)
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestContents_CachedHeadRead(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	counter := &contentsRequestCounter{}
+	client, err := server.NewClient(centraldogma.WithCache(10, time.Minute), centraldogma.WithTransport(counter))
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.CreateProject(context.Background(), "foo")
+	client.CreateRepository(context.Background(), "foo", "bar")
+
+	query := &centraldogma.Query{Path: "/a.json", Type: centraldogma.Identity}
+	read := func(want string, wantRevision int64) {
+		// A conditional request would be a watch which blocks until the timeout.
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		start := time.Now()
+		entry, _, err := client.GetFile(ctx, "foo", "bar", "-1", query)
+		if err != nil {
+			t.Fatalf("GetFile returned error: %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("GetFile took %v", elapsed)
+		}
+		if entry.Content.String() != want || entry.Revision != wantRevision {
+			t.Errorf("GetFile returned %s at %v, want %s at %v", entry.Content, entry.Revision, want, wantRevision)
+		}
+	}
+
+	upsert, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 1})
+	push(t, client, "Add a.json", upsert)
+	for i := 0; i < 3; i++ {
+		read(`{"a":1}`, 2)
+	}
+	if count := atomic.LoadInt32(&counter.count); count != 1 {
+		t.Errorf("sent %v requests for the contents, want %v", count, 1)
+	}
+
+	// The new head is read as soon as it is pushed.
+	upsert, _ = centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 2})
+	push(t, client, "Update a.json", upsert)
+	read(`{"a":2}`, 3)
+	if count := atomic.LoadInt32(&counter.count); count != 2 {
+		t.Errorf("sent %v requests for the contents, want %v", count, 2)
+	}
+}
+
+func TestPush_Patches(t *testing.T) {
+	server, client := newTestRepository(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	upsertJSON, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 1, "b": []int{1, 2}})
+	upsertText, _ := centraldogma.NewUpsertText("/b.txt", "foo\nbar\n")
+	push(t, client, "Add files", upsertJSON, upsertText)
+
+	jsonPatch, _ := centraldogma.NewApplyJSONPatch("/a.json",
+		centraldogma.JSONPatchOperation{Op: "replace", Path: "/a", Value: 2},
+		centraldogma.JSONPatchOperation{Op: "add", Path: "/b/-", Value: 3})
+	before := &centraldogma.Entry{Path: "/b.txt", Type: centraldogma.Text, Content: []byte("foo\nbar\n")}
+	after := &centraldogma.Entry{Path: "/b.txt", Type: centraldogma.Text, Content: []byte("foo\nbaz\n")}
+	diff, err := centraldogma.RenderUnifiedDiff(before, after)
+	if err != nil {
+		t.Fatal(err)
+	}
+	textPatch := &centraldogma.Change{Path: "/b.txt", Type: centraldogma.ApplyTextPatch, Content: diff}
+	push(t, client, "Apply patches", jsonPatch, textPatch)
+
+	entries, _, err := client.GetFiles(ctx, "foo", "bar", "-1", "/**")
+	if err != nil {
+		t.Fatalf("GetFiles returned error: %v", err)
+	}
+	if got := entries[0].Content.String(); got != `{"a":2,"b":[1,2,3]}` {
+		t.Errorf("the patched JSON is %s", got)
+	}
+	if got := entries[1].Content.String(); got != "foo\nbaz\n" {
+		t.Errorf("the patched text is %q", got)
+	}
+}
+
+func TestPush_Errors(t *testing.T) {
+	server, client := newTestRepository(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	upsert, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 1})
+	push(t, client, "Add a.json", upsert)
+
+	commitMessage := &centraldogma.CommitMessage{Summary: "Add a.json again"}
+	_, _, err := client.Push(ctx, "foo", "bar", "-1", commitMessage, []*centraldogma.Change{upsert})
+	if !isAPIError(err, centraldogma.ErrRedundantChange) {
+		t.Errorf("Push returned %v, want %v", err, centraldogma.ErrRedundantChange)
+	}
+
+	modified, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 2})
+	push(t, client, "Modify a.json", modified)
+	conflicting, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 3})
+	_, _, err = client.Push(ctx, "foo", "bar", "2", commitMessage, []*centraldogma.Change{conflicting})
+	if !isAPIError(err, centraldogma.ErrChangeConflict) {
+		t.Errorf("Push returned %v, want %v", err, centraldogma.ErrChangeConflict)
+	}
+
+	// A push based on an old revision succeeds if it does not modify the files modified after the revision.
+	other, _ := centraldogma.NewUpsertText("/b.txt", "b")
+	if _, _, err = client.Push(ctx, "foo", "bar", "2", commitMessage, []*centraldogma.Change{other}); err != nil {
+		t.Errorf("Push returned error: %v", err)
+	}
+
+	remove, _ := centraldogma.NewRemove("/c.txt")
+	_, _, err = client.Push(ctx, "foo", "bar", "-1", commitMessage, []*centraldogma.Change{remove})
+	if !isAPIError(err, centraldogma.ErrChangeConflict) {
+		t.Errorf("Push returned %v, want %v", err, centraldogma.ErrChangeConflict)
+	}
+}
+
+func TestHistoryAndDiffs(t *testing.T) {
+	server, client := newTestRepository(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	a1, _ := centraldogma.NewUpsertJSON("/a.json", 1)
+	b1, _ := centraldogma.NewUpsertText("/b.txt", "b")
+	a2, _ := centraldogma.NewUpsertJSON("/a.json", 2)
+	push(t, client, "Add a.json", a1)
+	push(t, client, "Add b.txt", b1)
+	push(t, client, "Modify a.json", a2)
+
+	commits, _, err := client.GetHistory(ctx, "foo", "bar", "-1", "1", "/a.json", 0)
+	if err != nil {
+		t.Fatalf("GetHistory returned error: %v", err)
+	}
+	var revisions []int64
+	for _, c := range commits {
+		revisions = append(revisions, c.Revision)
+	}
+	if want := []int64{4, 2}; !reflect.DeepEqual(revisions, want) {
+		t.Errorf("GetHistory returned %v, want %v", revisions, want)
+	}
+	if commits[0].CommitMessage.Summary != "Modify a.json" || commits[0].Author != DefaultAuthor {
+		t.Errorf("GetHistory returned %+v", commits[0])
+	}
+
+	commits, _, err = client.GetHistory(ctx, "foo", "bar", "1", "-1", "", 2)
+	if err != nil || len(commits) != 2 || commits[0].Revision != 1 || commits[1].Revision != 2 {
+		t.Errorf("GetHistory returned %v, %v", commits, err)
+	}
+
+	changes, _, err := client.GetDiffs(ctx, "foo", "bar", "2", "-1", "/**")
+	if err != nil || len(changes) != 2 || changes[0].Path != "/a.json" || changes[1].Type != centraldogma.UpsertText {
+		t.Errorf("GetDiffs returned %v, %v", changes, err)
+	}
+
+	change, _, err := client.GetDiff(ctx, "foo", "bar", "3", "4",
+		&centraldogma.Query{Path: "/b.txt", Type: centraldogma.Identity})
+	if err != nil || change.Type != centraldogma.ApplyTextPatch || change.Content != "" {
+		t.Errorf("GetDiff returned %+v, %v, want an empty patch", change, err)
+	}
+
+	remove, _ := centraldogma.NewRemove("/b.txt")
+	previewed, _, err := client.PreviewDiffs(ctx, "foo", "bar", "-1", []*centraldogma.Change{remove})
+	if err != nil || len(previewed) != 1 || previewed[0].Type != centraldogma.Remove {
+		t.Errorf("PreviewDiffs returned %v, %v", previewed, err)
+	}
+}
+
+func TestWatch(t *testing.T) {
+	server, client := newTestRepository(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	upsert, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 1})
+	push(t, client, "Add a.json", upsert)
+
+	watcher, err := client.FileWatcher("foo", "bar", &centraldogma.Query{Path: "/a.json", Type: centraldogma.Identity})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer watcher.Close()
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	initial := watcher.AwaitInitialValueWithContext(timeoutCtx)
+	if initial.Err != nil || initial.Revision != 2 {
+		t.Fatalf("the initial value is %+v", initial)
+	}
+
+	updates := make(chan centraldogma.WatchResult, 8)
+	watcher.Watch(func(result centraldogma.WatchResult) {
+		updates <- result
+	})
+	<-updates // the latest value
+
+	other, _ := centraldogma.NewUpsertText("/b.txt", "b")
+	push(t, client, "Add b.txt", other)
+	modified, _ := centraldogma.NewUpsertJSON("/a.json", map[string]interface{}{"a": 2})
+	push(t, client, "Modify a.json", modified)
+
+	select {
+	case result := <-updates:
+		if result.Revision != 4 || result.Entry.Content.String() != `{"a":2}` {
+			t.Errorf("the watcher was notified of %+v", result)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the watcher was not notified of the modification")
+	}
+}
+
+func TestWatchRepositoryOnce(t *testing.T) {
+	server, client := newTestRepository(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	revision, httpStatusCode, err := client.WatchRepositoryOnce(ctx, "foo", "bar", "/**", 1, 100*time.Millisecond)
+	if err != nil || httpStatusCode != http.StatusNotModified || revision != 1 {
+		t.Errorf("WatchRepositoryOnce returned %v, %v, %v, want 304 Not Modified", revision, httpStatusCode, err)
+	}
+
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		upsert, _ := centraldogma.NewUpsertText("/a/b.txt", "b")
+		client.Push(ctx, "foo", "bar", "-1", &centraldogma.CommitMessage{Summary: "Add b.txt"},
+			[]*centraldogma.Change{upsert})
+	}()
+	revision, _, err = client.WatchRepositoryOnce(ctx, "foo", "bar", "/a/**", 1, 5*time.Second)
+	if err != nil || revision != 2 {
+		t.Errorf("WatchRepositoryOnce returned %v, %v, want %v", revision, err, 2)
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogmatest
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+
+	"go.linecorp.com/centraldogma"
+)
+
+const (
+	stressWorkers    = 8
+	stressIterations = 20
+)
+
+// TestStress exercises a Client shared by many goroutines which push, read and watch at the same time.
+// It is meant to be run with the race detector, e.g. go test -race -run TestStress.
+func TestStress(t *testing.T) {
+	server := NewServer()
+	defer server.Close()
+	client, err := server.NewClient(centraldogma.WithCache(64, time.Minute),
+		centraldogma.WithRevisionCache(10*time.Millisecond), centraldogma.WithWatchMultiplexing(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if _, _, err = client.CreateProject(ctx, "foo"); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = client.CreateRepository(ctx, "foo", "bar"); err != nil {
+		t.Fatal(err)
+	}
+	changes := []*centraldogma.Change{stressCounter(t, "/shared.json", 0)}
+	for i := 0; i < stressWorkers; i++ {
+		changes = append(changes, stressCounter(t, stressFile(i), -1))
+	}
+	push(t, client, "Add the counters", changes...)
+
+	// Watch the files of the workers and the whole repository.
+	watchers := make([]*centraldogma.Watcher, stressWorkers)
+	for i := range watchers {
+		query := &centraldogma.Query{Path: stressFile(i), Type: centraldogma.Identity}
+		if watchers[i], err = client.FileWatcher("foo", "bar", query); err != nil {
+			t.Fatal(err)
+		}
+		// The listener is not invoked concurrently, so last needs no synchronization.
+		last := -1
+		path := query.Path
+		watchers[i].Watch(func(result centraldogma.WatchResult) {
+			n := stressValue(t, &result.Entry)
+			if n < last {
+				t.Errorf("the watcher of %s went back from %v to %v", path, last, n)
+			}
+			last = n
+		})
+	}
+	repoWatcher, err := client.RepoWatcher("foo", "bar", "/**")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var lastNotified int64
+	repoWatcher.Watch(func(result centraldogma.WatchResult) {
+		for {
+			last := atomic.LoadInt64(&lastNotified)
+			if result.Revision <= last || atomic.CompareAndSwapInt64(&lastNotified, last, result.Revision) {
+				return
+			}
+		}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < stressWorkers; i++ {
+		wg.Add(1)
+		go func(worker int) {
+			defer wg.Done()
+			for n := 0; n < stressIterations; n++ {
+				stressIteration(t, client, worker, n)
+			}
+		}(i)
+	}
+
+	// Replace the metric collector while the requests are being sent.
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		config := metrics.DefaultConfig("stress")
+		config.EnableRuntimeMetrics = false
+		for i := 0; i < stressIterations; i++ {
+			collector, err := metrics.New(config, metrics.NewInmemSink(time.Second, time.Minute))
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			client.SetMetricCollector(collector)
+			time.Sleep(time.Millisecond)
+		}
+	}()
+	wg.Wait()
+
+	// No increment of the shared counter is lost.
+	entry, _, err := client.GetFile(ctx, "foo", "bar", "-1", &centraldogma.Query{Path: "/shared.json"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := stressValue(t, entry), stressWorkers*stressIterations; got != want {
+		t.Errorf("shared counter: %v, want %v", got, want)
+	}
+
+	// All the watchers catch up with the last pushes.
+	deadline := time.Now().Add(10 * time.Second)
+	for i, watcher := range watchers {
+		for {
+			latest := watcher.Latest()
+			if latest.Err == nil && stressValue(t, &latest.Entry) == stressIterations-1 {
+				break
+			}
+			if time.Now().After(deadline) {
+				t.Fatalf("the watcher of %s is at %+v", stressFile(i), latest)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	for atomic.LoadInt64(&lastNotified) != entry.Revision {
+		if time.Now().After(deadline) {
+			t.Fatalf("the repository watcher is at %v, want %v", atomic.LoadInt64(&lastNotified), entry.Revision)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	closeCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	for _, watcher := range append(watchers, repoWatcher) {
+		if err = watcher.CloseAndWait(closeCtx); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// stressIteration pushes to the file of the worker, reads it back and increments the shared counter, retrying on
+// conflicts.
+func stressIteration(t *testing.T, client *centraldogma.Client, worker, n int) {
+	ctx := context.Background()
+	path := stressFile(worker)
+	result, _, err := client.Push(ctx, "foo", "bar", "-1",
+		&centraldogma.CommitMessage{Summary: fmt.Sprintf("Update %s", path)},
+		[]*centraldogma.Change{stressCounter(t, path, n)})
+	if err != nil {
+		t.Errorf("Push returned error: %v", err)
+		return
+	}
+
+	entry, _, err := client.GetFile(ctx, "foo", "bar", strconv.FormatInt(result.Revision, 10),
+		&centraldogma.Query{Path: path})
+	if err != nil {
+		t.Errorf("GetFile returned error: %v", err)
+		return
+	}
+	if got := stressValue(t, entry); got != n {
+		t.Errorf("%s at %v: %v, want %v", path, result.Revision, got, n)
+	}
+	if _, _, err = client.ListFiles(ctx, "foo", "bar", "-1", "/**"); err != nil {
+		t.Errorf("ListFiles returned error: %v", err)
+	}
+
+	for {
+		entry, _, err = client.GetFile(ctx, "foo", "bar", "-1", &centraldogma.Query{Path: "/shared.json"})
+		if err != nil {
+			t.Errorf("GetFile returned error: %v", err)
+			return
+		}
+		_, _, err = client.Push(ctx, "foo", "bar", strconv.FormatInt(entry.Revision, 10),
+			&centraldogma.CommitMessage{Summary: "Increment the shared counter"},
+			[]*centraldogma.Change{stressCounter(t, "/shared.json", stressValue(t, entry)+1)})
+		if err == nil {
+			return
+		}
+		if !isAPIError(err, centraldogma.ErrChangeConflict) {
+			t.Errorf("Push returned error: %v", err)
+			return
+		}
+	}
+}
+
+func stressFile(worker int) string {
+	return fmt.Sprintf("/workers/%d.json", worker)
+}
+
+func stressCounter(t *testing.T, path string, n int) *centraldogma.Change {
+	change, err := centraldogma.NewUpsertJSON(path, map[string]interface{}{"n": n})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return change
+}
+
+func stressValue(t *testing.T, entry *centraldogma.Entry) int {
+	var counter struct {
+		N int `json:"n"`
+	}
+	if err := entry.UnmarshalTo(&counter); err != nil {
+		t.Errorf("failed to decode %s: %v", entry.Path, err)
+	}
+	return counter.N
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// JSONPatchOperation represents an operation of a JSON patch defined in RFC 6902.
+type JSONPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+// NewUpsertJSON returns a Change which adds or replaces the JSON file at the path with the value.
+// The value is marshalled into JSON using json.Marshal unless it is already a json.RawMessage.
+func NewUpsertJSON(path string, value interface{}) (*Change, error) {
+	if err := validateJSONPath(path); err != nil {
+		return nil, err
+	}
+	content, err := marshalJSONContent(value)
+	if err != nil {
+		return nil, err
+	}
+	return &Change{Path: path, Type: UpsertJSON, Content: content}, nil
+}
+
+// NewUpsertText returns a Change which adds or replaces the text file at the path with the text.
+func NewUpsertText(path, text string) (*Change, error) {
+	if err := validateFilePath(path); err != nil {
+		return nil, err
+	}
+	return &Change{Path: path, Type: UpsertText, Content: text}, nil
+}
+
+// NewUpsertBinary returns a Change which adds or replaces the text file at the path with the base64-encoded
+// data. The data can be retrieved using Client.GetBinaryFile or Entry.Binary. ErrContentTooLarge is returned
+// if the data is larger than MaxBinaryContentLength.
+func NewUpsertBinary(path string, data []byte) (*Change, error) {
+	if err := validateFilePath(path); err != nil {
+		return nil, err
+	}
+	if len(data) > MaxBinaryContentLength {
+		return nil, ErrContentTooLarge
+	}
+	return &Change{Path: path, Type: UpsertText, Content: base64.StdEncoding.EncodeToString(data)}, nil
+}
+
+// NewRemove returns a Change which removes the file at the path.
+func NewRemove(path string) (*Change, error) {
+	if err := validateFilePath(path); err != nil {
+		return nil, err
+	}
+	return &Change{Path: path, Type: Remove}, nil
+}
+
+// NewRename returns a Change which renames the file at the oldPath to the newPath.
+func NewRename(oldPath, newPath string) (*Change, error) {
+	if err := validateFilePath(oldPath); err != nil {
+		return nil, err
+	}
+	if err := validateFilePath(newPath); err != nil {
+		return nil, err
+	}
+	if oldPath == newPath {
+		return nil, fmt.Errorf("oldPath and newPath must be different (path: %v)", oldPath)
+	}
+	return &Change{Path: oldPath, Type: Rename, Content: newPath}, nil
+}
+
+// NewApplyJSONPatch returns a Change which applies the JSON patch operations to the JSON file at the path.
+// For example:
+//
+//	change, err := NewApplyJSONPatch("/a.json",
+//	    JSONPatchOperation{Op: "test", Path: "/a", Value: "b"},
+//	    JSONPatchOperation{Op: "replace", Path: "/a", Value: "c"})
+func NewApplyJSONPatch(path string, operations ...JSONPatchOperation) (*Change, error) {
+	if err := validateJSONPath(path); err != nil {
+		return nil, err
+	}
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("operations should not be empty")
+	}
+	for _, operation := range operations {
+		if err := operation.validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &Change{Path: path, Type: ApplyJSONPatch, Content: operations}, nil
+}
+
+// MarshalJSON returns the JSON encoding of the operation. The value is always encoded for the operations
+// which require it, even if it is null.
+func (o JSONPatchOperation) MarshalJSON() ([]byte, error) {
+	type Alias JSONPatchOperation
+	switch o.Op {
+	case "add", "replace", "test":
+		return json.Marshal(&struct {
+			*Alias
+			Value interface{} `json:"value"`
+		}{
+			Alias: (*Alias)(&o),
+			Value: o.Value,
+		})
+	default:
+		return json.Marshal((*Alias)(&o))
+	}
+}
+
+func (o *JSONPatchOperation) validate() error {
+	switch o.Op {
+	case "add", "replace", "test", "remove":
+	case "move", "copy":
+		if len(o.From) == 0 {
+			return fmt.Errorf("from should be set for the %q operation (path: %v)", o.Op, o.Path)
+		}
+	default:
+		return fmt.Errorf("unknown JSON patch operation: %q", o.Op)
+	}
+	if len(o.Path) != 0 && !strings.HasPrefix(o.Path, "/") {
+		return fmt.Errorf("invalid JSON pointer: %q", o.Path)
+	}
+	return nil
+}
+
+func marshalJSONContent(value interface{}) (json.RawMessage, error) {
+	if raw, ok := value.(json.RawMessage); ok {
+		if !json.Valid(raw) {
+			return nil, fmt.Errorf("invalid JSON content")
+		}
+		return raw, nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(b), nil
+}
+
+func validateFilePath(path string) error {
+	if !strings.HasPrefix(path, "/") || strings.HasSuffix(path, "/") {
+		return fmt.Errorf("invalid file path: %q", path)
+	}
+	return nil
+}
+
+func validateJSONPath(path string) error {
+	if err := validateFilePath(path); err != nil {
+		return err
+	}
+	if !strings.HasSuffix(strings.ToLower(path), ".json") {
+		return fmt.Errorf("the path of a JSON file should end with .json: %q", path)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewUpsertJSON(t *testing.T) {
+	change, err := NewUpsertJSON("/a.json", map[string]string{"a": "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(change)
+	testString(t, string(b), `{"type":"UPSERT_JSON","path":"/a.json","content":{"a":"b"}}`, "change")
+
+	if _, err = NewUpsertJSON("/a.txt", "b"); err == nil {
+		t.Errorf("NewUpsertJSON should fail for a non-JSON path")
+	}
+	if _, err = NewUpsertJSON("/a.json", json.RawMessage(`{"a":`)); err == nil {
+		t.Errorf("NewUpsertJSON should fail for an invalid JSON")
+	}
+}
+
+func TestNewUpsertTextAndRemoveAndRename(t *testing.T) {
+	change, _ := NewUpsertText("/a.txt", "hello")
+	b, _ := json.Marshal(change)
+	testString(t, string(b), `{"type":"UPSERT_TEXT","path":"/a.txt","content":"hello"}`, "upsert text")
+
+	change, _ = NewRemove("/a.txt")
+	b, _ = json.Marshal(change)
+	testString(t, string(b), `{"type":"REMOVE","path":"/a.txt"}`, "remove")
+
+	change, _ = NewRename("/a.txt", "/b.txt")
+	b, _ = json.Marshal(change)
+	testString(t, string(b), `{"type":"RENAME","path":"/a.txt","content":"/b.txt"}`, "rename")
+
+	if _, err := NewRemove("a.txt"); err == nil {
+		t.Errorf("NewRemove should fail for a relative path")
+	}
+	if _, err := NewRename("/a.txt", "/a.txt"); err == nil {
+		t.Errorf("NewRename should fail for the same paths")
+	}
+}
+
+func TestNewApplyJSONPatch(t *testing.T) {
+	change, err := NewApplyJSONPatch("/a.json",
+		JSONPatchOperation{Op: "test", Path: "/a", Value: "b"},
+		JSONPatchOperation{Op: "replace", Path: "/a", Value: "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(change)
+	testString(t, string(b), `{"type":"APPLY_JSON_PATCH","path":"/a.json","content":`+
+		`[{"op":"test","path":"/a","value":"b"},{"op":"replace","path":"/a","value":"c"}]}`, "change")
+
+	if _, err = NewApplyJSONPatch("/a.json"); err == nil {
+		t.Errorf("NewApplyJSONPatch should fail without operations")
+	}
+	if _, err = NewApplyJSONPatch("/a.json", JSONPatchOperation{Op: "move", Path: "/a"}); err == nil {
+		t.Errorf("NewApplyJSONPatch should fail for a move operation without from")
+	}
+	if _, err = NewApplyJSONPatch("/a.json", JSONPatchOperation{Op: "unknown", Path: "/a"}); err == nil {
+		t.Errorf("NewApplyJSONPatch should fail for an unknown operation")
+	}
+}
+
+func TestNewUpsertBinary(t *testing.T) {
+	change, err := NewUpsertBinary("/a.bin", []byte{0, 1, 2, 255})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := json.Marshal(change)
+	testString(t, string(b), `{"type":"UPSERT_TEXT","path":"/a.bin","content":"AAEC/w=="}`, "change")
+
+	if _, err = NewUpsertBinary("/a.bin", make([]byte, MaxBinaryContentLength+1)); err != ErrContentTooLarge {
+		t.Errorf("NewUpsertBinary returned %v, want %v", err, ErrContentTooLarge)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const defaultCircuitBreakerCooldown = 10 * time.Second
+
+type circuitState int
+
+const (
+	circuitClosed circuitState = iota
+	circuitOpen
+	circuitHalfOpen
+)
+
+// circuitBreaker fast-fails the requests of a Client while the server keeps failing, so that the callers do not
+// wait for the requests which are likely to fail. The circuit opens after threshold consecutive failures and
+// lets one request through as a probe after the cooldown. It is closed again if the probe succeeds, or opened
+// for another cooldown otherwise.
+type circuitBreaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+	logger    Logger
+
+	lock     sync.Mutex
+	state    circuitState
+	failures int       // consecutive failures while closed
+	openedAt time.Time // when the circuit was opened last
+	probing  bool      // whether the probe is in flight while half-open
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration, logger Logger) *circuitBreaker {
+	if cooldown <= 0 {
+		cooldown = defaultCircuitBreakerCooldown
+	}
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, now: time.Now, logger: logger}
+}
+
+// allow returns whether the request can be sent. If true, done must be called with the result of the request.
+func (b *circuitBreaker) allow() bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	switch b.state {
+	case circuitOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return false
+		}
+		b.state = circuitHalfOpen
+		b.probing = true
+		return true
+	case circuitHalfOpen:
+		if b.probing {
+			return false
+		}
+		b.probing = true
+		return true
+	default:
+		return true
+	}
+}
+
+// done records the result of the request which was allowed. The request fails if it cannot reach the server
+// or the server responds with a server error. The request cancelled by the caller is not counted.
+func (b *circuitBreaker) done(ctx context.Context, res *http.Response, err error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if err != nil && ctx.Err() == context.Canceled {
+		b.probing = false // Let the next request probe instead.
+		return
+	}
+
+	failed := err != nil || res.StatusCode >= http.StatusInternalServerError
+	switch {
+	case !failed:
+		if b.state != circuitClosed {
+			b.logger.Warnf("Circuit breaker is closed: the server recovered")
+		}
+		b.state = circuitClosed
+		b.failures = 0
+		b.probing = false
+	case b.state == circuitHalfOpen:
+		b.state = circuitOpen
+		b.openedAt = b.now()
+		b.probing = false
+	case b.state == circuitClosed:
+		b.failures++
+		if b.failures >= b.threshold {
+			b.logger.Warnf("Circuit breaker is open for %v after %d consecutive failures", b.cooldown, b.failures)
+			b.state = circuitOpen
+			b.openedAt = b.now()
+			b.failures = 0
+		}
+	}
+}
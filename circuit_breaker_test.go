@@ -0,0 +1,133 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Now()
+	b := newCircuitBreaker(2, time.Minute, log)
+	b.now = func() time.Time { return now }
+	failure := errors.New("connection refused")
+	serverError := &http.Response{StatusCode: http.StatusServiceUnavailable}
+	ok := &http.Response{StatusCode: http.StatusNotFound}
+
+	// The circuit opens after 2 consecutive failures.
+	for i := 0; i < 2; i++ {
+		if !b.allow() {
+			t.Fatalf("allow returned false before the threshold")
+		}
+		b.done(context.Background(), serverError, nil)
+	}
+	if b.allow() {
+		t.Errorf("allow returned true while the circuit is open")
+	}
+
+	// Only one probe is let through after the cooldown, and the circuit opens again if it fails.
+	now = now.Add(time.Minute)
+	if !b.allow() {
+		t.Fatalf("allow returned false after the cooldown")
+	}
+	if b.allow() {
+		t.Errorf("allow returned true while the probe is in flight")
+	}
+	b.done(context.Background(), nil, failure)
+	if b.allow() {
+		t.Errorf("allow returned true after the probe failed")
+	}
+
+	// A probe cancelled by the caller is not counted, and the next request probes instead.
+	now = now.Add(time.Minute)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if !b.allow() {
+		t.Fatalf("allow returned false after the cooldown")
+	}
+	b.done(ctx, nil, context.Canceled)
+	if !b.allow() {
+		t.Fatalf("allow returned false after the probe was cancelled")
+	}
+
+	// The circuit is closed once the probe succeeds. A client error is not a failure of the server.
+	b.done(context.Background(), ok, nil)
+	b.done(context.Background(), serverError, nil)
+	if !b.allow() {
+		t.Errorf("allow returned false after the server recovered")
+	}
+}
+
+func TestWithCircuitBreaker(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	requests := 0
+	failing := false
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		fmt.Fprint(w, `{"revision":2}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"path":"/a.json", "type":"JSON", "content":{"a":"b"}, "revision":2}`)
+	})
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithCircuitBreaker(2, time.Minute),
+		WithCache(10, time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	query := &Query{Path: "/a.json", Type: Identity}
+	if _, _, err = c.GetFile(context.Background(), "foo", "bar", "-1", query); err != nil {
+		t.Fatal(err)
+	}
+
+	failing = true
+	for i := 0; i < 2; i++ {
+		_, httpStatusCode, _ := c.GetFile(context.Background(), "foo", "bar", "-1", query)
+		testStatusCode(t, httpStatusCode, http.StatusInternalServerError)
+	}
+
+	// The cached entry is used while the circuit is open.
+	entry, httpStatusCode, err := c.GetFile(context.Background(), "foo", "bar", "-1", query)
+	if err != nil {
+		t.Fatalf("GetFile returned error: %v", err)
+	}
+	testStatusCode(t, httpStatusCode, http.StatusOK)
+	testString(t, string(entry.Content), `{"a":"b"}`, "content")
+
+	// The uncached requests fail fast.
+	_, httpStatusCode, err = c.ListProjects(context.Background())
+	testStatusCode(t, httpStatusCode, UnknownHttpStatusCode)
+	if err != ErrCircuitOpen {
+		t.Errorf("ListProjects returned %v, want %v", err, ErrCircuitOpen)
+	}
+	// The head revision is normalized before the contents are read, and only the normalization fails.
+	if requests != 4 {
+		t.Errorf("sent %v requests, want %v", requests, 4)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+)
+
+// certificateReloader loads the client certificate from the files. It loads the certificate again when
+// the files are modified or the certificate expires, so that the certificates which are rotated by
+// an external agent are picked up without restarting.
+type certificateReloader struct {
+	certFile string
+	keyFile  string
+	now      func() time.Time
+	logger   Logger
+
+	lock     sync.Mutex
+	cert     *tls.Certificate
+	modTime  time.Time
+	notAfter time.Time
+}
+
+func newCertificateReloader(certFile, keyFile string, logger Logger) (*certificateReloader, error) {
+	r := &certificateReloader{certFile: certFile, keyFile: keyFile, now: time.Now, logger: logger}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (r *certificateReloader) getClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	modTime, err := r.lastModified()
+	if err == nil && modTime.Equal(r.modTime) && r.now().Before(r.notAfter) {
+		return r.cert, nil
+	}
+	if err == nil {
+		err = r.reload()
+	}
+	if err != nil {
+		// Keep using the previous certificate which may still be accepted by the server.
+		r.logger.Warnf("Failed to reload the client certificate from %s: %v", r.certFile, err)
+	}
+	return r.cert, nil
+}
+
+// reload must be called with the lock held except in the constructor.
+func (r *certificateReloader) reload() error {
+	modTime, err := r.lastModified()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return err
+	}
+	leaf, err := x509.ParseCertificate(cert.Certificate[0])
+	if err != nil {
+		return err
+	}
+	cert.Leaf = leaf
+
+	if r.cert != nil {
+		r.logger.Debugf("Reloaded the client certificate from %s, notAfter=%v", r.certFile, leaf.NotAfter)
+	}
+	r.cert = &cert
+	r.modTime = modTime
+	r.notAfter = leaf.NotAfter
+	return nil
+}
+
+// lastModified returns the later modification time of the certificate file and the key file.
+func (r *certificateReloader) lastModified() (time.Time, error) {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if keyInfo.ModTime().After(certInfo.ModTime()) {
+		return keyInfo.ModTime(), nil
+	}
+	return certInfo.ModTime(), nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+// writeTestCertificate writes a self-signed client certificate and its key to the files.
+func writeTestCertificate(t *testing.T, certFile, keyFile, commonName string,
+	notAfter time.Time) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeFile(t, certFile, string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	writeFile(t, keyFile, string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})))
+
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+func TestWithClientCertificateFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	clientCert := writeTestCertificate(t, certFile, keyFile, "client", time.Now().Add(time.Hour))
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `[{"name":"%s"}]`, r.TLS.PeerCertificates[0].Subject.CommonName)
+	})
+	server := httptest.NewUnstartedServer(mux)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(clientCert)
+	server.TLS = &tls.Config{
+		NextProtos: []string{http2.NextProtoTLS},
+		ClientAuth: tls.RequireAndVerifyClientCert,
+		ClientCAs:  clientCAs,
+	}
+	server.StartTLS()
+	defer server.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	c, err := NewClient(server.URL, WithRootCAs(rootCAs), WithClientCertificateFiles(certFile, keyFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, _, err := c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "client", "name")
+
+	// The server rejects the client without a certificate.
+	c, err = NewClient(server.URL, WithRootCAs(rootCAs))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err == nil {
+		t.Errorf("ListProjects should fail without a client certificate")
+	}
+
+	if _, err = NewClient(server.URL, WithClientCertificateFiles(certFile, keyFile),
+		WithTransport(http.DefaultTransport)); err != ErrTLSConfigConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrTLSConfigConflict)
+	}
+}
+
+func TestCertificateReloader(t *testing.T) {
+	dir, err := ioutil.TempDir("", "cert")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "client.crt"), filepath.Join(dir, "client.key")
+	writeTestCertificate(t, certFile, keyFile, "client1", time.Now().Add(time.Hour))
+
+	r, err := newCertificateReloader(certFile, keyFile, log)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := r.getClientCertificate(nil)
+	testString(t, cert.Leaf.Subject.CommonName, "client1", "common name")
+
+	// The rotated certificate is loaded.
+	writeTestCertificate(t, certFile, keyFile, "client2", time.Now().Add(time.Hour))
+	future := time.Now().Add(time.Minute)
+	if err = os.Chtimes(certFile, future, future); err != nil {
+		t.Fatal(err)
+	}
+	cert, _ = r.getClientCertificate(nil)
+	testString(t, cert.Leaf.Subject.CommonName, "client2", "common name")
+
+	// The previous certificate is kept if the files are broken.
+	writeFile(t, keyFile, "broken")
+	future = future.Add(time.Minute)
+	if err = os.Chtimes(keyFile, future, future); err != nil {
+		t.Fatal(err)
+	}
+	cert, _ = r.getClientCertificate(nil)
+	testString(t, cert.Leaf.Subject.CommonName, "client2", "common name")
+}
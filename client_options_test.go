@@ -0,0 +1,305 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"golang.org/x/net/http2"
+	"golang.org/x/oauth2"
+)
+
+func TestNewClient(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testAuthorization(t, r)
+		testHeader(t, r, "User-Agent", "myApp")
+		testHeader(t, r, "X-Foo", "bar")
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+
+	c, err := NewClient(server.URL,
+		WithToken(token),
+		WithTransport(http.DefaultTransport),
+		WithUserAgent("myApp"),
+		WithHeader("X-Foo", "bar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	projects, _, err := c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testString(t, projects[0].Name, "foo", "project name")
+}
+
+func TestNewClient_WithRequestTimeout(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(500 * time.Millisecond)
+		fmt.Fprint(w, `[]`)
+	})
+
+	c, _ := NewClient(server.URL, WithTransport(http.DefaultTransport),
+		WithRequestTimeout(100*time.Millisecond))
+	if _, _, err := c.ListProjects(context.Background()); err == nil {
+		t.Errorf("ListProjects should fail due to the request timeout")
+	}
+}
+
+func TestNewClient_WithDefaultTimeout(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(300 * time.Millisecond)
+		fmt.Fprint(w, `[]`)
+	})
+
+	c, _ := NewClient(server.URL, WithTransport(http.DefaultTransport),
+		WithDefaultTimeout(100*time.Millisecond))
+	if _, _, err := c.ListProjects(context.Background()); err == nil {
+		t.Errorf("ListProjects should fail due to the default timeout")
+	}
+
+	// The deadline of the context takes precedence.
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, _, err := c.ListProjects(ctx); err != nil {
+		t.Errorf("ListProjects returned error: %v", err)
+	}
+}
+
+func TestNewClient_Transport(t *testing.T) {
+	// http2.Transport is used with the tls config by default.
+	tlsConfig := &tls.Config{ServerName: "foo"}
+	c, _ := NewClient("https://localhost/", WithToken("myToken"), WithTLSConfig(tlsConfig))
+	oauth2Transport, _ := c.client.Transport.(*oauth2.Transport)
+	http2Transport, ok := oauth2Transport.Base.(*http2.Transport)
+	if !ok {
+		t.Fatalf("NewClient transport is %+v, want http2.Transport", oauth2Transport.Base)
+	}
+	if http2Transport.TLSClientConfig != tlsConfig {
+		t.Errorf("NewClient tls config is %+v, want %+v", http2Transport.TLSClientConfig, tlsConfig)
+	}
+
+	// The http client is used as it is.
+	myClient := &http.Client{}
+	c, _ = NewClient("https://localhost/", WithToken("myToken"), WithHTTPClient(myClient))
+	if c.client != myClient {
+		t.Errorf("NewClient client is %v, want %v", c.client, myClient)
+	}
+
+	if _, err := NewClient("https://localhost/", WithHTTPClient(myClient),
+		WithTransport(http.DefaultTransport)); err != ErrHTTPClientConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrHTTPClientConflict)
+	}
+	if _, err := NewClient("https://localhost/", WithTLSConfig(tlsConfig),
+		WithTransport(http.DefaultTransport)); err != ErrTLSConfigConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrTLSConfigConflict)
+	}
+}
+
+func TestNewClient_TransportTuning(t *testing.T) {
+	var protoMajor int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		atomic.StoreInt32(&protoMajor, int32(r.ProtoMajor))
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{NextProtos: []string{http2.NextProtoTLS}}
+	server.StartTLS()
+	defer server.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	tests := []struct {
+		opts                []ClientOption
+		maxIdleConnsPerHost int
+		idleConnTimeout     time.Duration
+		protoMajor          int32
+	}{
+		{[]ClientOption{WithMaxIdleConnsPerHost(16)}, 16, 90 * time.Second, 2},
+		{[]ClientOption{WithHTTP2(false), WithIdleConnTimeout(time.Minute)}, 0, time.Minute, 1},
+	}
+	for _, test := range tests {
+		c, err := NewClient(server.URL, append(test.opts, WithRootCAs(rootCAs))...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		transport, ok := c.client.Transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("NewClient transport is %+v, want http.Transport", c.client.Transport)
+		}
+		if transport.MaxIdleConnsPerHost != test.maxIdleConnsPerHost || transport.IdleConnTimeout != test.idleConnTimeout {
+			t.Errorf("NewClient transport has MaxIdleConnsPerHost=%v and IdleConnTimeout=%v, want %v and %v",
+				transport.MaxIdleConnsPerHost, transport.IdleConnTimeout, test.maxIdleConnsPerHost, test.idleConnTimeout)
+		}
+		if _, _, err = c.ListProjects(context.Background()); err != nil {
+			t.Fatalf("ListProjects returned error: %v", err)
+		}
+		if n := atomic.LoadInt32(&protoMajor); n != test.protoMajor {
+			t.Errorf("HTTP/%v is used, want HTTP/%v", n, test.protoMajor)
+		}
+	}
+
+	if _, err := NewClient("https://localhost/", WithHTTP2(false),
+		WithTransport(http.DefaultTransport)); err != ErrTransportTuningConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrTransportTuningConflict)
+	}
+	if _, err := NewClient("http://localhost/", WithIdleConnTimeout(time.Minute)); err != ErrCleartextHTTP2Conflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrCleartextHTTP2Conflict)
+	}
+	if _, err := NewClient("http://localhost/", WithIdleConnTimeout(time.Minute), WithHTTP2(false)); err != nil {
+		t.Errorf("NewClient returned error: %v", err)
+	}
+}
+
+func TestWithUnixSocket(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("unix domain sockets are not supported on all versions of Windows")
+	}
+	dir, err := ioutil.TempDir("", "socket")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dogma.sock")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testString(t, r.Host, "dogma.example.com", "host")
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	server := httptest.NewUnstartedServer(mux)
+	if server.Listener, err = net.Listen("unix", path); err != nil {
+		t.Fatal(err)
+	}
+	server.Start()
+	defer server.Close()
+
+	c, err := NewClient("http://dogma.example.com", WithUnixSocket(path), WithHTTP2(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, _, err := c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "foo", "project name")
+}
+
+func TestWithDialContext(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	server := httptest.NewUnstartedServer(mux)
+	server.TLS = &tls.Config{NextProtos: []string{http2.NextProtoTLS}}
+	server.StartTLS()
+	defer server.Close()
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(server.Certificate())
+
+	// The TLS handshake is performed over the connection to the server while the name of the certificate is
+	// verified against the host of the base URL.
+	var dials int32
+	dialContext := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		atomic.AddInt32(&dials, 1)
+		testString(t, addr, "example.com:443", "addr")
+		var dialer net.Dialer
+		return dialer.DialContext(ctx, network, server.Listener.Addr().String())
+	}
+	for _, opts := range [][]ClientOption{{}, {WithHTTP2(false)}} {
+		atomic.StoreInt32(&dials, 0)
+		c, err := NewClient("https://example.com", append(opts, WithRootCAs(rootCAs), WithDialContext(dialContext))...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = c.ListProjects(context.Background()); err != nil {
+			t.Fatalf("ListProjects returned error: %v", err)
+		}
+		if n := atomic.LoadInt32(&dials); n != 1 {
+			t.Errorf("dials: %v, want 1", n)
+		}
+	}
+
+	if _, err := NewClient("https://example.com", WithDialContext(dialContext),
+		WithProxy("http://localhost:3128")); err != ErrDialContextConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrDialContextConflict)
+	}
+}
+
+type countingTokenSource struct {
+	calls int
+}
+
+func (s *countingTokenSource) Token() (*oauth2.Token, error) {
+	s.calls++
+	return &oauth2.Token{AccessToken: fmt.Sprintf("token%d", s.calls), Expiry: time.Now().Add(time.Hour)}, nil
+}
+
+func TestNewClient_WithTokenSource(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer token1")
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+
+	source := &countingTokenSource{}
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithTokenSource(source))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, _, err = c.ListProjects(context.Background()); err != nil {
+			t.Fatalf("ListProjects returned error: %v", err)
+		}
+	}
+	// The token is reused until it expires.
+	if source.calls != 1 {
+		t.Errorf("calls: %v, want 1", source.calls)
+	}
+
+	if _, err = NewClient(server.URL, WithTokenSource(source),
+		WithToken("anonymous")); err != ErrTokenSourceConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrTokenSourceConflict)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// Codec encodes and decodes the JSON of the requests, the responses and the watched entries of a Client, so that
+// a faster JSON library can be used for decoding large entries. It must be compatible with encoding/json,
+// e.g. honor the json struct tags, json.Marshaler and json.Unmarshaler. For example, jsoniter and sonic provide
+// compatible ones:
+//
+//	client, err := centraldogma.NewClient("https://localhost:443",
+//	    centraldogma.WithCodec(jsoniter.ConfigCompatibleWithStandardLibrary))
+type Codec interface {
+	Marshal(v interface{}) ([]byte, error)
+	Unmarshal(data []byte, v interface{}) error
+}
+
+// jsonCodec is the default Codec which uses encoding/json.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error) {
+	buf := getBuffer()
+	defer putBuffer(buf)
+	enc := json.NewEncoder(buf)
+	enc.SetEscapeHTML(true)
+	if err := enc.Encode(v); err != nil {
+		return nil, err
+	}
+	// The encoded bytes are copied once into a slice of the exact size instead of growing a new buffer.
+	return append([]byte(nil), buf.Bytes()...), nil
+}
+
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error {
+	return json.Unmarshal(data, v)
+}
+
+// decodeBody decodes the JSON response body into the resContent with the codec. The body is decoded as a stream
+// with the default codec, and read fully otherwise. An empty body leaves the resContent as it is.
+func decodeBody(codec Codec, body io.Reader, resContent interface{}) error {
+	if _, ok := codec.(jsonCodec); ok {
+		return decodeJSON(body, resContent)
+	}
+	buf := getBuffer()
+	defer putBuffer(buf)
+	if _, err := buf.ReadFrom(body); err != nil || buf.Len() == 0 {
+		return err
+	}
+	return codec.Unmarshal(buf.Bytes(), resContent)
+}
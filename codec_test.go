@@ -0,0 +1,95 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// countingCodec counts the calls to the default codec.
+type countingCodec struct {
+	jsonCodec
+	marshals, unmarshals int32
+}
+
+func (c *countingCodec) Marshal(v interface{}) ([]byte, error) {
+	atomic.AddInt32(&c.marshals, 1)
+	return c.jsonCodec.Marshal(v)
+}
+
+func (c *countingCodec) Unmarshal(data []byte, v interface{}) error {
+	atomic.AddInt32(&c.unmarshals, 1)
+	return c.jsonCodec.Unmarshal(data, v)
+}
+
+func TestWithCodec(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			testBody(t, r, `{"name":"foo"}`+"\n")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"name":"foo", "creator":{"name":"minux", "email":"minux@m.x"}}`)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"foo"}, {"name":"bar"}]`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"path":"/a.json", "type":"JSON", "content":{"a":"b"}, "revision":2}`)
+	})
+
+	codec := &countingCodec{}
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithCodec(codec), WithCache(10, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	project, _, err := c.CreateProject(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	testString(t, project.Creator.Name, "minux", "creator")
+	projects, _, err := c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	if len(projects) != 2 {
+		t.Errorf("ListProjects returned %+v, want 2 projects", projects)
+	}
+	if marshals, unmarshals := atomic.LoadInt32(&codec.marshals), atomic.LoadInt32(&codec.unmarshals); marshals != 1 ||
+		unmarshals != 2 {
+		t.Errorf("codec calls: (%v, %v), want (1, 2)", marshals, unmarshals)
+	}
+
+	// The cached responses are decoded with the codec as well.
+	query := &Query{Path: "/a.json", Type: Identity}
+	for i := 0; i < 2; i++ {
+		entry, _, err := c.GetFile(context.Background(), "foo", "bar", "2", query)
+		if err != nil {
+			t.Fatalf("GetFile returned error: %v", err)
+		}
+		testString(t, string(entry.Content), `{"a":"b"}`, "content")
+	}
+	if unmarshals := atomic.LoadInt32(&codec.unmarshals); unmarshals != 4 {
+		t.Errorf("codec unmarshals: %v, want 4", unmarshals)
+	}
+}
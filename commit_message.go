@@ -0,0 +1,190 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+	"unicode/utf8"
+)
+
+// The markups of the detail of a commit message.
+const (
+	MarkupPlaintext = "PLAINTEXT"
+	MarkupMarkdown  = "MARKDOWN"
+)
+
+// The maximum lengths of a commit message in characters, which CommitMessageBuilder validates.
+const (
+	MaxCommitSummaryLength = 256
+	MaxCommitDetailLength  = 65536
+)
+
+// CommitMessageBuilder builds a CommitMessage whose detail consists of paragraphs. For example:
+//
+//	commitMessage, err := centraldogma.NewCommitMessageBuilder("Update the rate limits").
+//	    Markdown().
+//	    Detailf("Requested by %s.", requester).
+//	    Ticket("PROJ-123", "https://issues.example.com/PROJ-123").
+//	    DiffStats(changes).
+//	    Build()
+type CommitMessageBuilder struct {
+	summary    string
+	paragraphs []string
+	markup     string
+	err        error
+}
+
+// NewCommitMessageBuilder returns a CommitMessageBuilder with the summary.
+func NewCommitMessageBuilder(summary string) *CommitMessageBuilder {
+	return &CommitMessageBuilder{summary: summary, markup: MarkupPlaintext}
+}
+
+// Markup sets the markup of the detail, which can be MarkupPlaintext or MarkupMarkdown. MarkupPlaintext is
+// used by default.
+func (b *CommitMessageBuilder) Markup(markup string) *CommitMessageBuilder {
+	b.markup = markup
+	return b
+}
+
+// Markdown sets the markup of the detail to MarkupMarkdown.
+func (b *CommitMessageBuilder) Markdown() *CommitMessageBuilder {
+	return b.Markup(MarkupMarkdown)
+}
+
+// Detail appends a paragraph to the detail.
+func (b *CommitMessageBuilder) Detail(paragraph string) *CommitMessageBuilder {
+	if paragraph = strings.TrimSpace(paragraph); len(paragraph) != 0 {
+		b.paragraphs = append(b.paragraphs, paragraph)
+	}
+	return b
+}
+
+// Detailf appends a paragraph formatted according to the format to the detail.
+func (b *CommitMessageBuilder) Detailf(format string, args ...interface{}) *CommitMessageBuilder {
+	return b.Detail(fmt.Sprintf(format, args...))
+}
+
+// Template appends a paragraph produced by applying the text/template to the data. The error of the template
+// is returned by Build.
+func (b *CommitMessageBuilder) Template(text string, data interface{}) *CommitMessageBuilder {
+	tmpl, err := template.New("commitMessage").Parse(text)
+	if err != nil {
+		b.setErr(err)
+		return b
+	}
+	var buf bytes.Buffer
+	if err = tmpl.Execute(&buf, data); err != nil {
+		b.setErr(err)
+		return b
+	}
+	return b.Detail(buf.String())
+}
+
+// Ticket appends a paragraph which links the ticket of the changes.
+func (b *CommitMessageBuilder) Ticket(id, url string) *CommitMessageBuilder {
+	if b.markup == MarkupMarkdown {
+		return b.Detailf("Ticket: [%s](%s)", id, url)
+	}
+	return b.Detailf("Ticket: %s (%s)", id, url)
+}
+
+// DiffStats appends a paragraph which summarizes the changes, e.g.
+//
+//	2 files changed: 1 upserted, 1 removed
+//	- UPSERT_JSON /a.json
+//	- REMOVE /b.txt
+func (b *CommitMessageBuilder) DiffStats(changes []*Change) *CommitMessageBuilder {
+	if len(changes) == 0 {
+		return b
+	}
+
+	var upserted, patched, removed, renamed int
+	for _, change := range changes {
+		switch change.Type {
+		case UpsertJSON, UpsertText:
+			upserted++
+		case ApplyJSONPatch, ApplyTextPatch:
+			patched++
+		case Remove:
+			removed++
+		case Rename:
+			renamed++
+		}
+	}
+
+	var stats []string
+	for _, stat := range []struct {
+		count int
+		verb  string
+	}{{upserted, "upserted"}, {patched, "patched"}, {removed, "removed"}, {renamed, "renamed"}} {
+		if stat.count != 0 {
+			stats = append(stats, fmt.Sprintf("%d %s", stat.count, stat.verb))
+		}
+	}
+
+	var buf strings.Builder
+	noun := "files"
+	if len(changes) == 1 {
+		noun = "file"
+	}
+	fmt.Fprintf(&buf, "%d %s changed: %s", len(changes), noun, strings.Join(stats, ", "))
+	for _, change := range changes {
+		path := change.Path
+		if b.markup == MarkupMarkdown {
+			path = "`" + path + "`"
+		}
+		fmt.Fprintf(&buf, "\n- %s %s", change.Type, path)
+	}
+	return b.Detail(buf.String())
+}
+
+func (b *CommitMessageBuilder) setErr(err error) {
+	if b.err == nil {
+		b.err = err
+	}
+}
+
+// Build validates and returns the CommitMessage.
+func (b *CommitMessageBuilder) Build() (*CommitMessage, error) {
+	if b.err != nil {
+		return nil, b.err
+	}
+	summary := strings.TrimSpace(b.summary)
+	if len(summary) == 0 {
+		return nil, fmt.Errorf("summary should not be empty")
+	}
+	if strings.ContainsAny(summary, "\r\n") {
+		return nil, fmt.Errorf("summary should be a single line: %q", summary)
+	}
+	if n := utf8.RuneCountInString(summary); n > MaxCommitSummaryLength {
+		return nil, fmt.Errorf("summary is too long (length: %d, max: %d)", n, MaxCommitSummaryLength)
+	}
+	if b.markup != MarkupPlaintext && b.markup != MarkupMarkdown {
+		return nil, fmt.Errorf("unknown markup: %q", b.markup)
+	}
+
+	detail := strings.Join(b.paragraphs, "\n\n")
+	if n := utf8.RuneCountInString(detail); n > MaxCommitDetailLength {
+		return nil, fmt.Errorf("detail is too long (length: %d, max: %d)", n, MaxCommitDetailLength)
+	}
+	commitMessage := &CommitMessage{Summary: summary, Detail: detail}
+	if len(detail) != 0 {
+		commitMessage.Markup = b.markup
+	}
+	return commitMessage, nil
+}
@@ -0,0 +1,77 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCommitMessageBuilder(t *testing.T) {
+	changes := []*Change{
+		{Path: "/a.json", Type: UpsertJSON},
+		{Path: "/b.json", Type: ApplyJSONPatch},
+		{Path: "/c.txt", Type: Remove},
+	}
+	commitMessage, err := NewCommitMessageBuilder(" Update the rate limits ").
+		Markdown().
+		Detailf("Requested by %s.", "minux").
+		Template("Affects {{len .}} services.", []string{"foo", "bar"}).
+		Ticket("PROJ-123", "https://issues.example.com/PROJ-123").
+		DiffStats(changes).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &CommitMessage{
+		Summary: "Update the rate limits",
+		Detail: "Requested by minux.\n\n" +
+			"Affects 2 services.\n\n" +
+			"Ticket: [PROJ-123](https://issues.example.com/PROJ-123)\n\n" +
+			"3 files changed: 1 upserted, 1 patched, 1 removed\n" +
+			"- UPSERT_JSON `/a.json`\n" +
+			"- APPLY_JSON_PATCH `/b.json`\n" +
+			"- REMOVE `/c.txt`",
+		Markup: MarkupMarkdown,
+	}
+	if !reflect.DeepEqual(commitMessage, want) {
+		t.Errorf("Build returned %+v, want %+v", commitMessage, want)
+	}
+
+	commitMessage, err = NewCommitMessageBuilder("Add a.json").Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (&CommitMessage{Summary: "Add a.json"}); !reflect.DeepEqual(commitMessage, want) {
+		t.Errorf("Build returned %+v, want %+v", commitMessage, want)
+	}
+}
+
+func TestCommitMessageBuilder_Validation(t *testing.T) {
+	for _, builder := range []*CommitMessageBuilder{
+		NewCommitMessageBuilder(""),
+		NewCommitMessageBuilder("first line\nsecond line"),
+		NewCommitMessageBuilder(strings.Repeat("a", MaxCommitSummaryLength+1)),
+		NewCommitMessageBuilder("Add a.json").Detail(strings.Repeat("a", MaxCommitDetailLength+1)),
+		NewCommitMessageBuilder("Add a.json").Markup("HTML"),
+		NewCommitMessageBuilder("Add a.json").Template("{{.Missing", nil),
+	} {
+		if commitMessage, err := builder.Build(); err == nil {
+			t.Errorf("Build should fail but returned %+v", commitMessage)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// CommitEvent represents a commit delivered by CommitStream with the changes made by the commit.
+type CommitEvent struct {
+	Commit  *Commit
+	Changes []*Change
+}
+
+// CommitStream delivers each commit pushed to the repository after the fromRevision through the returned
+// channel in the order of the revisions. A relative fromRevision such as -1 is resolved when the stream starts,
+// so that passing Head delivers only the commits pushed from now on. To resume a stream, pass the Revision of
+// the last Commit that was processed. The stream retries on failures using the BackoffPolicy of the client and
+// the channel is closed when the context is done. For example:
+//
+//	events, err := client.CommitStream(ctx, "foo", "bar", lastProcessedRevision)
+//	if err != nil {
+//	    panic(err)
+//	}
+//	for event := range events {
+//	    // event.Commit is pushed with event.Changes
+//	    lastProcessedRevision = event.Commit.Revision
+//	}
+func (c *Client) CommitStream(ctx context.Context,
+	projectName, repoName string, fromRevision int64) (<-chan CommitEvent, error) {
+	repository := (*repositoryService)(c.content)
+	from, _, err := repository.normalizeRevision(ctx, projectName, repoName, strconv.FormatInt(fromRevision, 10))
+	if err != nil {
+		return nil, err
+	}
+
+	backoff := c.backoffPolicy
+	if backoff == nil {
+		backoff = DefaultBackoffPolicy()
+	}
+	s := &commitStream{
+		client:       c,
+		projectName:  projectName,
+		repoName:     repoName,
+		lastRevision: from,
+		backoff:      backoff,
+		ch:           make(chan CommitEvent, DefaultChannelBuffer),
+	}
+	go s.run(ctx)
+	return s.ch, nil
+}
+
+type commitStream struct {
+	client       *Client
+	projectName  string
+	repoName     string
+	lastRevision int64 // the revision of the last delivered commit
+	backoff      BackoffPolicy
+	ch           chan CommitEvent
+}
+
+func (s *commitStream) run(ctx context.Context) {
+	defer close(s.ch)
+
+	numAttemptsSoFar := 0
+	for ctx.Err() == nil {
+		if err := s.next(ctx); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			numAttemptsSoFar++
+			delay := s.backoff.NextDelay(numAttemptsSoFar)
+			s.client.logger.Debugf("CommitStream failed: %s/%s, lastRevision=%v, err=%v, retrying in %v",
+				s.projectName, s.repoName, s.lastRevision, err, delay)
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(delay):
+			}
+			continue
+		}
+		numAttemptsSoFar = 0
+	}
+}
+
+// next waits for the new commits and delivers them.
+func (s *commitStream) next(ctx context.Context) error {
+	result := s.client.watch.watchRepo(ctx, s.projectName, s.repoName,
+		strconv.FormatInt(s.lastRevision, 10), "/**", defaultWatchTimeout)
+	if result.Err != nil {
+		return result.Err
+	}
+	if result.HttpStatusCode == http.StatusNotModified || result.Revision <= s.lastRevision {
+		return nil
+	}
+
+	it := s.client.content.historyIterator(ctx, s.projectName, s.repoName,
+		strconv.FormatInt(s.lastRevision+1, 10), strconv.FormatInt(result.Revision, 10), "/**")
+	for it.Next() {
+		commit := it.Commit()
+		changes, _, err := s.client.content.getDiffs(ctx, s.projectName, s.repoName,
+			strconv.FormatInt(commit.Revision-1, 10), strconv.FormatInt(commit.Revision, 10), "/**")
+		if err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case s.ch <- CommitEvent{Commit: commit, Changes: changes}:
+			s.lastRevision = commit.Revision
+		}
+	}
+	return it.Err()
+}
@@ -0,0 +1,114 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestCommitStream(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+	c.backoffPolicy = &ExponentialBackoff{Initial: 10 * time.Millisecond, Max: 10 * time.Millisecond}
+
+	var lock sync.Mutex
+	head := 3
+	failed := false
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		revision, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/revision/"))
+		if revision < 0 {
+			revision = head + revision + 1
+		}
+		fmt.Fprintf(w, `{"revision":%d}`, revision)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/**", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		lock.Lock()
+		defer lock.Unlock()
+		if head >= 7 {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		// Two commits are pushed at once.
+		head += 2
+		fmt.Fprintf(w, `{"revision":%d}`, head)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		defer lock.Unlock()
+		if !failed {
+			// The stream should retry from the last delivered revision.
+			failed = true
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		from, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/commits/"))
+		to, _ := strconv.Atoi(r.URL.Query().Get("to"))
+		var commits []*Commit
+		for rev := to; rev >= from; rev-- {
+			commits = append(commits, &Commit{Revision: int64(rev)})
+		}
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/compare", func(w http.ResponseWriter, r *http.Request) {
+		from, _ := strconv.Atoi(r.URL.Query().Get("from"))
+		to, _ := strconv.Atoi(r.URL.Query().Get("to"))
+		if from != to-1 {
+			t.Errorf("compare from %v to %v, want the changes of a single commit", from, to)
+		}
+		fmt.Fprintf(w, `[{"path":"/%d.txt", "type":"UPSERT_TEXT", "content":"%d"}]`, to, to)
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	events, err := c.CommitStream(ctx, "foo", "bar", -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for want := int64(4); want <= 7; want++ {
+		select {
+		case event := <-events:
+			if event.Commit.Revision != want {
+				t.Errorf("revision: %v, want %v", event.Commit.Revision, want)
+			}
+			wantPath := fmt.Sprintf("/%d.txt", want)
+			if len(event.Changes) != 1 || event.Changes[0].Path != wantPath {
+				t.Errorf("changes: %+v, want a change of %v", event.Changes, wantPath)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatal("failed to receive a commit")
+		}
+	}
+
+	cancel()
+	select {
+	case _, ok := <-events:
+		if ok {
+			t.Error("received an unexpected commit")
+		}
+	case <-time.After(3 * time.Second):
+		t.Error("the stream is not closed after the context is cancelled")
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// minCompressionSize is the minimum size of the request body to be compressed.
+const minCompressionSize = 1024
+
+// compressionTransport requests the gzip-compressed responses and decompresses them. It also compresses
+// the request bodies which are larger than minCompressionSize.
+type compressionTransport struct {
+	base http.RoundTripper
+}
+
+func (t *compressionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clone := cloneRequest(req)
+	if len(clone.Header.Get("Accept-Encoding")) == 0 {
+		clone.Header.Set("Accept-Encoding", "gzip")
+	}
+	if clone.Body != nil && clone.Body != http.NoBody && clone.ContentLength >= minCompressionSize &&
+		len(clone.Header.Get("Content-Encoding")) == 0 {
+		if err := compressBody(clone); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := t.base.RoundTrip(clone)
+	if err != nil {
+		return nil, err
+	}
+	if strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		res.Body = &gzipReader{body: res.Body}
+		res.Header.Del("Content-Encoding")
+		res.Header.Del("Content-Length")
+		res.ContentLength = -1
+		res.Uncompressed = true
+	}
+	return res, nil
+}
+
+// compressBody replaces the body of the request with the gzip-compressed one.
+func compressBody(req *http.Request) error {
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err = writer.Write(body); err != nil {
+		return err
+	}
+	if err = writer.Close(); err != nil {
+		return err
+	}
+
+	compressed := buf.Bytes()
+	req.Body = ioutil.NopCloser(bytes.NewReader(compressed))
+	req.GetBody = func() (io.ReadCloser, error) {
+		return ioutil.NopCloser(bytes.NewReader(compressed)), nil
+	}
+	req.ContentLength = int64(len(compressed))
+	req.Header.Set("Content-Encoding", "gzip")
+	return nil
+}
+
+// gzipReader decompresses the body lazily, so that an empty body of an error response does not fail.
+type gzipReader struct {
+	body   io.ReadCloser
+	reader *gzip.Reader
+	err    error
+}
+
+func (r *gzipReader) Read(p []byte) (int, error) {
+	if r.reader == nil && r.err == nil {
+		r.reader, r.err = gzip.NewReader(r.body)
+	}
+	if r.err != nil {
+		return 0, r.err
+	}
+	return r.reader.Read(p)
+}
+
+func (r *gzipReader) Close() error {
+	return r.body.Close()
+}
@@ -0,0 +1,71 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithCompression(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, "Content-Encoding", "gzip")
+		reader, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var reqBody push
+		if err = json.NewDecoder(reader).Decode(&reqBody); err != nil {
+			t.Fatal(err)
+		}
+		testString(t, reqBody.Changes[0].Content.(string), strings.Repeat("a", 4096), "content")
+		fmt.Fprint(w, `{"revision":2, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/list/**", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Accept-Encoding", "gzip")
+		w.Header().Set("Content-Encoding", "gzip")
+		writer := gzip.NewWriter(w)
+		fmt.Fprint(writer, `[{"path":"/a.json", "type":"JSON"}]`)
+		writer.Close()
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(&http.Transport{DisableCompression: true}),
+		WithCompression(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, _, err := c.ListFiles(context.Background(), "foo", "bar", "-1", "/**")
+	if err != nil {
+		t.Fatalf("ListFiles returned error: %v", err)
+	}
+	testString(t, entries[0].Path, "/a.json", "path")
+
+	changes := []*Change{{Path: "/a.txt", Type: UpsertText, Content: strings.Repeat("a", 4096)}}
+	if _, _, err = c.Push(context.Background(), "foo", "bar", "-1",
+		&CommitMessage{Summary: "Add a.txt"}, changes); err != nil {
+		t.Fatalf("Push returned error: %v", err)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+)
+
+// PushTransform returns the changes to push on top of the baseRevision. It is invoked again with a new
+// baseRevision when the previous push failed due to a conflict.
+type PushTransform func(ctx context.Context, baseRevision Revision) ([]*Change, error)
+
+func (con *contentService) pushIfUnchanged(ctx context.Context, projectName, repoName string,
+	baseRevision Revision, commitMessage *CommitMessage, changes []*Change) (*PushResult, int, error) {
+	if baseRevision.IsRelative() || baseRevision == 0 {
+		return nil, UnknownHttpStatusCode, fmt.Errorf(
+			"baseRevision should be an absolute revision (baseRevision: %v)", baseRevision)
+	}
+	return con.push(ctx, projectName, repoName, baseRevision.String(), commitMessage, changes)
+}
+
+func (con *contentService) pushCAS(ctx context.Context, projectName, repoName string,
+	commitMessage *CommitMessage, transform PushTransform, maxRetries int) (*PushResult, int, error) {
+	if transform == nil {
+		return nil, UnknownHttpStatusCode, fmt.Errorf("transform should not be nil")
+	}
+
+	repository := (*repositoryService)(con)
+	var (
+		result         *PushResult
+		httpStatusCode int
+		err            error
+	)
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		var head int64
+		head, httpStatusCode, err = repository.fetchHead(ctx, projectName, repoName)
+		if err != nil {
+			return nil, httpStatusCode, err
+		}
+
+		var changes []*Change
+		if changes, err = transform(ctx, Rev(head)); err != nil {
+			return nil, UnknownHttpStatusCode, err
+		}
+
+		result, httpStatusCode, err = con.pushIfUnchanged(ctx, projectName, repoName, Rev(head),
+			commitMessage, changes)
+		if !isChangeConflict(err) {
+			return result, httpStatusCode, err
+		}
+		con.client.logger.Debugf("The push conflicted: %s/%s, baseRevision=%v, attempt=%v",
+			projectName, repoName, head, attempt+1)
+	}
+	con.client.logger.Warnf("Gave up the push due to conflicts: %s/%s, attempts=%v",
+		projectName, repoName, maxRetries+1)
+	return nil, httpStatusCode, err
+}
+
+func isChangeConflict(err error) bool {
+	apiError, ok := err.(*APIError)
+	return ok && apiError.Is(ErrChangeConflict)
+}
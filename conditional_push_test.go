@@ -0,0 +1,99 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestPushIfUnchanged(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURLQuery(t, r, "revision", "2")
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	commitMessage := &CommitMessage{Summary: "Add a.json"}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}
+	result, _, err := c.PushIfUnchanged(context.Background(), "foo", "bar", Rev(2), commitMessage, changes)
+	if err != nil {
+		t.Fatalf("PushIfUnchanged returned error: %v", err)
+	}
+	if result.Revision != 3 {
+		t.Errorf("PushIfUnchanged returned revision %v, want %v", result.Revision, 3)
+	}
+
+	if _, _, err = c.PushIfUnchanged(context.Background(), "foo", "bar", Head, commitMessage, changes); err == nil {
+		t.Errorf("PushIfUnchanged should fail for a relative revision")
+	}
+}
+
+func TestPushCAS(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	head := 2
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprintf(w, `{"revision":%d}`, head)
+	})
+	pushes := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		pushes++
+		if pushes == 1 {
+			// Someone else pushed a commit in the meantime.
+			testURLQuery(t, r, "revision", "2")
+			head = 3
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.ChangeConflictException",`+
+				`"message":"conflict"}`)
+			return
+		}
+		testURLQuery(t, r, "revision", "3")
+		fmt.Fprint(w, `{"revision":4, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	var baseRevisions []Revision
+	transform := func(ctx context.Context, baseRevision Revision) ([]*Change, error) {
+		baseRevisions = append(baseRevisions, baseRevision)
+		return []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}, nil
+	}
+
+	commitMessage := &CommitMessage{Summary: "Add a.json"}
+	result, _, err := c.PushCAS(context.Background(), "foo", "bar", commitMessage, transform, 1)
+	if err != nil {
+		t.Fatalf("PushCAS returned error: %v", err)
+	}
+	if result.Revision != 4 {
+		t.Errorf("PushCAS returned revision %v, want %v", result.Revision, 4)
+	}
+	if len(baseRevisions) != 2 || baseRevisions[0] != 2 || baseRevisions[1] != 3 {
+		t.Errorf("transform was invoked with %v, want [2 3]", baseRevisions)
+	}
+
+	head, pushes = 2, 0
+	_, httpStatusCode, err := c.PushCAS(context.Background(), "foo", "bar", commitMessage, transform, 0)
+	if !isChangeConflict(err) {
+		t.Errorf("PushCAS returned %v, want a conflict", err)
+	}
+	testStatusCode(t, httpStatusCode, http.StatusConflict)
+}
@@ -0,0 +1,206 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// ConfigValidator validates a configuration decoded by ConfigBinder.
+type ConfigValidator[T any] func(config T) error
+
+// ConfigRejectionListener listens to the configurations rejected by ConfigBinder.
+type ConfigRejectionListener func(revision int64, err error)
+
+// ConfigBinder watches a JSON file, decodes it into T and publishes the decoded configuration only if it passes
+// all validators. When the file is changed into an invalid configuration, the last valid configuration is kept.
+type ConfigBinder[T any] struct {
+	watcher    *Watcher
+	validators []ConfigValidator[T]
+
+	lock               sync.RWMutex
+	current            *TypedWatchResult[T] // the last valid configuration. nil if not available yet.
+	listeners          []TypedWatchListener[T]
+	rejectionListeners []ConfigRejectionListener
+
+	initialValueCh chan struct{} // closed when the first valid configuration is published.
+}
+
+// BindConfig returns a ConfigBinder which decodes the JSON file specified in the Query into T and validates it
+// using the validators whenever a new revision becomes available. For example:
+//
+//	type MyConfig struct {
+//	    Port int `json:"port"`
+//	}
+//
+//	query := &Query{Path: "/config.json", Type: Identity}
+//	binder, err := centraldogma.BindConfig[MyConfig](ctx, client, "foo", "bar", query,
+//	    func(config MyConfig) error {
+//	        if config.Port <= 0 {
+//	            return errors.New("invalid port")
+//	        }
+//	        return nil
+//	    })
+//	if err != nil {
+//	    panic(err)
+//	}
+//	defer binder.Close()
+//
+//	config, revision, ok := binder.Current()
+func BindConfig[T any](ctx context.Context, c *Client, projectName, repoName string, query *Query,
+	validators ...ConfigValidator[T]) (*ConfigBinder[T], error) {
+	w, err := c.watch.fileWatcher(ctx, projectName, repoName, query)
+	if err != nil {
+		return nil, err
+	}
+
+	b := &ConfigBinder[T]{
+		watcher:        w,
+		validators:     validators,
+		initialValueCh: make(chan struct{}),
+	}
+	if err = w.Watch(b.onUpdate); err != nil {
+		return nil, err
+	}
+	w.start()
+	return b, nil
+}
+
+func (b *ConfigBinder[T]) onUpdate(result WatchResult) {
+	if result.Err != nil {
+		return
+	}
+
+	var config T
+	err := b.watcher.codec.Unmarshal(result.Entry.Content, &config)
+	if err != nil {
+		err = fmt.Errorf("failed to decode: %v", err)
+	} else {
+		for _, validator := range b.validators {
+			if err = validator(config); err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		b.reject(result.Revision, err)
+		return
+	}
+
+	b.lock.Lock()
+	initial := b.current == nil
+	b.current = &TypedWatchResult[T]{Revision: result.Revision, Value: config}
+	listeners := b.listeners
+	b.lock.Unlock()
+
+	if initial {
+		close(b.initialValueCh)
+	}
+	for _, listener := range listeners {
+		listener(result.Revision, config)
+	}
+}
+
+func (b *ConfigBinder[T]) reject(revision int64, err error) {
+	b.watcher.logger.Warnf("ConfigBinder keeps the last valid configuration: %s/%s%s, rev=%v, err=%v",
+		b.watcher.projectName, b.watcher.repoName, b.watcher.pathPattern, revision, err)
+
+	b.lock.RLock()
+	rejectionListeners := b.rejectionListeners
+	b.lock.RUnlock()
+	for _, listener := range rejectionListeners {
+		listener(revision, err)
+	}
+}
+
+// Current returns the last valid configuration and its revision. ok is false if no valid configuration is
+// available yet.
+func (b *ConfigBinder[T]) Current() (config T, revision int64, ok bool) {
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+	if b.current == nil {
+		return config, 0, false
+	}
+	return b.current.Value, b.current.Revision, true
+}
+
+// AwaitInitialValueWithContext awaits for the first valid configuration to be available until the specified
+// context is done.
+func (b *ConfigBinder[T]) AwaitInitialValueWithContext(ctx context.Context) (config T, revision int64, err error) {
+	select {
+	case <-b.initialValueCh:
+		config, revision, _ = b.Current()
+		return config, revision, nil
+	case <-ctx.Done():
+		return config, 0, fmt.Errorf("failed to get the initial value: %v", ctx.Err())
+	}
+}
+
+// Watch registers a func that will be invoked when a valid configuration is published. The func is invoked
+// immediately if a valid configuration is already available.
+func (b *ConfigBinder[T]) Watch(listener TypedWatchListener[T]) error {
+	if listener == nil {
+		return nil // do nothing
+	}
+
+	if b.watcher.isStopped() {
+		return ErrWatcherClosed
+	}
+
+	b.lock.Lock()
+	// copy-on-write so that onUpdate can iterate over a snapshot without holding the lock.
+	listeners := make([]TypedWatchListener[T], len(b.listeners)+1)
+	copy(listeners, b.listeners)
+	listeners[len(b.listeners)] = listener
+	b.listeners = listeners
+	current := b.current
+	b.lock.Unlock()
+
+	if current != nil {
+		listener(current.Revision, current.Value)
+	}
+	return nil
+}
+
+// OnRejected registers a func that will be invoked when a new revision of the file is rejected because it
+// cannot be decoded or fails the validation.
+func (b *ConfigBinder[T]) OnRejected(listener ConfigRejectionListener) {
+	if listener == nil {
+		return
+	}
+
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	rejectionListeners := make([]ConfigRejectionListener, len(b.rejectionListeners)+1)
+	copy(rejectionListeners, b.rejectionListeners)
+	rejectionListeners[len(b.rejectionListeners)] = listener
+	b.rejectionListeners = rejectionListeners
+}
+
+// Close stops watching the file.
+func (b *ConfigBinder[T]) Close() {
+	b.watcher.Close()
+}
+
+// CloseAndWait stops watching the file and waits until the listeners being invoked return or the context is
+// done.
+func (b *ConfigBinder[T]) CloseAndWait(ctx context.Context) error {
+	return b.watcher.CloseAndWait(ctx)
+}
@@ -0,0 +1,109 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package centraldogma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestBindConfig(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	// port is valid at the revisions 2 and 4 only. The revision 3 has an invalid port and the revision 5 is
+	// not decodable.
+	contents := map[int]string{2: `{"port":8080}`, 3: `{"port":-1}`, 4: `{"port":8081}`, 5: `{"port":"a"}`}
+	revision := 1
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		if revision == 5 {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		revision++
+		fmt.Fprint(w, `{"revision":`+strconv.Itoa(revision)+`,
+"entry":{"path":"/a.json", "type":"JSON", "content": `+contents[revision]+`}}`)
+	})
+
+	type myConfig struct {
+		Port int `json:"port"`
+	}
+	validator := func(config myConfig) error {
+		if config.Port <= 0 {
+			return errors.New("invalid port")
+		}
+		return nil
+	}
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	binder, err := BindConfig[myConfig](context.Background(), c, "foo", "bar", query, validator)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer binder.Close()
+
+	rejected := make(chan int64, 128)
+	binder.OnRejected(func(revision int64, err error) { rejected <- revision })
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	config, rev, err := binder.AwaitInitialValueWithContext(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Port != 8080 || rev != 2 {
+		t.Errorf("initial value: %+v at %v, want port 8080 at 2", config, rev)
+	}
+
+	published := make(chan int, 128)
+	_ = binder.Watch(func(revision int64, value myConfig) { published <- value.Port })
+
+	for _, want := range []int64{3, 5} {
+		select {
+		case revision := <-rejected:
+			if revision != want {
+				t.Errorf("rejected revision: %v, want %v", revision, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("failed to reject")
+		}
+	}
+
+	// 8080 is notified on registration, then 8081 at the revision 4.
+	for _, want := range []int{8080, 8081} {
+		select {
+		case port := <-published:
+			if port != want {
+				t.Errorf("published port: %v, want %v", port, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("failed to publish")
+		}
+	}
+
+	// The last valid configuration is kept.
+	if config, rev, ok := binder.Current(); !ok || config.Port != 8081 || rev != 4 {
+		t.Errorf("current: %+v at %v (%v), want port 8081 at 4", config, rev, ok)
+	}
+}
@@ -2,6 +2,7 @@ package centraldogma
 
 import (
 	"fmt"
+	"time"
 )
 
 var (
@@ -11,6 +12,8 @@ var (
 
 	ErrWatcherClosed = fmt.Errorf("watcher is closed")
 
+	ErrTransactionDone = fmt.Errorf("transaction is already committed")
+
 	ErrTokenEmpty = fmt.Errorf("token should not be empty")
 
 	ErrTransportMustBeSet = fmt.Errorf("transport should not be nil")
@@ -18,6 +21,38 @@ var (
 	ErrTransportMustNotBeOAuth2 = fmt.Errorf("transport cannot be oauth2.Transport")
 
 	ErrMetricCollectorConfigMustBeSet = fmt.Errorf("metric collector config should not be nil")
+
+	ErrHTTPClientConflict = fmt.Errorf("http client cannot be used with transport, tls config or authentication options")
+
+	ErrTLSConfigConflict = fmt.Errorf("tls config cannot be used with transport")
+
+	ErrClientCertificateConflict = fmt.Errorf("client certificate cannot be used with client certificate files")
+
+	ErrContentTooLarge = fmt.Errorf("content is too large")
+
+	ErrInstrumentationConflict = fmt.Errorf("instrumentation cannot be used with prometheus registry")
+
+	ErrDNSRefreshConflict = fmt.Errorf("dns refresh interval cannot be used with http client or transport")
+
+	ErrProxyConflict = fmt.Errorf("proxy cannot be used with http client, transport or dns refresh interval")
+
+	ErrDialContextConflict = fmt.Errorf("dial context cannot be used with http client, transport, proxy or dns refresh")
+
+	ErrTransportTuningConflict = fmt.Errorf("connection pool or http2 option cannot be used with http client or transport")
+
+	ErrCleartextHTTP2Conflict = fmt.Errorf("connection pool options cannot be used with cleartext HTTP/2")
+
+	ErrVCRInteractionNotFound = fmt.Errorf("no recorded interaction matches the request")
+
+	ErrBaseURLSchemeMismatch = fmt.Errorf("base url in the context must have the same scheme as the one of the client")
+	ErrBaseURLNotAllowed     = fmt.Errorf("base url in the context must be the one of the client or its endpoints")
+	ErrCallTokenNotSupported = fmt.Errorf("token in the context cannot replace the credentials of the transport")
+
+	ErrPasswordLoginConflict = fmt.Errorf("password login cannot be used with token")
+	ErrTokenProviderConflict = fmt.Errorf("token provider cannot be used with token or password login")
+	ErrTokenSourceConflict   = fmt.Errorf("token source cannot be used with token, password login or token provider")
+
+	ErrCircuitOpen = fmt.Errorf("circuit breaker is open because the server keeps failing")
 )
 
 const (
@@ -26,6 +61,15 @@ const (
 	UnknownHttpStatusCode = 0
 
 	DefaultClientName = "centralDogmaClient"
+
+	// defaultIdleConnTimeout is the same as the one of http.DefaultTransport.
+	defaultIdleConnTimeout = 90 * time.Second
+	// tlsHandshakeTimeout is the same as the TLSHandshakeTimeout of http.DefaultTransport.
+	tlsHandshakeTimeout = 10 * time.Second
+
+	// MaxBinaryContentLength is the maximum length of the binary data which can be pushed or retrieved
+	// as a base64-encoded text file.
+	MaxBinaryContentLength = 8 * 1024 * 1024
 )
 
 const (
@@ -33,8 +77,16 @@ const (
 	repos    = "repos"
 	contents = "contents"
 	commits  = "commits"
+	tokens   = "tokens"
+	metadata = "metadata"
+	members  = "members"
+
+	mirrors     = "mirrors"
+	credentials = "credentials"
 
 	actionList    = "list"
 	actionCompare = "compare"
 	actionRemoved = "removed"
+	actionMerge   = "merge"
+	actionPreview = "preview"
 )
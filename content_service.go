@@ -15,14 +15,20 @@
 package centraldogma
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
+	"strconv"
 	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
 )
 
 type contentService service
@@ -81,19 +87,202 @@ func (c *Entry) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
+// Text returns the content of the entry as a string. It fails if the entry is a directory.
+func (c *Entry) Text() (string, error) {
+	if c.Type == Directory {
+		return "", fmt.Errorf("the entry is a directory (path: %v)", c.Path)
+	}
+	return string(c.Content), nil
+}
+
+// RawJSON returns the content of the entry as a json.RawMessage. It fails if the entry is not a JSON entry.
+func (c *Entry) RawJSON() (json.RawMessage, error) {
+	if c.Type != JSON {
+		return nil, fmt.Errorf("the entry is not a JSON entry (path: %v, type: %v)", c.Path, c.Type)
+	}
+	return json.RawMessage(c.Content), nil
+}
+
+// UnmarshalTo decodes the content of the JSON entry into the value pointed to by v.
+// It fails if the entry is not a JSON entry.
+func (c *Entry) UnmarshalTo(v interface{}) error {
+	raw, err := c.RawJSON()
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Binary decodes the base64-encoded content of the text entry which was pushed using NewUpsertBinary.
+func (c *Entry) Binary() ([]byte, error) {
+	if c.Type != Text {
+		return nil, fmt.Errorf("the entry is not a text entry (path: %v, type: %v)", c.Path, c.Type)
+	}
+	content := bytes.TrimSpace(c.Content)
+	if base64.StdEncoding.DecodedLen(len(content)) > MaxBinaryContentLength+2 {
+		// DecodedLen can be at most 2 bytes larger than the actual length because of the padding.
+		return nil, ErrContentTooLarge
+	}
+	data := make([]byte, base64.StdEncoding.DecodedLen(len(content)))
+	n, err := base64.StdEncoding.Decode(data, content)
+	if err != nil {
+		return nil, err
+	}
+	if n > MaxBinaryContentLength {
+		return nil, ErrContentTooLarge
+	}
+	return data[:n], nil
+}
+
 // EntryContent represents the content of an entry
 type EntryContent []byte
 
+// String returns the content as a string.
+func (e EntryContent) String() string {
+	return string(e)
+}
+
 func (e *EntryContent) UnmarshalJSON(b []byte) error {
 	if n := len(b); n >= 2 && b[0] == 34 && b[n-1] == 34 { // string
+		if unquoted, ok := unquoteJSONString(b[1 : n-1]); ok {
+			*e = unquoted
+			return nil
+		}
 		var dst string
 		if err := json.Unmarshal(b, &dst); err != nil {
 			return err
 		}
 		*e = []byte(dst)
 	} else {
-		*e = b
+		// b may be reused by the json.Decoder after returning, so it must be copied.
+		*e = append([]byte(nil), b...)
+	}
+	return nil
+}
+
+// unquoteJSONString unescapes the content of a JSON string into a new slice, so that the content is copied only
+// once instead of being decoded into a string and copied again. It returns false if the content needs
+// the handling of encoding/json, e.g. an invalid UTF-8 sequence or an unpaired surrogate, so that the result
+// is always the same as the one of encoding/json.
+func unquoteJSONString(s []byte) ([]byte, bool) {
+	for _, c := range s {
+		if c < 0x20 {
+			return nil, false
+		}
+	}
+	if !utf8.Valid(s) {
+		return nil, false
+	}
+
+	out := make([]byte, 0, len(s))
+	for len(s) > 0 {
+		i := bytes.IndexByte(s, '\\')
+		if i < 0 {
+			return append(out, s...), true
+		}
+		out = append(out, s[:i]...)
+		if i+1 >= len(s) {
+			return nil, false
+		}
+		c := s[i+1]
+		s = s[i+2:]
+		switch c {
+		case '"', '\\', '/':
+		case 'b':
+			c = '\b'
+		case 'f':
+			c = '\f'
+		case 'n':
+			c = '\n'
+		case 'r':
+			c = '\r'
+		case 't':
+			c = '\t'
+		case 'u':
+			r, ok := parseHex4(s)
+			if !ok {
+				return nil, false
+			}
+			s = s[4:]
+			if utf16.IsSurrogate(r) {
+				if len(s) < 6 || s[0] != '\\' || s[1] != 'u' {
+					return nil, false
+				}
+				low, ok := parseHex4(s[2:])
+				if r = utf16.DecodeRune(r, low); !ok || r == utf8.RuneError {
+					return nil, false
+				}
+				s = s[6:]
+			}
+			var buf [utf8.UTFMax]byte
+			out = append(out, buf[:utf8.EncodeRune(buf[:], r)]...)
+			continue
+		default:
+			return nil, false
+		}
+		out = append(out, c)
+	}
+	return out, true
+}
+
+func parseHex4(s []byte) (rune, bool) {
+	if len(s) < 4 {
+		return 0, false
+	}
+	var r rune
+	for _, c := range s[:4] {
+		switch {
+		case '0' <= c && c <= '9':
+			c -= '0'
+		case 'a' <= c && c <= 'f':
+			c = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			c = c - 'A' + 10
+		default:
+			return 0, false
+		}
+		r = r<<4 | rune(c)
+	}
+	return r, true
+}
+
+// MergeSource specifies a file to be merged by a MergeQuery. If Optional is true, the file is ignored when
+// it does not exist. Otherwise, the merge fails.
+type MergeSource struct {
+	Path     string
+	Optional bool
+}
+
+// MergeQuery specifies a query on the JSON files to be merged.
+type MergeQuery struct {
+	MergeSources []*MergeSource
+	// QueryType can be "identity" or "json_path". "identity" is used to retrieve the merged content as it is.
+	// "json_path" applies a series of JSON path to the merged content.
+	Type        QueryType
+	Expressions []string
+}
+
+// MergedEntry represents a merged entry in the repository.
+type MergedEntry struct {
+	Paths    []string     `json:"paths"`
+	Type     EntryType    `json:"type"` // can be JSON only
+	Content  EntryContent `json:"content,omitempty"`
+	Revision int64        `json:"revision,omitempty"`
+}
+
+func (m *MergedEntry) UnmarshalJSON(b []byte) error {
+	type Alias MergedEntry
+	auxiliary := &struct {
+		Type string `json:"type"`
+		*Alias
+	}{
+		Alias: (*Alias)(m),
 	}
+
+	if err := json.Unmarshal(b, &auxiliary); err != nil {
+		return err
+	}
+	m.Type = entryTypeMap[auxiliary.Type]
 	return nil
 }
 
@@ -103,6 +292,30 @@ type PushResult struct {
 	PushedAt string `json:"pushedAt"`
 }
 
+// PushResultDetail represents a result of push in the repository along with the author of the commit and
+// the changes which were actually applied by the server after normalization.
+type PushResultDetail struct {
+	PushResult
+	Author  Author    `json:"author,omitempty"`
+	Changes []*Change `json:"changes,omitempty"`
+}
+
+// PushDetailError is returned by PushWithResultDetail when the changes were pushed but the author and
+// the changes of the commit could not be retrieved. The push must not be retried because the commit was made.
+type PushDetailError struct {
+	Revision int64 // The revision of the commit made by the push.
+	Err      error
+}
+
+func (e *PushDetailError) Error() string {
+	return fmt.Sprintf("pushed at revision %d but failed to retrieve the commit: %v", e.Revision, e.Err)
+}
+
+// Unwrap returns the error which occurred while retrieving the commit.
+func (e *PushDetailError) Unwrap() error {
+	return e.Err
+}
+
 // Commit represents a commit in the repository.
 type Commit struct {
 	Revision      int64         `json:"revision"`
@@ -111,6 +324,12 @@ type Commit struct {
 	PushedAt      string        `json:"pushedAt,omitempty"`
 }
 
+// CommitDetail represents a commit in the repository along with the changes it made.
+type CommitDetail struct {
+	Commit
+	Changes []*Change `json:"changes,omitempty"`
+}
+
 // CommitMessages represents a commit message in the repository.
 type CommitMessage struct {
 	Summary string `json:"summary"`
@@ -154,6 +373,35 @@ func (c *Change) UnmarshalJSON(b []byte) error {
 
 func (con *contentService) listFiles(ctx context.Context,
 	projectName, repoName, revision, pathPattern string) ([]*Entry, int, error) {
+	req, err := con.listFilesRequest(projectName, repoName, revision, pathPattern)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	var entries []*Entry
+	httpStatusCode, err := con.client.do(ctx, req, &entries, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return entries, httpStatusCode, nil
+}
+
+func (con *contentService) listFilesIterator(ctx context.Context, projectName, repoName, revision,
+	pathPattern string, entryTypes []EntryType) (*EntryIterator, int, error) {
+	req, err := con.listFilesRequest(projectName, repoName, revision, pathPattern)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	body, httpStatusCode, err := con.client.doStream(ctx, req)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return newEntryIterator(body, entryTypes), httpStatusCode, nil
+}
+
+func (con *contentService) listFilesRequest(
+	projectName, repoName, revision, pathPattern string) (*http.Request, error) {
 	if len(pathPattern) != 0 && !strings.HasPrefix(pathPattern, "/") {
 		// Normalize the pathPattern when it does not start with "/" so that the pathPattern fits into the url.
 		pathPattern = "/**/" + pathPattern
@@ -167,7 +415,7 @@ func (con *contentService) listFiles(ctx context.Context,
 		actionList, pathPattern,
 	))
 	if err != nil {
-		return nil, UnknownHttpStatusCode, err
+		return nil, err
 	}
 
 	// build query params
@@ -175,17 +423,7 @@ func (con *contentService) listFiles(ctx context.Context,
 	setRevision(&q, revision)
 	u.RawQuery = q.Encode()
 
-	req, err := con.client.newRequest(http.MethodGet, u, nil)
-	if err != nil {
-		return nil, UnknownHttpStatusCode, err
-	}
-
-	var entries []*Entry
-	httpStatusCode, err := con.client.do(ctx, req, &entries, false)
-	if err != nil {
-		return nil, httpStatusCode, err
-	}
-	return entries, httpStatusCode, nil
+	return con.client.newRequest(http.MethodGet, u, nil)
 }
 
 func (con *contentService) getFile(
@@ -222,23 +460,49 @@ func (con *contentService) getFile(
 	if err != nil {
 		return nil, httpStatusCode, err
 	}
+	con.client.normalizeEntry(entry)
 
 	return entry, httpStatusCode, nil
 }
 
-func (con *contentService) getFiles(ctx context.Context,
-	projectName, repoName, revision, pathPattern string) ([]*Entry, int, error) {
-	if len(pathPattern) != 0 && !strings.HasPrefix(pathPattern, "/") {
-		// Normalize the pathPattern when it does not start with "/" so that the pathPattern fits into the url.
-		pathPattern = "/**/" + pathPattern
+func (con *contentService) openFile(ctx context.Context,
+	projectName, repoName, revision, filePath string) (io.ReadCloser, int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		projects, projectName,
+		repos, repoName,
+		contents, filePath,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
 	}
 
+	// build query params
+	q := u.Query()
+	setRevision(&q, revision)
+	u.RawQuery = q.Encode()
+
+	req, err := con.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	body, httpStatusCode, err := con.client.doStream(ctx, req)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return newEntryReader(body), httpStatusCode, nil
+}
+
+func (con *contentService) openRawFile(ctx context.Context,
+	projectName, repoName, revision, filePath string) (io.ReadCloser, int, error) {
 	// build relative url
 	u, err := url.Parse(path.Join(
 		defaultPathPrefix,
 		projects, projectName,
 		repos, repoName,
-		contents, pathPattern,
+		contents, filePath,
 	))
 	if err != nil {
 		return nil, UnknownHttpStatusCode, err
@@ -247,6 +511,66 @@ func (con *contentService) getFiles(ctx context.Context,
 	// build query params
 	q := u.Query()
 	setRevision(&q, revision)
+	q.Set("viewRaw", "true")
+	u.RawQuery = q.Encode()
+
+	req, err := con.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+	req.Header.Set("Accept", "text/plain, application/octet-stream, application/json;q=0.5")
+
+	body, header, httpStatusCode, err := con.client.doStreamWithHeader(ctx, req)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	if strings.HasPrefix(header.Get("Content-Type"), "application/json") {
+		// The server responded with the entry instead of the raw content.
+		return newEntryReader(body), httpStatusCode, nil
+	}
+	return body, httpStatusCode, nil
+}
+
+func (con *contentService) downloadTo(ctx context.Context,
+	projectName, repoName, revision, filePath string, w io.Writer) (int64, int, error) {
+	body, httpStatusCode, err := con.openRawFile(ctx, projectName, repoName, revision, filePath)
+	if err != nil {
+		return 0, httpStatusCode, err
+	}
+	defer body.Close()
+
+	written, err := io.Copy(w, body)
+	if err != nil {
+		return written, UnknownHttpStatusCode, err
+	}
+	return written, httpStatusCode, nil
+}
+
+func (con *contentService) getMergedEntry(ctx context.Context,
+	projectName, repoName, revision string, mergeQuery *MergeQuery) (*MergedEntry, int, error) {
+	if mergeQuery == nil {
+		return nil, UnknownHttpStatusCode, errors.New("mergeQuery should not be nil")
+	}
+	if len(mergeQuery.MergeSources) == 0 {
+		return nil, UnknownHttpStatusCode, errors.New("mergeSources should not be empty")
+	}
+
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		projects, projectName,
+		repos, repoName,
+		actionMerge,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	// build query params
+	q := u.Query()
+	if err := getMergedEntryURLValues(&q, revision, mergeQuery); err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
 	u.RawQuery = q.Encode()
 
 	req, err := con.client.newRequest(http.MethodGet, u, nil)
@@ -254,14 +578,123 @@ func (con *contentService) getFiles(ctx context.Context,
 		return nil, UnknownHttpStatusCode, err
 	}
 
+	mergedEntry := new(MergedEntry)
+	httpStatusCode, err := con.client.do(ctx, req, mergedEntry, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return mergedEntry, httpStatusCode, nil
+}
+
+func (con *contentService) diffAsJSONPatch(ctx context.Context,
+	projectName, repoName, path string, newValue interface{}) (*Change, int, error) {
+	if err := validateJSONPath(path); err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+	target, err := toJSONValue(newValue)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	entry, httpStatusCode, err := con.getFile(ctx, projectName, repoName, "-1", &Query{Path: path, Type: Identity})
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	raw, err := entry.RawJSON()
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	var source interface{}
+	if err = json.Unmarshal(raw, &source); err != nil {
+		return nil, httpStatusCode, err
+	}
+
+	operations := diffJSON(source, target)
+	if len(operations) == 0 {
+		return nil, httpStatusCode, ErrRedundantChange
+	}
+	return &Change{Path: path, Type: ApplyJSONPatch, Content: operations}, httpStatusCode, nil
+}
+
+func (con *contentService) getBinaryFile(ctx context.Context,
+	projectName, repoName, revision, path string) ([]byte, int, error) {
+	entry, httpStatusCode, err := con.getFile(ctx, projectName, repoName, revision, &Query{Path: path, Type: Identity})
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	data, err := entry.Binary()
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return data, httpStatusCode, nil
+}
+
+func (con *contentService) getFiles(ctx context.Context,
+	projectName, repoName, revision, pathPattern string) ([]*Entry, int, error) {
+	return con.getFilesWithJSONPaths(ctx, projectName, repoName, revision, pathPattern, nil)
+}
+
+func (con *contentService) getFilesWithJSONPaths(ctx context.Context,
+	projectName, repoName, revision, pathPattern string, jsonPaths []string) ([]*Entry, int, error) {
+	req, err := con.getFilesRequest(projectName, repoName, revision, pathPattern, jsonPaths)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
 	var entries []*Entry
 	httpStatusCode, err := con.client.do(ctx, req, &entries, false)
 	if err != nil {
 		return nil, httpStatusCode, err
 	}
+	for _, entry := range entries {
+		con.client.normalizeEntry(entry)
+	}
 	return entries, httpStatusCode, nil
 }
 
+func (con *contentService) getFilesIterator(ctx context.Context, projectName, repoName, revision,
+	pathPattern string, entryTypes []EntryType) (*EntryIterator, int, error) {
+	req, err := con.getFilesRequest(projectName, repoName, revision, pathPattern, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	body, httpStatusCode, err := con.client.doStream(ctx, req)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return newEntryIterator(body, entryTypes), httpStatusCode, nil
+}
+
+func (con *contentService) getFilesRequest(
+	projectName, repoName, revision, pathPattern string, jsonPaths []string) (*http.Request, error) {
+	if len(pathPattern) != 0 && !strings.HasPrefix(pathPattern, "/") {
+		// Normalize the pathPattern when it does not start with "/" so that the pathPattern fits into the url.
+		pathPattern = "/**/" + pathPattern
+	}
+
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		projects, projectName,
+		repos, repoName,
+		contents, pathPattern,
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	// build query params
+	q := u.Query()
+	setRevision(&q, revision)
+	for _, jsonPath := range jsonPaths {
+		q.Add("jsonpath", jsonPath)
+	}
+	u.RawQuery = q.Encode()
+
+	return con.client.newRequest(http.MethodGet, u, nil)
+}
+
 func (con *contentService) getHistory(ctx context.Context,
 	projectName, repoName, from, to, pathPattern string, maxCommits int) ([]*Commit, int, error) {
 
@@ -379,6 +812,53 @@ func (con *contentService) getDiffs(ctx context.Context,
 	return changes, httpStatusCode, nil
 }
 
+func (con *contentService) previewDiffs(ctx context.Context,
+	projectName, repoName, baseRevision string, changes []*Change) ([]*Change, int, error) {
+	if len(changes) == 0 {
+		return nil, UnknownHttpStatusCode, errors.New("no changes to preview")
+	}
+
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		projects, projectName,
+		repos, repoName,
+		actionPreview,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	// build query params
+	q := u.Query()
+	setRevision(&q, baseRevision)
+	u.RawQuery = q.Encode()
+
+	req, err := con.client.newRequest(http.MethodPost, u, changes)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	var previewed []*Change
+	httpStatusCode, err := con.client.do(ctx, req, &previewed, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return previewed, httpStatusCode, nil
+}
+
+// validatePush validates the commit message and the changes before they are pushed.
+func (c *Client) validatePush(commitMessage *CommitMessage, changes []*Change) error {
+	if len(commitMessage.Summary) == 0 {
+		return fmt.Errorf("summary of commitMessage cannot be empty. commitMessage: %+v", commitMessage)
+	}
+
+	if len(changes) == 0 {
+		return errors.New("no changes to commit")
+	}
+	return c.validateChanges(changes)
+}
+
 type push struct {
 	CommitMessage *CommitMessage `json:"commitMessage"`
 	Changes       []*Change      `json:"changes"`
@@ -386,13 +866,8 @@ type push struct {
 
 func (con *contentService) push(ctx context.Context, projectName, repoName, baseRevision string,
 	commitMessage *CommitMessage, changes []*Change) (*PushResult, int, error) {
-	if len(commitMessage.Summary) == 0 {
-		return nil, UnknownHttpStatusCode, fmt.Errorf(
-			"summary of commitMessage cannot be empty. commitMessage: %+v", commitMessage)
-	}
-
-	if len(changes) == 0 {
-		return nil, UnknownHttpStatusCode, errors.New("no changes to commit")
+	if err := con.client.validatePush(commitMessage, changes); err != nil {
+		return nil, UnknownHttpStatusCode, err
 	}
 
 	// build relative url
@@ -421,7 +896,73 @@ func (con *contentService) push(ctx context.Context, projectName, repoName, base
 	pushResult := new(PushResult)
 	httpStatusCode, err := con.client.do(ctx, req, pushResult, false)
 	if err != nil {
+		if isChangeConflict(err) {
+			// The cached head is older than the actual one, so it is not used anymore.
+			con.client.invalidateRevision(ctx, projectName, repoName)
+		}
 		return nil, httpStatusCode, err
 	}
+	con.client.observeRevision(ctx, projectName, repoName, pushResult.Revision)
 	return pushResult, httpStatusCode, nil
 }
+
+func (con *contentService) pushWithResultDetail(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, changes []*Change) (*PushResultDetail, int, error) {
+	pushResult, httpStatusCode, err := con.push(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+
+	detail := &PushResultDetail{PushResult: *pushResult}
+	commit, _, err := con.getCommitAt(ctx, projectName, repoName, pushResult.Revision)
+	if err != nil {
+		return detail, httpStatusCode, &PushDetailError{Revision: pushResult.Revision, Err: err}
+	}
+	detail.Author = commit.Author
+	detail.Changes = commit.Changes
+	return detail, httpStatusCode, nil
+}
+
+func (con *contentService) getCommit(ctx context.Context,
+	projectName, repoName, revision string) (*CommitDetail, int, error) {
+	parsed, err := ParseRevision(revision)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+	normalized := int64(parsed)
+	if parsed.IsRelative() {
+		repository := (*repositoryService)(con)
+		var httpStatusCode int
+		normalized, httpStatusCode, err = repository.normalizeRevision(ctx, projectName, repoName, revision)
+		if err != nil {
+			return nil, httpStatusCode, err
+		}
+	}
+	return con.getCommitAt(ctx, projectName, repoName, normalized)
+}
+
+// getCommitAt retrieves the commit at the absolute revision and the changes it made by comparing it with
+// the previous revision.
+func (con *contentService) getCommitAt(ctx context.Context,
+	projectName, repoName string, revision int64) (*CommitDetail, int, error) {
+	rev := strconv.FormatInt(revision, 10)
+	commits, httpStatusCode, err := con.getHistory(ctx, projectName, repoName, rev, rev, "/**", 1)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	if len(commits) == 0 {
+		return nil, httpStatusCode, fmt.Errorf("failed to find the commit (revision: %v)", rev)
+	}
+
+	detail := &CommitDetail{Commit: *commits[0]}
+	if revision == int64(Init) {
+		// The initial commit has no previous revision to compare with.
+		return detail, httpStatusCode, nil
+	}
+	detail.Changes, httpStatusCode, err = con.getDiffs(ctx, projectName, repoName,
+		strconv.FormatInt(revision-1, 10), rev, "/**")
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return detail, httpStatusCode, nil
+}
@@ -15,11 +15,13 @@
 package centraldogma
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -248,6 +250,75 @@ func TestGetDiffs(t *testing.T) {
 	}
 }
 
+func TestGetRawFile(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	raw := "{\n  // comment\n  \"a\": 1\n}\n"
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json5", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "2")
+		testURLQuery(t, r, "viewRaw", "true")
+		testHeader(t, r, "Accept", "text/plain, application/octet-stream, application/json;q=0.5")
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		fmt.Fprint(w, raw)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/b.txt", func(w http.ResponseWriter, r *http.Request) {
+		// The server which does not support the raw content responds with the entry.
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"path":"/b.txt","type":"TEXT","content":"b\n","revision":2}`)
+	})
+
+	content, _, err := c.GetRawFile(context.Background(), "foo", "bar", "2", "/a.json5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != raw {
+		t.Errorf("GetRawFile returned %q, want %q", content, raw)
+	}
+
+	var buf bytes.Buffer
+	written, _, err := c.DownloadTo(context.Background(), "foo", "bar", "2", "/b.txt", &buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "b\n" || written != 2 {
+		t.Errorf("DownloadTo wrote %q (%d bytes), want %q", buf.String(), written, "b\n")
+	}
+}
+
+func TestGetFilesWithJSONPaths(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/services/*.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "-1")
+		if got, want := r.URL.Query()["jsonpath"], []string{"$.timeout", "$[0]"}; !reflect.DeepEqual(got, want) {
+			t.Errorf("jsonpath: %v, want %v", got, want)
+		}
+		fmt.Fprint(w, `[{"path":"/services/a.json", "type":"JSON", "content":10},
+{"path":"/services/b.json", "type":"JSON", "content":20}]`)
+	})
+
+	entries, _, err := c.GetFilesWithJSONPaths(context.Background(), "foo", "bar", "-1", "/services/*.json",
+		"$.timeout", "$[0]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Entry{
+		{Path: "/services/a.json", Type: JSON, Content: EntryContent("10")},
+		{Path: "/services/b.json", Type: JSON, Content: EntryContent("20")},
+	}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("GetFilesWithJSONPaths returned %+v, want %+v", entries, want)
+	}
+
+	if _, _, err = c.GetFilesWithJSONPaths(context.Background(), "foo", "bar", "-1", "/**"); err == nil {
+		t.Error("GetFilesWithJSONPaths should fail without JSON paths")
+	}
+}
+
 func TestPush(t *testing.T) {
 	c, mux, teardown := setup()
 	defer teardown()
@@ -333,3 +404,388 @@ func TestGetTextFile(t *testing.T) {
 		t.Errorf("GetFile returned %+v, want %+v", entry, want)
 	}
 }
+
+func TestGetMergedEntry(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "path", "/a.json")
+		testURLQuery(t, r, "optional_path", "/b.json")
+		testURLQuery(t, r, "jsonpath", "$.a")
+		testURLQuery(t, r, "revision", "-1")
+		fmt.Fprint(w, `{"paths":["/a.json", "/b.json"], "type":"JSON", "content":{"b":"c"}, "revision":3}`)
+	})
+
+	mergeQuery := &MergeQuery{
+		MergeSources: []*MergeSource{{Path: "/a.json"}, {Path: "/b.json", Optional: true}},
+		Type:         JSONPath,
+		Expressions:  []string{"$.a"},
+	}
+	mergedEntry, _, _ := c.GetMergedEntry(context.Background(), "foo", "bar", "-1", mergeQuery)
+	want := &MergedEntry{Paths: []string{"/a.json", "/b.json"}, Type: JSON,
+		Content: EntryContent(`{"b":"c"}`), Revision: 3}
+	if !reflect.DeepEqual(mergedEntry, want) {
+		t.Errorf("GetMergedEntry returned %+v, want %+v", mergedEntry, want)
+	}
+}
+
+func TestGetMergedEntry_NonJSONSource(t *testing.T) {
+	c, _, teardown := setup()
+	defer teardown()
+
+	for _, path := range []string{"/a.txt", "/foojson"} {
+		mergeQuery := &MergeQuery{MergeSources: []*MergeSource{{Path: path}}, Type: Identity}
+		if _, _, err := c.GetMergedEntry(context.Background(), "foo", "bar", "-1", mergeQuery); err == nil {
+			t.Errorf("GetMergedEntry should fail for a non-JSON merge source %q", path)
+		}
+	}
+}
+
+func TestPreviewDiffs(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/preview", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURLQuery(t, r, "revision", "-1")
+
+		var reqBody []*Change
+		_ = json.NewDecoder(r.Body).Decode(&reqBody)
+		want := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "c"}}}
+		if !reflect.DeepEqual(reqBody, want) {
+			t.Errorf("PreviewDiffs request body %+v, want %+v", reqBody, want)
+		}
+
+		fmt.Fprint(w, `[{"path":"/a.json", "type":"APPLY_JSON_PATCH", "content":[{
+"op":"safeReplace",
+"path":"/a",
+"oldValue":"b",
+"value":"c"
+}]}]`)
+	})
+
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "c"}}}
+	diffs, _, _ := c.PreviewDiffs(context.Background(), "foo", "bar", "-1", changes)
+
+	var content []interface{}
+	content = append(content, map[string]interface{}{"op": "safeReplace",
+		"path":     "/a",
+		"oldValue": "b",
+		"value":    "c"})
+	want := []*Change{{Path: "/a.json", Type: ApplyJSONPatch, Content: content}}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("PreviewDiffs returned %+v, want %+v", diffs, want)
+	}
+}
+
+func TestPushWithResultDetail(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/3", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "to", "3")
+		fmt.Fprint(w, `[{"revision":3, "author":{"name":"minux", "email":"minux@m.x"},
+"commitMessage":{"summary":"Edit a.json"}}]`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "from", "2")
+		testURLQuery(t, r, "to", "3")
+		fmt.Fprint(w, `[{"path":"/a.json", "type":"APPLY_JSON_PATCH", "content":[{
+"op":"safeReplace",
+"path":"/a",
+"oldValue":"b",
+"value":"c"
+}]}]`)
+	})
+
+	commitMessage := &CommitMessage{Summary: "Edit a.json"}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "c"}}}
+	result, _, err := c.PushWithResultDetail(context.Background(), "foo", "bar", "-1", commitMessage, changes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var content []interface{}
+	content = append(content, map[string]interface{}{"op": "safeReplace",
+		"path":     "/a",
+		"oldValue": "b",
+		"value":    "c"})
+	want := &PushResultDetail{
+		PushResult: PushResult{Revision: 3, PushedAt: "2017-05-22T00:00:00Z"},
+		Author:     Author{Name: "minux", Email: "minux@m.x"},
+		Changes:    []*Change{{Path: "/a.json", Type: ApplyJSONPatch, Content: content}},
+	}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("PushWithResultDetail returned %+v, want %+v", result, want)
+	}
+}
+
+func TestPushWithResultDetail_CommitNotRetrieved(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/3", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	commitMessage := &CommitMessage{Summary: "Edit a.json"}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "c"}}}
+	result, httpStatusCode, err := c.PushWithResultDetail(context.Background(), "foo", "bar", "-1",
+		commitMessage, changes)
+	testStatusCode(t, httpStatusCode, http.StatusOK)
+
+	detailError, ok := err.(*PushDetailError)
+	if !ok {
+		t.Fatalf("PushWithResultDetail returned %v, want *PushDetailError", err)
+	}
+	if detailError.Revision != 3 {
+		t.Errorf("PushDetailError.Revision = %v, want 3", detailError.Revision)
+	}
+	if apiError, ok := detailError.Unwrap().(*APIError); !ok || apiError.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("PushDetailError.Unwrap() returned %v, want the APIError of 503", detailError.Unwrap())
+	}
+	want := &PushResultDetail{PushResult: PushResult{Revision: 3, PushedAt: "2017-05-22T00:00:00Z"}}
+	if !reflect.DeepEqual(result, want) {
+		t.Errorf("PushWithResultDetail returned %+v, want %+v", result, want)
+	}
+}
+
+func TestGetCommit(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"revision":3}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		revision := strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/commits/")
+		testURLQuery(t, r, "to", revision)
+		fmt.Fprintf(w, `[{"revision":%s, "author":{"name":"minux", "email":"minux@m.x"},
+"commitMessage":{"summary":"Edit a.json"}, "pushedAt":"2017-05-22T00:00:00Z"}]`, revision)
+	})
+	compares := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "from", "2")
+		testURLQuery(t, r, "to", "3")
+		compares++
+		fmt.Fprint(w, `[{"path":"/a.json", "type":"UPSERT_JSON", "content":{"a":"c"}}]`)
+	})
+
+	commit, _, err := c.GetCommit(context.Background(), "foo", "bar", "-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &CommitDetail{
+		Commit: Commit{
+			Revision:      3,
+			Author:        Author{Name: "minux", Email: "minux@m.x"},
+			CommitMessage: CommitMessage{Summary: "Edit a.json"},
+			PushedAt:      "2017-05-22T00:00:00Z",
+		},
+		Changes: []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "c"}}},
+	}
+	if !reflect.DeepEqual(commit, want) {
+		t.Errorf("GetCommit returned %+v, want %+v", commit, want)
+	}
+
+	// The initial commit is not compared with anything.
+	if commit, _, err = c.GetCommit(context.Background(), "foo", "bar", "1"); err != nil {
+		t.Fatal(err)
+	}
+	if commit.Revision != 1 || len(commit.Changes) != 0 {
+		t.Errorf("GetCommit returned %+v, want the initial commit without changes", commit)
+	}
+	if compares != 1 {
+		t.Errorf("compared %v times, want %v", compares, 1)
+	}
+}
+
+func TestListFilesByType(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/list/**", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"path":"/a", "type":"DIRECTORY"},{"path":"/a/b.json", "type":"JSON"},
+{"path":"/c.txt", "type":"TEXT"}]`)
+	})
+
+	entries, _, _ := c.ListFilesByType(context.Background(), "foo", "bar", "", "/**", JSON, Text)
+	want := []*Entry{{Path: "/a/b.json", Type: JSON}, {Path: "/c.txt", Type: Text}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("ListFilesByType returned %+v, want %+v", entries, want)
+	}
+}
+
+func TestListFilesIterator(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/list/**", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "2")
+		fmt.Fprint(w, `[{"path":"/a", "type":"DIRECTORY"},{"path":"/a/b.json", "type":"JSON"},
+{"path":"/c.txt", "type":"TEXT"}]`)
+	})
+
+	it, _, err := c.ListFilesIterator(context.Background(), "foo", "bar", "2", "/**", Directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+
+	var entries []*Entry
+	for it.Next() {
+		entries = append(entries, it.Entry())
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	want := []*Entry{{Path: "/a", Type: Directory}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("ListFilesIterator returned %+v, want %+v", entries, want)
+	}
+}
+
+func TestGetFilesIterator(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/**", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "2")
+		fmt.Fprint(w, `[{"path":"/a.json", "type":"JSON", "content":{"a":"b"}},
+{"path":"/b.txt", "type":"TEXT", "content":"hello"}]`)
+	})
+
+	it, _, err := c.GetFilesIterator(context.Background(), "foo", "bar", "2", "/**", Text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer it.Close()
+
+	var entries []*Entry
+	for it.Next() {
+		entries = append(entries, it.Entry())
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	want := []*Entry{{Path: "/b.txt", Type: Text, Content: EntryContent("hello")}}
+	if !reflect.DeepEqual(entries, want) {
+		t.Errorf("GetFilesIterator returned %+v, want %+v", entries, want)
+	}
+}
+
+func TestEntry_Decoding(t *testing.T) {
+	jsonEntry := &Entry{Path: "/a.json", Type: JSON, Content: EntryContent(`{"a":"b"}`)}
+	var aStruct struct {
+		A string `json:"a"`
+	}
+	if err := jsonEntry.UnmarshalTo(&aStruct); err != nil {
+		t.Fatal(err)
+	}
+	testString(t, aStruct.A, "b", "UnmarshalTo")
+
+	raw, _ := jsonEntry.RawJSON()
+	testString(t, string(raw), `{"a":"b"}`, "RawJSON")
+
+	textEntry := &Entry{Path: "/b.txt", Type: Text, Content: EntryContent("hello")}
+	text, _ := textEntry.Text()
+	testString(t, text, "hello", "Text")
+	if err := textEntry.UnmarshalTo(&aStruct); err == nil {
+		t.Errorf("UnmarshalTo should fail for a text entry")
+	}
+
+	directoryEntry := &Entry{Path: "/c", Type: Directory}
+	if _, err := directoryEntry.Text(); err == nil {
+		t.Errorf("Text should fail for a directory entry")
+	}
+}
+
+func TestDiffAsJSONPatch(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "-1")
+		fmt.Fprint(w, `{"path":"/a.json", "type":"JSON", "content":{"a":"b", "c":[1, 2]}, "revision":3}`)
+	})
+
+	change, _, err := c.DiffAsJSONPatch(context.Background(), "foo", "bar", "/a.json",
+		map[string]interface{}{"a": "b", "c": []int{1, 3}})
+	if err != nil {
+		t.Fatalf("DiffAsJSONPatch returned error: %v", err)
+	}
+	b, _ := json.Marshal(change)
+	testString(t, string(b),
+		`{"type":"APPLY_JSON_PATCH","path":"/a.json","content":[{"op":"replace","path":"/c/1","value":3}]}`, "change")
+
+	_, _, err = c.DiffAsJSONPatch(context.Background(), "foo", "bar", "/a.json",
+		map[string]interface{}{"a": "b", "c": []int{1, 2}})
+	if err != ErrRedundantChange {
+		t.Errorf("DiffAsJSONPatch returned %v, want %v", err, ErrRedundantChange)
+	}
+}
+
+func TestGetBinaryFile(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.bin", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"path":"/a.bin", "type":"TEXT", "content":"AAEC/w==\n", "revision":2}`)
+	})
+
+	data, _, err := c.GetBinaryFile(context.Background(), "foo", "bar", "-1", "/a.bin")
+	if err != nil {
+		t.Fatalf("GetBinaryFile returned error: %v", err)
+	}
+	if want := []byte{0, 1, 2, 255}; !reflect.DeepEqual(data, want) {
+		t.Errorf("GetBinaryFile returned %v, want %v", data, want)
+	}
+}
+
+func TestEntryContent_UnmarshalJSON(t *testing.T) {
+	tests := []string{
+		`"plain"`,
+		`""`,
+		`"line1\nline2\t\"quoted\" \\ \/ \b\f\r"`,
+		`"é中😀 é 中"`,
+		`"\ud800A"`,        // unpaired high surrogate
+		`"\udc00"`,         // unpaired low surrogate
+		"\"invalid \xff\"", // invalid UTF-8
+		`"trailing \u00"`,  // invalid escape
+		`{"a":"b"}`,
+	}
+	for _, test := range tests {
+		var got EntryContent
+		gotErr := json.Unmarshal([]byte(test), &got)
+
+		var want string
+		var wantErr error
+		if strings.HasPrefix(test, `"`) {
+			wantErr = json.Unmarshal([]byte(test), &want)
+		} else {
+			want = test
+		}
+		if (gotErr != nil) != (wantErr != nil) || string(got) != want {
+			t.Errorf("Unmarshal(%q) = (%q, %v), want (%q, %v)", test, got, gotErr, want, wantErr)
+		}
+	}
+}
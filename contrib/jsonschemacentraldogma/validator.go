@@ -0,0 +1,107 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// Package jsonschemacentraldogma provides a centraldogma.PushValidator which validates the JSON changes against
+// a JSON Schema before they are pushed. For example:
+//
+//	validator, err := jsonschemacentraldogma.NewValidator(`{
+//	    "type": "object",
+//	    "required": ["port"],
+//	    "properties": {"port": {"type": "integer", "minimum": 1}}
+//	}`)
+//	if err != nil {
+//	    panic(err)
+//	}
+//	client, err := centraldogma.NewClient("https://localhost:443",
+//	    centraldogma.WithPushValidator("/servers/*.json", validator))
+package jsonschemacentraldogma
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+	"go.linecorp.com/centraldogma"
+)
+
+// Validator validates the content of the UpsertJSON changes against a JSON Schema.
+// The other types of the changes are not validated.
+type Validator struct {
+	schema    *jsonschema.Schema
+	validator centraldogma.PushValidator
+}
+
+// NewValidator returns a Validator which validates against the JSON Schema.
+func NewValidator(schema string) (*Validator, error) {
+	compiled, err := jsonschema.CompileString("schema.json", schema)
+	if err != nil {
+		return nil, err
+	}
+	v := &Validator{schema: compiled}
+	v.validator = centraldogma.JSONValidator(v.validate)
+	return v, nil
+}
+
+// Validate implements centraldogma.PushValidator. A SchemaError is returned if the content violates the schema.
+func (v *Validator) Validate(change *centraldogma.Change) error {
+	return v.validator.Validate(change)
+}
+
+func (v *Validator) validate(value interface{}) error {
+	err := v.schema.Validate(value)
+	if validationError, ok := err.(*jsonschema.ValidationError); ok {
+		schemaError := &SchemaError{}
+		appendViolations(schemaError, validationError)
+		return schemaError
+	}
+	return err
+}
+
+// SchemaError represents the violations of a JSON Schema.
+type SchemaError struct {
+	Violations []Violation
+}
+
+// Violation represents a value which violates a JSON Schema.
+type Violation struct {
+	// InstanceLocation is the JSON pointer of the value, e.g. "/servers/0/port".
+	InstanceLocation string
+	Message          string
+}
+
+func (e *SchemaError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		location := violation.InstanceLocation
+		if len(location) == 0 {
+			location = "/"
+		}
+		messages[i] = fmt.Sprintf("%s: %s", location, violation.Message)
+	}
+	return "schema violations: " + strings.Join(messages, ", ")
+}
+
+// appendViolations appends the leaves of the causes, which describe the actual violations.
+func appendViolations(schemaError *SchemaError, validationError *jsonschema.ValidationError) {
+	if len(validationError.Causes) == 0 {
+		schemaError.Violations = append(schemaError.Violations, Violation{
+			InstanceLocation: validationError.InstanceLocation,
+			Message:          validationError.Message,
+		})
+		return
+	}
+	for _, cause := range validationError.Causes {
+		appendViolations(schemaError, cause)
+	}
+}
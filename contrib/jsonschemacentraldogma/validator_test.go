@@ -0,0 +1,69 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package jsonschemacentraldogma
+
+import (
+	"testing"
+
+	"go.linecorp.com/centraldogma"
+)
+
+const serverSchema = `{
+  "type": "object",
+  "required": ["host", "port"],
+  "properties": {
+    "host": {"type": "string"},
+    "port": {"type": "integer", "minimum": 1}
+  }
+}`
+
+func TestValidator(t *testing.T) {
+	validator, err := NewValidator(serverSchema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	valid := &centraldogma.Change{Path: "/a.json", Type: centraldogma.UpsertJSON,
+		Content: map[string]interface{}{"host": "a", "port": 8080}}
+	if err = validator.Validate(valid); err != nil {
+		t.Errorf("Validate returned error: %v", err)
+	}
+
+	invalid := &centraldogma.Change{Path: "/a.json", Type: centraldogma.UpsertJSON,
+		Content: map[string]interface{}{"port": 0}}
+	err = validator.Validate(invalid)
+	schemaError, ok := err.(*SchemaError)
+	if !ok {
+		t.Fatalf("Validate returned %v, want a SchemaError", err)
+	}
+	locations := make(map[string]bool)
+	for _, violation := range schemaError.Violations {
+		locations[violation.InstanceLocation] = true
+	}
+	if len(schemaError.Violations) != 2 || !locations[""] || !locations["/port"] {
+		t.Errorf("Validate returned %+v, want the violations of the missing host and the port", schemaError)
+	}
+
+	// The other types of the changes are not validated.
+	if err = validator.Validate(&centraldogma.Change{Path: "/a.json", Type: centraldogma.Remove}); err != nil {
+		t.Errorf("Validate returned error: %v", err)
+	}
+}
+
+func TestNewValidator_InvalidSchema(t *testing.T) {
+	if _, err := NewValidator(`{"type": 1}`); err == nil {
+		t.Errorf("NewValidator should fail for an invalid schema")
+	}
+}
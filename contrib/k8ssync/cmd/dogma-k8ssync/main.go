@@ -0,0 +1,130 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// Command dogma-k8ssync syncs the files in a Central Dogma repository into a ConfigMap or a Secret. For example:
+//
+//	dogma-k8ssync --connect https://dogma.example.com --configmap app-config --namespace default foo/bar/app/**
+//
+// The token is read from the DOGMA_TOKEN environment variable if --token is not specified. The in-cluster
+// configuration is used to access the Kubernetes API unless --kubeconfig is specified.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"go.linecorp.com/centraldogma"
+	"go.linecorp.com/centraldogma/contrib/k8ssync"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func main() {
+	if err := run(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	flags := flag.NewFlagSet("dogma-k8ssync", flag.ContinueOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: dogma-k8ssync [options] <project_name>/<repository_name>/<path_pattern>")
+		flags.PrintDefaults()
+	}
+	connect := flags.String("connect", "", "the `URL` of the Central Dogma server")
+	token := flags.String("token", os.Getenv("DOGMA_TOKEN"), "the authorization token (default: $DOGMA_TOKEN)")
+	kubeconfig := flags.String("kubeconfig", "", "the `path` of the kubeconfig (default: the in-cluster config)")
+	namespace := flags.String("namespace", "default", "the namespace of the ConfigMap or the Secret")
+	configMap := flags.String("configmap", "", "the `name` of the ConfigMap to write the files into")
+	secret := flags.String("secret", "", "the `name` of the Secret to write the files into")
+	once := flags.Bool("once", false, "exits after syncing the latest files without watching")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 || len(*connect) == 0 || (len(*configMap) == 0) == (len(*secret) == 0) {
+		flags.Usage()
+		return fmt.Errorf("--connect, either --configmap or --secret, and the path are required")
+	}
+	split := strings.SplitN(strings.TrimPrefix(flags.Arg(0), "/"), "/", 3)
+	if len(split) != 3 || len(split[0]) == 0 || len(split[1]) == 0 || len(split[2]) == 0 {
+		return fmt.Errorf("invalid path: %q (expected: <project_name>/<repository_name>/<path_pattern>)",
+			flags.Arg(0))
+	}
+	target := k8ssync.Target{Kind: k8ssync.ConfigMap, Namespace: *namespace, Name: *configMap}
+	if len(*secret) != 0 {
+		target.Kind, target.Name = k8ssync.Secret, *secret
+	}
+
+	var opts []centraldogma.ClientOption
+	if len(*token) != 0 {
+		opts = append(opts, centraldogma.WithToken(*token))
+	}
+	client, err := centraldogma.NewClient(*connect, opts...)
+	if err != nil {
+		return err
+	}
+	kube, err := newKubeClient(*kubeconfig)
+	if err != nil {
+		return err
+	}
+	syncer, err := k8ssync.NewSyncer(client, kube, split[0], split[1], "/"+split[2], target,
+		k8ssync.WithListener(func(result *k8ssync.Result, err error) {
+			printResult(target, result, err)
+		}))
+	if err != nil {
+		return err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+	if *once {
+		result, err := syncer.Sync(ctx, "-1")
+		if err != nil {
+			return err
+		}
+		printResult(target, result, nil)
+		return nil
+	}
+	return syncer.Run(ctx)
+}
+
+func newKubeClient(kubeconfig string) (kubernetes.Interface, error) {
+	var config *rest.Config
+	var err error
+	if len(kubeconfig) != 0 {
+		config, err = clientcmd.BuildConfigFromFlags("", kubeconfig)
+	} else {
+		config, err = rest.InClusterConfig()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return kubernetes.NewForConfig(config)
+}
+
+func printResult(target k8ssync.Target, result *k8ssync.Result, err error) {
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to sync %s: %v\n", target, err)
+		return
+	}
+	fmt.Printf("Synced revision %d to %s (%s, %s)\n", result.Revision, target, result.Operation, result.Hash)
+}
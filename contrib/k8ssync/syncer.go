@@ -0,0 +1,478 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// Package k8ssync syncs the files in a Central Dogma repository into a Kubernetes ConfigMap or Secret and
+// keeps it up to date. For example:
+//
+//	syncer, err := k8ssync.NewSyncer(client, clientset, "foo", "bar", "/app/**",
+//	    k8ssync.Target{Kind: k8ssync.ConfigMap, Namespace: "default", Name: "app-config"})
+//	if err != nil {
+//	    panic(err)
+//	}
+//	// Blocks until the context is done.
+//	syncer.Run(ctx)
+//
+// Each file becomes a key of the object, named after the base name of the file by default. The object is
+// annotated with the source path pattern, the revision and the hash of the content. The hash is used to
+// skip the updates which do not change the content, and can be copied into a pod template to roll out the
+// pods when the content is changed. The object which was not created by the Syncer of the same source is
+// never modified.
+package k8ssync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"go.linecorp.com/centraldogma"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+)
+
+const (
+	// ManagedByLabel is the label which marks the objects managed by a Syncer.
+	ManagedByLabel = "app.kubernetes.io/managed-by"
+	// ManagedByValue is the value of the ManagedByLabel.
+	ManagedByValue = "centraldogma-k8ssync"
+
+	// SourceAnnotation is the annotation which records the source of the content, e.g. /foo/bar/app/**.
+	SourceAnnotation = "centraldogma.linecorp.com/source"
+	// RevisionAnnotation is the annotation which records the revision of the content.
+	RevisionAnnotation = "centraldogma.linecorp.com/revision"
+	// ContentHashAnnotation is the annotation which records the hash of the content, e.g. sha256:9f86d0...
+	ContentHashAnnotation = "centraldogma.linecorp.com/content-hash"
+)
+
+// ErrNotOwned is returned when the target object exists but was not created by a Syncer of the same source.
+var ErrNotOwned = errors.New("the object is not owned by the syncer")
+
+// Kind is the kind of the Kubernetes object which the content is written into.
+type Kind string
+
+const (
+	// ConfigMap writes the content into a ConfigMap. The files which are not valid UTF-8 are written into
+	// the binaryData.
+	ConfigMap Kind = "ConfigMap"
+	// Secret writes the content into an Opaque Secret.
+	Secret Kind = "Secret"
+)
+
+// Target is the Kubernetes object which the content is written into.
+type Target struct {
+	Kind      Kind
+	Namespace string
+	Name      string
+}
+
+// String returns the target as kind/namespace/name.
+func (t Target) String() string {
+	return string(t.Kind) + "/" + t.Namespace + "/" + t.Name
+}
+
+// Operation is the operation performed on the target object by a sync.
+type Operation string
+
+const (
+	// Created means that the target object did not exist and was created.
+	Created Operation = "created"
+	// Updated means that the content of the target object was changed.
+	Updated Operation = "updated"
+	// Unchanged means that the target object already had the same content.
+	Unchanged Operation = "unchanged"
+)
+
+// Result is the result of a sync.
+type Result struct {
+	Revision  int64
+	Hash      string
+	Operation Operation
+}
+
+// Option configures the Syncer.
+type Option func(*config)
+
+type config struct {
+	keyFunc  func(filePath string) string
+	listener func(result *Result, err error)
+	backoff  centraldogma.BackoffPolicy
+}
+
+// WithKeyFunc sets the function which returns the key of the file in the target object. The file is skipped
+// if the function returns an empty string. The base name of the file is used by default.
+func WithKeyFunc(keyFunc func(filePath string) string) Option {
+	return func(c *config) {
+		c.keyFunc = keyFunc
+	}
+}
+
+// WithListener sets the function which is invoked with the result of each sync performed by Run.
+func WithListener(listener func(result *Result, err error)) Option {
+	return func(c *config) {
+		c.listener = listener
+	}
+}
+
+// WithBackoffPolicy sets the BackoffPolicy which determines how long Run waits before it retries a failed sync.
+// centraldogma.DefaultBackoffPolicy is used by default.
+func WithBackoffPolicy(backoff centraldogma.BackoffPolicy) Option {
+	return func(c *config) {
+		c.backoff = backoff
+	}
+}
+
+// Syncer writes the files matched by a path pattern into a ConfigMap or a Secret.
+type Syncer struct {
+	client      *centraldogma.Client
+	kube        kubernetes.Interface
+	projectName string
+	repoName    string
+	pathPattern string
+	target      Target
+	config
+
+	lock    sync.Mutex
+	running bool
+}
+
+// NewSyncer returns a Syncer which writes the files matched by the path pattern into the target object.
+func NewSyncer(client *centraldogma.Client, kube kubernetes.Interface, projectName, repoName, pathPattern string,
+	target Target, opts ...Option) (*Syncer, error) {
+	if len(projectName) == 0 || len(repoName) == 0 || len(pathPattern) == 0 {
+		return nil, errors.New("projectName, repoName and pathPattern should not be empty")
+	}
+	if target.Kind != ConfigMap && target.Kind != Secret {
+		return nil, fmt.Errorf("unknown kind: %q (expected: %s or %s)", target.Kind, ConfigMap, Secret)
+	}
+	if errs := validation.IsDNS1123Label(target.Namespace); len(errs) != 0 {
+		return nil, fmt.Errorf("invalid namespace %q: %s", target.Namespace, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsDNS1123Subdomain(target.Name); len(errs) != 0 {
+		return nil, fmt.Errorf("invalid name %q: %s", target.Name, strings.Join(errs, ", "))
+	}
+
+	s := &Syncer{client: client, kube: kube, projectName: projectName, repoName: repoName,
+		pathPattern: pathPattern, target: target}
+	for _, opt := range opts {
+		opt(&s.config)
+	}
+	if s.keyFunc == nil {
+		s.keyFunc = path.Base
+	}
+	if s.backoff == nil {
+		s.backoff = centraldogma.DefaultBackoffPolicy()
+	}
+	return s, nil
+}
+
+// Source returns the source of the content which is recorded in the SourceAnnotation.
+func (s *Syncer) Source() string {
+	return "/" + s.projectName + "/" + s.repoName + s.pathPattern
+}
+
+// Sync writes the files at the revision into the target object. The target object is not updated if its
+// content is the same as the files.
+func (s *Syncer) Sync(ctx context.Context, revision string) (*Result, error) {
+	normalizedRevision, _, err := s.client.NormalizeRevision(ctx, s.projectName, s.repoName, revision)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.readData(ctx, normalizedRevision)
+	if err != nil {
+		return nil, err
+	}
+	result := &Result{Revision: normalizedRevision, Hash: hashData(data)}
+
+	// Retry with the latest object if it is changed by others while being updated.
+	err = retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		var err error
+		if s.target.Kind == Secret {
+			result.Operation, err = s.applySecret(ctx, data, result)
+		} else {
+			result.Operation, err = s.applyConfigMap(ctx, data, result)
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Run syncs the latest files and keeps syncing whenever the files are changed until the context is done.
+// Run returns an error only if it fails to start watching. The failures of the syncs are reported to
+// the listener, and the latest files are synced again with the backoff until it succeeds.
+func (s *Syncer) Run(ctx context.Context) error {
+	s.lock.Lock()
+	if s.running {
+		s.lock.Unlock()
+		return errors.New("the syncer is already running")
+	}
+	s.running = true
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		s.running = false
+		s.lock.Unlock()
+	}()
+
+	watcher, err := s.client.RepoWatcher(s.projectName, s.repoName, s.pathPattern)
+	if err != nil {
+		return err
+	}
+	defer watcher.Close()
+
+	// Keep only the latest revision if the files are still being synced when the next revision is pushed.
+	updates := make(chan int64, 1)
+	notify := func(revision int64) {
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- revision
+	}
+	if err = watcher.Watch(func(result centraldogma.WatchResult) {
+		if result.Err == nil {
+			notify(result.Revision)
+		}
+	}); err != nil {
+		return err
+	}
+
+	var lastRevision int64
+	failures := 0
+	retryTimer := time.NewTimer(0) // syncs the latest files first.
+	defer retryTimer.Stop()
+	syncRevision := func(revision string) {
+		result, err := s.Sync(ctx, revision)
+		if ctx.Err() != nil {
+			return
+		}
+		s.notifyListener(result, err)
+		retryTimer.Stop()
+		if err != nil {
+			// Retry until it succeeds because the next change may never come.
+			failures++
+			retryTimer.Reset(s.backoff.NextDelay(failures))
+			return
+		}
+		failures = 0
+		lastRevision = result.Revision
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-retryTimer.C:
+			syncRevision(centraldogma.Head.String())
+		case revision := <-updates:
+			if revision <= lastRevision {
+				continue
+			}
+			syncRevision(fmt.Sprint(revision))
+		}
+	}
+}
+
+func (s *Syncer) notifyListener(result *Result, err error) {
+	if s.listener != nil {
+		s.listener(result, err)
+	}
+}
+
+// readData returns the content of the files at the revision mapped by their keys.
+func (s *Syncer) readData(ctx context.Context, revision int64) (map[string][]byte, error) {
+	entries, httpStatusCode, err := s.client.GetFiles(
+		ctx, s.projectName, s.repoName, fmt.Sprint(revision), s.pathPattern)
+	if err != nil {
+		return nil, err
+	}
+	if httpStatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the files: %s revision: %d (status: %d)",
+			s.Source(), revision, httpStatusCode)
+	}
+
+	data := make(map[string][]byte)
+	paths := make(map[string]string)
+	for _, entry := range entries {
+		if entry.Type == centraldogma.Directory {
+			continue
+		}
+		key := s.keyFunc(entry.Path)
+		if len(key) == 0 {
+			continue
+		}
+		if errs := validation.IsConfigMapKey(key); len(errs) != 0 {
+			return nil, fmt.Errorf("invalid key %q of %s: %s", key, entry.Path, strings.Join(errs, ", "))
+		}
+		if other, ok := paths[key]; ok {
+			return nil, fmt.Errorf("both %s and %s are mapped to the key %q", other, entry.Path, key)
+		}
+		paths[key] = entry.Path
+		data[key] = entry.Content
+	}
+	return data, nil
+}
+
+func (s *Syncer) applyConfigMap(ctx context.Context, data map[string][]byte, result *Result) (Operation, error) {
+	configMaps := s.kube.CoreV1().ConfigMaps(s.target.Namespace)
+	current, err := configMaps.Get(ctx, s.target.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		configMap := &corev1.ConfigMap{}
+		configMap.Namespace, configMap.Name = s.target.Namespace, s.target.Name
+		configMap.Data, configMap.BinaryData = splitBinaryData(data)
+		s.setMetadata(&configMap.ObjectMeta, result)
+		_, err = configMaps.Create(ctx, configMap, metav1.CreateOptions{})
+		return Created, err
+	}
+	if err != nil {
+		return "", err
+	}
+	if err = s.checkOwnership(&current.ObjectMeta); err != nil {
+		return "", err
+	}
+	if isUpToDate(&current.ObjectMeta, joinBinaryData(current.Data, current.BinaryData), result.Hash) {
+		return Unchanged, nil
+	}
+
+	configMap := current.DeepCopy()
+	configMap.Data, configMap.BinaryData = splitBinaryData(data)
+	s.setMetadata(&configMap.ObjectMeta, result)
+	_, err = configMaps.Update(ctx, configMap, metav1.UpdateOptions{})
+	return Updated, err
+}
+
+func (s *Syncer) applySecret(ctx context.Context, data map[string][]byte, result *Result) (Operation, error) {
+	secrets := s.kube.CoreV1().Secrets(s.target.Namespace)
+	current, err := secrets.Get(ctx, s.target.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		secret := &corev1.Secret{Type: corev1.SecretTypeOpaque, Data: data}
+		secret.Namespace, secret.Name = s.target.Namespace, s.target.Name
+		s.setMetadata(&secret.ObjectMeta, result)
+		_, err = secrets.Create(ctx, secret, metav1.CreateOptions{})
+		return Created, err
+	}
+	if err != nil {
+		return "", err
+	}
+	if err = s.checkOwnership(&current.ObjectMeta); err != nil {
+		return "", err
+	}
+	// The stringData is merged into the data by the API server, so it does not need to be checked.
+	if isUpToDate(&current.ObjectMeta, current.Data, result.Hash) {
+		return Unchanged, nil
+	}
+
+	secret := current.DeepCopy()
+	secret.Data, secret.StringData = data, nil
+	s.setMetadata(&secret.ObjectMeta, result)
+	_, err = secrets.Update(ctx, secret, metav1.UpdateOptions{})
+	return Updated, err
+}
+
+// checkOwnership returns ErrNotOwned if the object was not created by a Syncer of the same source.
+func (s *Syncer) checkOwnership(meta *metav1.ObjectMeta) error {
+	if meta.Labels[ManagedByLabel] != ManagedByValue || meta.Annotations[SourceAnnotation] != s.Source() {
+		return fmt.Errorf("%w: %s (%s: %q, %s: %q)", ErrNotOwned, s.target, ManagedByLabel,
+			meta.Labels[ManagedByLabel], SourceAnnotation, meta.Annotations[SourceAnnotation])
+	}
+	return nil
+}
+
+func (s *Syncer) setMetadata(meta *metav1.ObjectMeta, result *Result) {
+	if meta.Labels == nil {
+		meta.Labels = make(map[string]string)
+	}
+	meta.Labels[ManagedByLabel] = ManagedByValue
+	if meta.Annotations == nil {
+		meta.Annotations = make(map[string]string)
+	}
+	meta.Annotations[SourceAnnotation] = s.Source()
+	meta.Annotations[RevisionAnnotation] = fmt.Sprint(result.Revision)
+	meta.Annotations[ContentHashAnnotation] = result.Hash
+}
+
+// isUpToDate returns true if both the annotated hash and the actual content match the hash, so that the
+// content modified by others is also restored.
+func isUpToDate(meta *metav1.ObjectMeta, data map[string][]byte, hash string) bool {
+	return meta.Annotations[ContentHashAnnotation] == hash && hashData(data) == hash
+}
+
+// hashData returns the SHA-256 hash of the keys and the values in the order of the keys.
+func hashData(data map[string][]byte) string {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	var length [8]byte
+	for _, key := range keys {
+		// Prefix the lengths so that the boundaries of the keys and the values are not ambiguous.
+		binary.BigEndian.PutUint64(length[:], uint64(len(key)))
+		h.Write(length[:])
+		h.Write([]byte(key))
+		binary.BigEndian.PutUint64(length[:], uint64(len(data[key])))
+		h.Write(length[:])
+		h.Write(data[key])
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil))
+}
+
+// splitBinaryData splits the data into the data and the binaryData of a ConfigMap.
+func splitBinaryData(data map[string][]byte) (map[string]string, map[string][]byte) {
+	var textData map[string]string
+	var binaryData map[string][]byte
+	for key, value := range data {
+		if utf8.Valid(value) {
+			if textData == nil {
+				textData = make(map[string]string)
+			}
+			textData[key] = string(value)
+		} else {
+			if binaryData == nil {
+				binaryData = make(map[string][]byte)
+			}
+			binaryData[key] = value
+		}
+	}
+	return textData, binaryData
+}
+
+func joinBinaryData(textData map[string]string, binaryData map[string][]byte) map[string][]byte {
+	data := make(map[string][]byte, len(textData)+len(binaryData))
+	for key, value := range textData {
+		data[key] = []byte(value)
+	}
+	for key, value := range binaryData {
+		data[key] = value
+	}
+	return data
+}
@@ -0,0 +1,371 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package k8ssync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.linecorp.com/centraldogma"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var testEntries = map[int]string{
+	1: `[{"path": "/app/a.json", "type": "JSON", "content": {"a":1}},
+		{"path": "/app/sub", "type": "DIRECTORY"},
+		{"path": "/app/sub/b.txt", "type": "TEXT", "content": "b"}]`,
+	2: `[{"path": "/app/a.json", "type": "JSON", "content": {"a":1}},
+		{"path": "/app/sub", "type": "DIRECTORY"},
+		{"path": "/app/sub/b.txt", "type": "TEXT", "content": "b"}]`,
+	3: `[{"path": "/app/a.json", "type": "JSON", "content": {"a":2}},
+		{"path": "/app/c.txt", "type": "TEXT", "content": "c"}]`,
+}
+
+// newTestServer returns a server which serves the testEntries at the revision and the head revision for the
+// watch requests.
+func newTestServer(t *testing.T, head *int32) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/", func(w http.ResponseWriter, r *http.Request) {
+		revision := strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/revision/")
+		if revision == "-1" {
+			revision = strconv.Itoa(int(atomic.LoadInt32(head)))
+		}
+		fmt.Fprintf(w, `{"revision":%s}`, revision)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/app/**", func(w http.ResponseWriter, r *http.Request) {
+		if lastKnownRevision := r.Header.Get("if-none-match"); lastKnownRevision != "" {
+			current := atomic.LoadInt32(head)
+			if lastKnownRevision != "-1" && lastKnownRevision != strconv.Itoa(int(current)) {
+				fmt.Fprintf(w, `{"revision":%d}`, current)
+				return
+			}
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		revision, err := strconv.Atoi(r.URL.Query().Get("revision"))
+		if err != nil {
+			t.Errorf("Invalid revision: %q", r.URL.Query().Get("revision"))
+		}
+		fmt.Fprint(w, testEntries[revision])
+	})
+	return httptest.NewServer(mux)
+}
+
+func newTestClient(t *testing.T, server *httptest.Server) *centraldogma.Client {
+	client, err := centraldogma.NewClient(server.URL, centraldogma.WithTransport(http.DefaultTransport))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client
+}
+
+func TestNewSyncer(t *testing.T) {
+	var tests = []struct {
+		target Target
+		valid  bool
+	}{
+		{Target{Kind: ConfigMap, Namespace: "default", Name: "app-config"}, true},
+		{Target{Kind: Secret, Namespace: "default", Name: "app.secret"}, true},
+		{Target{Kind: "Pod", Namespace: "default", Name: "app"}, false},
+		{Target{Kind: ConfigMap, Namespace: "", Name: "app"}, false},
+		{Target{Kind: ConfigMap, Namespace: "default", Name: "App_Config"}, false},
+	}
+
+	for _, test := range tests {
+		_, err := NewSyncer(nil, fake.NewSimpleClientset(), "foo", "bar", "/app/**", test.target)
+		if (err == nil) != test.valid {
+			t.Errorf("NewSyncer(%v) returned %v; want valid: %v", test.target, err, test.valid)
+		}
+	}
+}
+
+func TestSyncConfigMap(t *testing.T) {
+	var head int32 = 3
+	server := newTestServer(t, &head)
+	defer server.Close()
+	kube := fake.NewSimpleClientset()
+	syncer, err := NewSyncer(newTestClient(t, server), kube, "foo", "bar", "/app/**",
+		Target{Kind: ConfigMap, Namespace: "default", Name: "app-config"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	get := func() *corev1.ConfigMap {
+		configMap, err := kube.CoreV1().ConfigMaps("default").Get(ctx, "app-config", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return configMap
+	}
+
+	var tests = []struct {
+		revision   string
+		operation  Operation
+		data       map[string]string
+		binaryData map[string][]byte
+		revisionAt string // The revision in the RevisionAnnotation.
+	}{
+		{"1", Created, map[string]string{"a.json": `{"a":1}`, "b.txt": "b"}, nil, "1"},
+		{"1", Unchanged, map[string]string{"a.json": `{"a":1}`, "b.txt": "b"}, nil, "1"},
+		// The revision is not updated if the content is not changed.
+		{"2", Unchanged, map[string]string{"a.json": `{"a":1}`, "b.txt": "b"}, nil, "1"},
+		{"-1", Updated, map[string]string{"a.json": `{"a":2}`, "c.txt": "c"}, nil, "3"},
+	}
+
+	for _, test := range tests {
+		result, err := syncer.Sync(ctx, test.revision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result.Operation != test.operation {
+			t.Errorf("Sync(%s) performed %s; want %s", test.revision, result.Operation, test.operation)
+		}
+		configMap := get()
+		if fmt.Sprint(configMap.Data) != fmt.Sprint(test.data) ||
+			fmt.Sprint(configMap.BinaryData) != fmt.Sprint(test.binaryData) {
+			t.Errorf("Sync(%s) wrote (%v, %v); want (%v, %v)", test.revision, configMap.Data,
+				configMap.BinaryData, test.data, test.binaryData)
+		}
+		if got := configMap.Annotations[RevisionAnnotation]; got != test.revisionAt {
+			t.Errorf("Sync(%s) annotated the revision %s; want %s", test.revision, got, test.revisionAt)
+		}
+		if got := configMap.Annotations[ContentHashAnnotation]; got != result.Hash {
+			t.Errorf("Sync(%s) annotated the hash %s; want %s", test.revision, got, result.Hash)
+		}
+		if got := configMap.Annotations[SourceAnnotation]; got != "/foo/bar/app/**" {
+			t.Errorf("Sync(%s) annotated the source %s; want /foo/bar/app/**", test.revision, got)
+		}
+		if got := configMap.Labels[ManagedByLabel]; got != ManagedByValue {
+			t.Errorf("Sync(%s) labeled %s; want %s", test.revision, got, ManagedByValue)
+		}
+	}
+
+	// The content modified by others is restored even if the annotation is not changed.
+	configMap := get()
+	configMap.Data["a.json"] = "modified"
+	if _, err = kube.CoreV1().ConfigMaps("default").Update(ctx, configMap, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	result, err := syncer.Sync(ctx, "3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.Operation != Updated || get().Data["a.json"] != `{"a":2}` {
+		t.Errorf("Sync(3) performed %s and wrote %v; want the restored content", result.Operation, get().Data)
+	}
+}
+
+func TestSyncSecret(t *testing.T) {
+	var head int32 = 3
+	server := newTestServer(t, &head)
+	defer server.Close()
+	kube := fake.NewSimpleClientset()
+	// Map the nested files to the keys which keep their directories.
+	syncer, err := NewSyncer(newTestClient(t, server), kube, "foo", "bar", "/app/**",
+		Target{Kind: Secret, Namespace: "default", Name: "app-secret"},
+		WithKeyFunc(func(filePath string) string {
+			return strings.ReplaceAll(strings.TrimPrefix(filePath, "/app/"), "/", ".")
+		}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	for _, revision := range []string{"1", "3"} {
+		if _, err = syncer.Sync(ctx, revision); err != nil {
+			t.Fatal(err)
+		}
+		secret, err := kube.CoreV1().Secrets("default").Get(ctx, "app-secret", metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string][]byte{"a.json": []byte(`{"a":1}`), "sub.b.txt": []byte("b")}
+		if revision == "3" {
+			want = map[string][]byte{"a.json": []byte(`{"a":2}`), "c.txt": []byte("c")}
+		}
+		if secret.Type != corev1.SecretTypeOpaque || fmt.Sprint(secret.Data) != fmt.Sprint(want) {
+			t.Errorf("Sync(%s) wrote a %s Secret with %v; want an Opaque Secret with %v", revision,
+				secret.Type, secret.Data, want)
+		}
+	}
+}
+
+func TestSyncNotOwned(t *testing.T) {
+	var head int32 = 1
+	server := newTestServer(t, &head)
+	defer server.Close()
+	var tests = []struct {
+		labels      map[string]string
+		annotations map[string]string
+	}{
+		{nil, nil},
+		{map[string]string{ManagedByLabel: "helm"}, map[string]string{SourceAnnotation: "/foo/bar/app/**"}},
+		{map[string]string{ManagedByLabel: ManagedByValue}, map[string]string{SourceAnnotation: "/foo/baz/app/**"}},
+	}
+
+	for _, test := range tests {
+		existing := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "app-config",
+			Labels: test.labels, Annotations: test.annotations}, Data: map[string]string{"a": "b"}}
+		kube := fake.NewSimpleClientset(existing)
+		syncer, _ := NewSyncer(newTestClient(t, server), kube, "foo", "bar", "/app/**",
+			Target{Kind: ConfigMap, Namespace: "default", Name: "app-config"})
+		if _, err := syncer.Sync(context.Background(), "1"); !errors.Is(err, ErrNotOwned) {
+			t.Errorf("Sync() with %v and %v returned %v; want %v", test.labels, test.annotations, err, ErrNotOwned)
+		}
+		configMap, _ := kube.CoreV1().ConfigMaps("default").Get(context.Background(), "app-config",
+			metav1.GetOptions{})
+		if configMap.Data["a"] != "b" {
+			t.Errorf("Sync() modified the object which is not owned: %v", configMap.Data)
+		}
+	}
+}
+
+func TestSyncKeyConflict(t *testing.T) {
+	var head int32 = 1
+	server := newTestServer(t, &head)
+	defer server.Close()
+	syncer, _ := NewSyncer(newTestClient(t, server), fake.NewSimpleClientset(), "foo", "bar", "/app/**",
+		Target{Kind: ConfigMap, Namespace: "default", Name: "app-config"},
+		WithKeyFunc(func(filePath string) string {
+			return "config"
+		}))
+	if _, err := syncer.Sync(context.Background(), "1"); err == nil ||
+		!strings.Contains(err.Error(), `mapped to the key "config"`) {
+		t.Errorf("Sync() returned %v; want the conflict of the keys", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	var head int32 = 1
+	server := newTestServer(t, &head)
+	defer server.Close()
+	results := make(chan *Result, 10)
+	syncer, _ := NewSyncer(newTestClient(t, server), fake.NewSimpleClientset(), "foo", "bar", "/app/**",
+		Target{Kind: ConfigMap, Namespace: "default", Name: "app-config"},
+		WithListener(func(result *Result, err error) {
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			results <- result
+		}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- syncer.Run(ctx)
+	}()
+
+	expect := func(revision int64, operation Operation) {
+		select {
+		case result := <-results:
+			if result.Revision != revision || result.Operation != operation {
+				t.Errorf("Got %+v; want revision %d %s", result, revision, operation)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for revision %d", revision)
+		}
+	}
+	expect(1, Created)
+	if err := syncer.Run(ctx); err == nil {
+		t.Error("Run() while running returned nil; want an error")
+	}
+	atomic.StoreInt32(&head, 3)
+	expect(3, Updated)
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Run() returned %v; want nil", err)
+	}
+}
+
+func TestSplitBinaryData(t *testing.T) {
+	data := map[string][]byte{"a.txt": []byte("a"), "b.bin": {0xff, 0xfe}}
+	textData, binaryData := splitBinaryData(data)
+	if fmt.Sprint(textData) != "map[a.txt:a]" || fmt.Sprint(binaryData) != "map[b.bin:[255 254]]" {
+		t.Errorf("splitBinaryData(%v) = (%v, %v); want the invalid UTF-8 in the binaryData", data, textData,
+			binaryData)
+	}
+	if hashData(joinBinaryData(textData, binaryData)) != hashData(data) {
+		t.Errorf("The hash of the joined data is different from the hash of %v", data)
+	}
+}
+
+// fixedBackoff is a centraldogma.BackoffPolicy which always returns the same delay.
+type fixedBackoff time.Duration
+
+func (b fixedBackoff) NextDelay(numAttemptsSoFar int) time.Duration {
+	return time.Duration(b)
+}
+
+func TestRun_Retry(t *testing.T) {
+	var head int32 = 1
+	server := newTestServer(t, &head)
+	defer server.Close()
+	kube := fake.NewSimpleClientset()
+	// The API server is unavailable for the first two requests.
+	var failures int32
+	kube.PrependReactor("get", "configmaps", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if atomic.AddInt32(&failures, 1) <= 2 {
+			return true, nil, apierrors.NewServiceUnavailable("unavailable")
+		}
+		return false, nil, nil
+	})
+	results := make(chan error, 10)
+	syncer, _ := NewSyncer(newTestClient(t, server), kube, "foo", "bar", "/app/**",
+		Target{Kind: ConfigMap, Namespace: "default", Name: "app-config"},
+		WithBackoffPolicy(fixedBackoff(10*time.Millisecond)),
+		WithListener(func(result *Result, err error) {
+			results <- err
+		}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() {
+		done <- syncer.Run(ctx)
+	}()
+	for i := 0; i < 3; i++ {
+		select {
+		case err := <-results:
+			if (err == nil) != (i == 2) {
+				t.Errorf("Got %v for the attempt %d", err, i+1)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("Timed out waiting for the attempt %d", i+1)
+		}
+	}
+	if _, err := kube.CoreV1().ConfigMaps("default").Get(ctx, "app-config", metav1.GetOptions{}); err != nil {
+		t.Errorf("The ConfigMap was not created after the retries: %v", err)
+	}
+
+	cancel()
+	if err := <-done; err != nil {
+		t.Errorf("Run() returned %v; want nil", err)
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// Package koanfcentraldogma provides a koanf provider which loads the configuration from Central Dogma and
+// reloads it when the file is changed. For example:
+//
+//	provider := koanfcentraldogma.Provider(client, "foo", "bar",
+//	    &centraldogma.Query{Path: "/config.json", Type: centraldogma.Identity})
+//	k := koanf.New(".")
+//	if err := k.Load(provider, nil); err != nil {
+//	    panic(err)
+//	}
+//	provider.Watch(func(event interface{}, err error) {
+//	    if err != nil {
+//	        return
+//	    }
+//	    k = koanf.New(".")
+//	    k.Load(provider, nil)
+//	})
+package koanfcentraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"go.linecorp.com/centraldogma"
+)
+
+// ErrAlreadyWatching is returned by Watch when the provider is already watching.
+var ErrAlreadyWatching = errors.New("the provider is already watching")
+
+// CentralDogma implements the koanf.Provider interface which reads a file or the merged files in
+// a Central Dogma repository.
+type CentralDogma struct {
+	client      *centraldogma.Client
+	projectName string
+	repoName    string
+	query       *centraldogma.Query      // nil if mergeQuery is set.
+	mergeQuery  *centraldogma.MergeQuery // nil if query is set.
+
+	lock         sync.Mutex
+	lastRevision int64 // the revision of the last read content.
+	watcher      *centraldogma.Watcher
+}
+
+// Provider returns a provider which reads the file specified in the Query. The content of a JSON file can
+// be loaded without a parser, while the content of the other files should be loaded with a parser.
+func Provider(client *centraldogma.Client, projectName, repoName string, query *centraldogma.Query) *CentralDogma {
+	return &CentralDogma{client: client, projectName: projectName, repoName: repoName, query: query}
+}
+
+// MergedProvider returns a provider which reads the JSON files merged by the MergeQuery.
+func MergedProvider(client *centraldogma.Client, projectName, repoName string,
+	mergeQuery *centraldogma.MergeQuery) *CentralDogma {
+	return &CentralDogma{client: client, projectName: projectName, repoName: repoName, mergeQuery: mergeQuery}
+}
+
+// ReadBytes reads the content of the latest revision.
+func (p *CentralDogma) ReadBytes() ([]byte, error) {
+	ctx := context.Background()
+	var content []byte
+	var revision int64
+	if p.mergeQuery != nil {
+		merged, _, err := p.client.GetMergedEntry(ctx, p.projectName, p.repoName, "-1", p.mergeQuery)
+		if err != nil {
+			return nil, err
+		}
+		content, revision = merged.Content, merged.Revision
+	} else {
+		entry, _, err := p.client.GetFile(ctx, p.projectName, p.repoName, "-1", p.query)
+		if err != nil {
+			return nil, err
+		}
+		content, revision = entry.Content, entry.Revision
+	}
+
+	p.lock.Lock()
+	if revision > p.lastRevision {
+		p.lastRevision = revision
+	}
+	p.lock.Unlock()
+	return content, nil
+}
+
+// Read reads the JSON content of the latest revision as a map.
+func (p *CentralDogma) Read() (map[string]interface{}, error) {
+	content, err := p.ReadBytes()
+	if err != nil {
+		return nil, err
+	}
+	var m map[string]interface{}
+	if err = json.Unmarshal(content, &m); err != nil {
+		return nil, fmt.Errorf("the content is not a JSON object, use ReadBytes with a parser: %v", err)
+	}
+	return m, nil
+}
+
+// Watch starts watching the file or the merged files and invokes the callback with a nil event whenever the
+// content is changed after the last read. The callback is expected to reload the configuration.
+func (p *CentralDogma) Watch(cb func(event interface{}, err error)) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.watcher != nil {
+		return ErrAlreadyWatching
+	}
+
+	var watcher *centraldogma.Watcher
+	var err error
+	if p.mergeQuery != nil {
+		paths := make([]string, len(p.mergeQuery.MergeSources))
+		for i, source := range p.mergeQuery.MergeSources {
+			paths[i] = source.Path
+		}
+		watcher, err = p.client.RepoWatcher(p.projectName, p.repoName, strings.Join(paths, ","))
+	} else {
+		watcher, err = p.client.FileWatcher(p.projectName, p.repoName, p.query)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = watcher.Watch(func(result centraldogma.WatchResult) {
+		p.lock.Lock()
+		changed := result.Revision > p.lastRevision
+		p.lock.Unlock()
+		if changed {
+			cb(nil, result.Err)
+		}
+	}); err != nil {
+		watcher.Close()
+		return err
+	}
+	p.watcher = watcher
+	return nil
+}
+
+// Unwatch stops watching.
+func (p *CentralDogma) Unwatch() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.watcher != nil {
+		p.watcher.Close()
+		p.watcher = nil
+	}
+	return nil
+}
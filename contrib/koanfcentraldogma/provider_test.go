@@ -0,0 +1,98 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package koanfcentraldogma
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/knadh/koanf/v2"
+	"go.linecorp.com/centraldogma"
+)
+
+var _ koanf.Provider = (*CentralDogma)(nil)
+
+func TestProvider(t *testing.T) {
+	var revision int32 = 2
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/config.json", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("if-none-match") != "" {
+			// Let's pretend that the file is modified.
+			time.Sleep(100 * time.Millisecond)
+			if atomic.LoadInt32(&revision) == 2 {
+				atomic.StoreInt32(&revision, 3)
+			} else {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+		}
+		rev := atomic.LoadInt32(&revision)
+		fmt.Fprintf(w, `{"revision":%d,"entry":{"path":"/config.json","type":"JSON",`+
+			`"content":{"server":{"port":%d}}},"path":"/config.json","type":"JSON","content":{"server":{"port":%d}}}`,
+			rev, 8000+rev, 8000+rev)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	client, err := centraldogma.NewClient(server.URL, centraldogma.WithTransport(http.DefaultTransport))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider := Provider(client, "foo", "bar",
+		&centraldogma.Query{Path: "/config.json", Type: centraldogma.Identity})
+	k := koanf.New(".")
+	if err = k.Load(provider, nil); err != nil {
+		t.Fatal(err)
+	}
+	if port := k.Int("server.port"); port != 8002 {
+		t.Errorf("server.port: %v, want 8002", port)
+	}
+
+	reloaded := make(chan *koanf.Koanf, 1)
+	err = provider.Watch(func(event interface{}, err error) {
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		k := koanf.New(".")
+		if err := k.Load(provider, nil); err != nil {
+			t.Error(err)
+			return
+		}
+		reloaded <- k
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Unwatch()
+
+	if err = provider.Watch(func(interface{}, error) {}); err != ErrAlreadyWatching {
+		t.Errorf("Watch returned %v, want %v", err, ErrAlreadyWatching)
+	}
+
+	select {
+	case k := <-reloaded:
+		if port := k.Int("server.port"); port != 8003 {
+			t.Errorf("server.port: %v, want 8003", port)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the configuration is not reloaded")
+	}
+}
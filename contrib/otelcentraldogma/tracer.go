@@ -0,0 +1,110 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// Package otelcentraldogma provides the OpenTelemetry tracing for the Central Dogma client. For example:
+//
+//	client, err := centraldogma.NewClient("https://localhost:443",
+//	    centraldogma.WithToken("myToken"),
+//	    otelcentraldogma.WithTracerProvider(tracerProvider))
+package otelcentraldogma
+
+import (
+	"context"
+	"net/http"
+
+	"go.linecorp.com/centraldogma"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "go.linecorp.com/centraldogma/contrib/otelcentraldogma"
+
+// Option configures the Tracer.
+type Option func(*config)
+
+type config struct {
+	propagator propagation.TextMapPropagator
+}
+
+// WithPropagator sets the propagator which injects the trace context into the requests.
+// The global propagator is used by default.
+func WithPropagator(propagator propagation.TextMapPropagator) Option {
+	return func(c *config) {
+		c.propagator = propagator
+	}
+}
+
+// WithTracerProvider returns a centraldogma.ClientOption which creates a client span for each API call using
+// the provider. The global provider is used if the provider is nil.
+func WithTracerProvider(provider trace.TracerProvider, opts ...Option) centraldogma.ClientOption {
+	return centraldogma.WithTracer(NewTracer(provider, opts...))
+}
+
+// NewTracer returns a centraldogma.Tracer which creates a client span for each API call using the provider.
+// The global provider is used if the provider is nil.
+func NewTracer(provider trace.TracerProvider, opts ...Option) centraldogma.Tracer {
+	if provider == nil {
+		provider = otel.GetTracerProvider()
+	}
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.propagator == nil {
+		c.propagator = otel.GetTextMapPropagator()
+	}
+	return &tracer{tracer: provider.Tracer(instrumentationName), propagator: c.propagator}
+}
+
+type tracer struct {
+	tracer     trace.Tracer
+	propagator propagation.TextMapPropagator
+}
+
+func (t *tracer) Start(ctx context.Context, req *http.Request,
+	info *centraldogma.RequestInfo) (context.Context, func(statusCode int, err error)) {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", req.Method),
+		attribute.String("centraldogma.operation", info.Operation),
+	}
+	if len(info.ProjectName) != 0 {
+		attributes = append(attributes, attribute.String("centraldogma.project", info.ProjectName))
+	}
+	if len(info.RepoName) != 0 {
+		attributes = append(attributes, attribute.String("centraldogma.repo", info.RepoName))
+	}
+	if len(info.Path) != 0 {
+		attributes = append(attributes, attribute.String("centraldogma.path", info.Path))
+	}
+
+	ctx, span := t.tracer.Start(ctx, "centraldogma."+info.Name,
+		trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(attributes...))
+	t.propagator.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return ctx, func(statusCode int, err error) {
+		if statusCode != centraldogma.UnknownHttpStatusCode {
+			span.SetAttributes(attribute.Int("http.response.status_code", statusCode))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		} else if statusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(statusCode))
+		}
+		span.End()
+	}
+}
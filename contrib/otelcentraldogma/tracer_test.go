@@ -0,0 +1,88 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package otelcentraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.linecorp.com/centraldogma"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestWithTracerProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		if len(r.Header.Get("traceparent")) == 0 {
+			t.Errorf("traceparent header is not propagated")
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.EntryNotFoundException"}`)
+	})
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	client, err := centraldogma.NewClient(server.URL,
+		centraldogma.WithTransport(http.DefaultTransport),
+		WithTracerProvider(provider, WithPropagator(propagation.TraceContext{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	query := &centraldogma.Query{Path: "/a.json", Type: centraldogma.Identity}
+	if _, _, err = client.GetFile(context.Background(), "foo", "bar", "-1", query); err == nil {
+		t.Fatal("GetFile should fail")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %v spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "centraldogma.GetFile" {
+		t.Errorf("span name: %v, want %v", span.Name(), "centraldogma.GetFile")
+	}
+	if span.Status().Code != codes.Error {
+		t.Errorf("span status: %v, want %v", span.Status().Code, codes.Error)
+	}
+
+	want := map[attribute.Key]attribute.Value{
+		"centraldogma.project":      attribute.StringValue("foo"),
+		"centraldogma.repo":         attribute.StringValue("bar"),
+		"centraldogma.path":         attribute.StringValue("/a.json"),
+		"http.response.status_code": attribute.IntValue(http.StatusNotFound),
+	}
+	for _, kv := range span.Attributes() {
+		if value, ok := want[kv.Key]; ok {
+			if kv.Value != value {
+				t.Errorf("attribute %v: %v, want %v", kv.Key, kv.Value.Emit(), value.Emit())
+			}
+			delete(want, kv.Key)
+		}
+	}
+	if len(want) != 0 {
+		t.Errorf("missing attributes: %v", want)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// dnsResolver resolves the hostname of the server periodically and rotates the connections among
+// the resolved A and AAAA records, so that a long-lived client picks up the changes of the replicas.
+type dnsResolver struct {
+	interval   time.Duration
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+	dialer     *net.Dialer
+	now        func() time.Time
+	logger     Logger
+
+	lock  sync.Mutex
+	hosts map[string]*resolvedHost
+}
+
+type resolvedHost struct {
+	addrs      []string
+	resolvedAt time.Time
+	next       int // index of the address to dial next
+}
+
+func newDNSResolver(interval time.Duration, logger Logger) *dnsResolver {
+	return &dnsResolver{
+		interval:   interval,
+		lookupHost: net.DefaultResolver.LookupHost,
+		dialer:     &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second},
+		now:        time.Now,
+		logger:     logger,
+		hosts:      make(map[string]*resolvedHost),
+	}
+}
+
+// addrs returns the addresses of the host in the order to dial. The host is resolved again if the previous
+// resolution is older than the interval. The previous addresses are used if the resolution fails.
+func (r *dnsResolver) addrs(ctx context.Context, host string) ([]string, error) {
+	r.lock.Lock()
+	resolved, ok := r.hosts[host]
+	expired := !ok || r.now().Sub(resolved.resolvedAt) >= r.interval
+	r.lock.Unlock()
+
+	if expired {
+		addrs, err := r.lookupHost(ctx, host)
+		r.lock.Lock()
+		switch {
+		case err == nil && len(addrs) != 0:
+			if ok && !sameAddrs(resolved.addrs, addrs) {
+				r.logger.Debugf("The addresses of %s are changed: %v -> %v", host, resolved.addrs, addrs)
+			}
+			resolved = &resolvedHost{addrs: addrs, resolvedAt: r.now()}
+			r.hosts[host] = resolved
+		case ok:
+			r.logger.Warnf("Failed to resolve %s, using the previous addresses %v: %v", host, resolved.addrs, err)
+			resolved.resolvedAt = r.now() // try again after the interval
+		default:
+			r.lock.Unlock()
+			if err == nil {
+				err = fmt.Errorf("no addresses found for %s", host)
+			}
+			return nil, err
+		}
+		r.lock.Unlock()
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	ordered := make([]string, len(resolved.addrs))
+	for i := range resolved.addrs {
+		ordered[i] = resolved.addrs[(resolved.next+i)%len(resolved.addrs)]
+	}
+	resolved.next = (resolved.next + 1) % len(resolved.addrs)
+	return ordered, nil
+}
+
+func sameAddrs(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// dialContext connects to the next address of the host in the addr, falling back to the other addresses.
+func (r *dnsResolver) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if net.ParseIP(host) != nil {
+		return r.dialer.DialContext(ctx, network, addr)
+	}
+
+	addrs, err := r.addrs(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+	var conn net.Conn
+	for _, ip := range addrs {
+		if conn, err = r.dialer.DialContext(ctx, network, net.JoinHostPort(ip, port)); err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// dialTLSFunc returns the func which can be used as the DialTLS of http2.Transport. It performs the TLS handshake
+// over the connection made by the dialContext. The server name is verified against the hostname even though
+// the connection is made to another address, e.g. a resolved address or a proxy. http2.Transport does not pass
+// the context of the request, so the dial and the handshake are bounded by tlsHandshakeTimeout instead.
+func dialTLSFunc(dialContext func(ctx context.Context, network, addr string) (net.Conn, error)) func(network,
+	addr string, config *tls.Config) (net.Conn, error) {
+	return func(network, addr string, config *tls.Config) (net.Conn, error) {
+		ctx, cancel := context.WithTimeout(context.Background(), tlsHandshakeTimeout)
+		defer cancel()
+		conn, err := dialContext(ctx, network, addr)
+		if err != nil {
+			return nil, err
+		}
+		return tlsClientHandshake(ctx, conn, addr, config)
+	}
+}
+
+// tlsClientHandshake performs the TLS handshake over the conn which is connected to the addr, verifying
+// the server name against the hostname of the addr unless the config specifies it. The handshake fails if it
+// does not complete within tlsHandshakeTimeout or before the ctx is done. The conn is closed if the handshake
+// fails.
+func tlsClientHandshake(ctx context.Context, conn net.Conn, addr string, config *tls.Config) (net.Conn, error) {
+	if config == nil {
+		config = &tls.Config{}
+	} else {
+		config = config.Clone()
+	}
+	if len(config.ServerName) == 0 {
+		config.ServerName, _, _ = net.SplitHostPort(addr)
+	}
+
+	deadline := time.Now().Add(tlsHandshakeTimeout)
+	ctxDeadline, ok := ctx.Deadline()
+	deadlineFromCtx := ok && ctxDeadline.Before(deadline)
+	if deadlineFromCtx {
+		deadline = ctxDeadline
+	}
+	_ = conn.SetDeadline(deadline)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.SetDeadline(time.Now()) // aborts the handshake
+		case <-done:
+		}
+	}()
+
+	tlsConn := tls.Client(conn, config)
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() && deadlineFromCtx {
+			// The conn may time out slightly before the ctx is done because they use the same deadline.
+			<-ctx.Done()
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, err
+	}
+	_ = conn.SetDeadline(time.Time{})
+	return tlsConn, nil
+}
+
+// dnsRefreshTransport closes the idle connections of the transport before sending a request once every
+// interval, so that the next request makes a new connection to an address which is resolved again and rotated.
+// A connection which is never idle, e.g. the HTTP/2 connection which keeps serving the long-polling watch
+// requests, is not closed, so it keeps the address it was made to.
+type dnsRefreshTransport struct {
+	base                 http.RoundTripper
+	closeIdleConnections func()
+	interval             time.Duration
+	now                  func() time.Time
+
+	lock        sync.Mutex
+	refreshedAt time.Time
+}
+
+func newDNSRefreshTransport(base http.RoundTripper, closeIdleConnections func(),
+	interval time.Duration) *dnsRefreshTransport {
+	return &dnsRefreshTransport{
+		base:                 base,
+		closeIdleConnections: closeIdleConnections,
+		interval:             interval,
+		now:                  time.Now,
+		refreshedAt:          time.Now(),
+	}
+}
+
+func (t *dnsRefreshTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.lock.Lock()
+	now := t.now()
+	refresh := now.Sub(t.refreshedAt) >= t.interval
+	if refresh {
+		t.refreshedAt = now
+	}
+	t.lock.Unlock()
+
+	if refresh {
+		t.closeIdleConnections()
+	}
+	return t.base.RoundTrip(req)
+}
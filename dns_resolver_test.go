@@ -0,0 +1,160 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/veqryn/h2c"
+	"golang.org/x/net/http2"
+)
+
+func TestDNSResolver_Rotation(t *testing.T) {
+	now := time.Unix(0, 0)
+	records := []string{"127.0.0.1", "127.0.0.2"}
+	var lookupErr error
+	lookups := 0
+
+	resolver := newDNSResolver(time.Minute, log)
+	resolver.now = func() time.Time { return now }
+	resolver.lookupHost = func(ctx context.Context, host string) ([]string, error) {
+		lookups++
+		return records, lookupErr
+	}
+
+	next := func() string {
+		addrs, err := resolver.addrs(context.Background(), "dogma.example.com")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return addrs[0]
+	}
+
+	testString(t, next(), "127.0.0.1", "1st address")
+	testString(t, next(), "127.0.0.2", "2nd address")
+	testString(t, next(), "127.0.0.1", "3rd address")
+	if lookups != 1 {
+		t.Errorf("looked up %v times, want %v", lookups, 1)
+	}
+
+	// Resolved again after the interval.
+	now = now.Add(time.Minute)
+	records = []string{"127.0.0.3"}
+	testString(t, next(), "127.0.0.3", "new address")
+
+	// The previous addresses are used if the resolution fails.
+	now = now.Add(time.Minute)
+	lookupErr = errors.New("no such host")
+	testString(t, next(), "127.0.0.3", "previous address")
+	if lookups != 3 {
+		t.Errorf("looked up %v times, want %v", lookups, 3)
+	}
+}
+
+func TestWithDNSRefreshInterval(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(&h2c.HandlerH2C{Handler: mux, H2Server: &http2.Server{}})
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+
+	// "localhost" is resolved by the system resolver and the client falls back to the other addresses
+	// if the server does not listen on the first one.
+	_, port, _ := net.SplitHostPort(server.Listener.Addr().String())
+	c, err := NewClient("http://localhost:"+port, WithToken(token), WithDNSRefreshInterval(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Errorf("ListProjects returned error: %v", err)
+	}
+
+	if _, err = NewClient("http://localhost:"+port, WithTransport(http.DefaultTransport),
+		WithDNSRefreshInterval(time.Minute)); err != ErrDNSRefreshConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrDNSRefreshConflict)
+	}
+}
+
+func TestDNSRefreshTransport(t *testing.T) {
+	now := time.Now()
+	closed := 0
+	base := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	})
+	transport := newDNSRefreshTransport(base, func() { closed++ }, time.Minute)
+	transport.now = func() time.Time { return now }
+	transport.refreshedAt = now
+
+	roundTrip := func() {
+		req, _ := http.NewRequest(http.MethodGet, "http://localhost/", nil)
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	roundTrip()
+	if closed != 0 {
+		t.Errorf("closed the idle connections %v times, want %v", closed, 0)
+	}
+
+	now = now.Add(time.Minute)
+	roundTrip()
+	roundTrip()
+	if closed != 1 {
+		t.Errorf("closed the idle connections %v times, want %v", closed, 1)
+	}
+}
+
+func TestTLSClientHandshake_Timeout(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listener.Close()
+	go func() {
+		// Accepts the connection but never responds to the handshake.
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			defer conn.Close()
+		}
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err = tlsClientHandshake(ctx, conn, listener.Addr().String(), nil); err != context.DeadlineExceeded {
+		t.Errorf("tlsClientHandshake returned %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+type roundTripperFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
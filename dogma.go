@@ -39,7 +39,7 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
-	"fmt"
+	"errors"
 	"io"
 	"io/ioutil"
 	"net"
@@ -47,6 +47,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	metrics "github.com/armon/go-metrics"
@@ -68,8 +69,16 @@ const (
 )
 
 // A Client communicates with the Central Dogma server API.
+//
+// A Client is safe for concurrent use by multiple goroutines and should be reused rather than created per
+// request. The session token of WithPasswordLogin, the caches, the rate limiter and the endpoint health are
+// shared among the goroutines, and an expired session is renewed only once even if many requests fail at the
+// same time. A Watcher is also safe for concurrent use, but its listeners are invoked from the goroutines of
+// the Watcher, so they must synchronize the state which they share with the other goroutines. The Entry passed
+// to the listeners is shared among them and must not be modified.
 type Client struct {
-	client *http.Client // HTTP client which sends the request.
+	client          *http.Client // HTTP client which sends the request.
+	unauthenticated *http.Client // HTTP client which sends the request with the token of the context, or nil.
 
 	baseURL *url.URL // Base URL for API requests.
 
@@ -78,9 +87,34 @@ type Client struct {
 	repository *repositoryService
 	content    *contentService
 	watch      *watchService
+	token      *tokenService
+	metadata   *metadataService
+	mirror     *mirrorService
+	server     *serverService
+
+	userAgent      string        // User-Agent header of every request.
+	header         http.Header   // Additional headers of every request.
+	requestTimeout time.Duration // Timeout of every request except watch requests.
+	defaultTimeout time.Duration // Timeout of the non-watch requests whose context has no deadline.
+
+	cache         *responseCache // Cache of the content responses. nil if disabled.
+	revisionCache *revisionCache // Cache of the latest revisions. nil if disabled.
+
+	instrumentation Instrumentation // nil if disabled.
+	tracer          Tracer          // nil if disabled.
+	logger          Logger
+	endpoints       *endpointGroup    // nil if there is only one endpoint.
+	limiter         *requestLimiter   // nil if disabled.
+	breaker         *circuitBreaker   // nil if disabled.
+	watchMux        *watchMultiplexer // nil if disabled.
+	watcherCacheDir string            // empty if disabled.
+	backoffPolicy   BackoffPolicy     // nil if the default is used.
+	lenientJSON     bool              // whether the comments and trailing commas are allowed in JSON entries.
+	codec           Codec             // JSON codec of the requests and the responses.
+	pushValidators  []pushValidator
 
 	// metrics
-	metricCollector *metrics.Metrics
+	metricCollector atomic.Value // of *metrics.Metrics, which can be set by SetMetricCollector at any time.
 }
 
 type service struct {
@@ -103,6 +137,84 @@ func NewClientWithToken(baseURL, token string, transport http.RoundTripper) (*Cl
 	return newClientWithHTTPClient(normalizedURL, client)
 }
 
+// NewClient returns a Central Dogma client which communicates the server at baseURL, configured with
+// the specified options. For example:
+//
+//	client, err := centraldogma.NewClient("https://localhost:443",
+//	    centraldogma.WithToken("myToken"),
+//	    centraldogma.WithUserAgent("myApp"),
+//	    centraldogma.WithRequestTimeout(10 * time.Second))
+func NewClient(baseURL string, opts ...ClientOption) (*Client, error) {
+	normalizedURL, err := normalizeURL(baseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &clientOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	client, err := options.newHTTPClient(normalizedURL.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(options.vcrCassette) != 0 {
+		if client, err = newVCRClient(client, options.vcrCassette, options.vcrMode, options.vcrScrubbers); err != nil {
+			return nil, err
+		}
+	}
+
+	c, err := newClientWithHTTPClient(normalizedURL, client)
+	if err != nil {
+		return nil, err
+	}
+	c.userAgent = options.userAgent
+	c.header = options.header
+	c.requestTimeout = options.requestTimeout
+	c.defaultTimeout = options.defaultTimeout
+	if options.cacheSize > 0 {
+		c.cache = newResponseCache(options.cacheSize, options.cacheTTL)
+	}
+	if options.revisionCacheTTL > 0 {
+		c.revisionCache = newRevisionCache(options.revisionCacheTTL)
+	}
+	if c.instrumentation, err = options.newInstrumentation(); err != nil {
+		return nil, err
+	}
+	c.tracer = options.tracer
+	c.watcherCacheDir = options.watcherCacheDir
+	c.backoffPolicy = options.backoffPolicy
+	c.lenientJSON = options.lenientJSON
+	if options.codec != nil {
+		c.codec = options.codec
+	}
+	c.pushValidators = options.pushValidators
+	if options.watchMultiplexing {
+		c.watchMux = newWatchMultiplexer(c.watch)
+	}
+	if options.rateLimit > 0 || options.maxInflight > 0 {
+		c.limiter = newRequestLimiter(options.rateLimit, options.rateBurst, options.maxInflight)
+	}
+	c.logger = options.loggerOrDefault()
+	if options.circuitBreakerThreshold > 0 {
+		c.breaker = newCircuitBreaker(options.circuitBreakerThreshold, options.circuitBreakerCooldown, c.logger)
+	}
+	if len(options.endpoints) != 0 {
+		baseURLs := []*url.URL{c.baseURL}
+		for _, endpoint := range options.endpoints {
+			baseURL, err := normalizeURL(endpoint)
+			if err != nil {
+				return nil, err
+			}
+			baseURLs = append(baseURLs, baseURL)
+		}
+		c.endpoints = newEndpointGroup(baseURLs, options.healthCheckInterval, client, c.logger)
+		c.endpoints.hedgeDelay = options.hedgeDelay
+	}
+	return c, nil
+}
+
 // DefaultOAuth2Transport returns an oauth2.Transport which internally uses the specified transport and attaches
 // the specified token to every request using the authorization header. If the transport is a type of oauth2.Transport,
 // it will throw an error.
@@ -173,8 +285,11 @@ func newOAuth2HTTP2Client(normalizedURL, token string, transport http.RoundTripp
 // The client should perform the authentication.
 func newClientWithHTTPClient(baseURL *url.URL, client *http.Client) (*Client, error) {
 	c := &Client{
-		client:  client,
-		baseURL: baseURL,
+		client:          client,
+		unauthenticated: withoutAuthentication(client),
+		baseURL:         baseURL,
+		logger:          log,
+		codec:           jsonCodec{},
 	}
 	service := &service{client: c}
 
@@ -182,6 +297,10 @@ func newClientWithHTTPClient(baseURL *url.URL, client *http.Client) (*Client, er
 	c.repository = (*repositoryService)(service)
 	c.content = (*contentService)(service)
 	c.watch = (*watchService)(service)
+	c.token = (*tokenService)(service)
+	c.metadata = (*metadataService)(service)
+	c.mirror = (*mirrorService)(service)
+	c.server = (*serverService)(service)
 	return c, nil
 }
 
@@ -228,6 +347,22 @@ func (c *Client) SecurityEnabled() (bool, error) {
 	return true, nil
 }
 
+// ServerHealthy returns whether the server is healthy using the /monitor/l7check endpoint. It returns false
+// without an error when the server responds with 503 Service Unavailable.
+func (c *Client) ServerHealthy(ctx context.Context) (healthy bool, httpStatusCode int, err error) {
+	return c.server.healthy(ctx)
+}
+
+// ServerStatus returns whether the server is writable and replicating.
+func (c *Client) ServerStatus(ctx context.Context) (status *ServerStatus, httpStatusCode int, err error) {
+	return c.server.status(ctx)
+}
+
+// ServerVersion returns the version information of the server served at /monitor/version.
+func (c *Client) ServerVersion(ctx context.Context) (version *ServerVersion, httpStatusCode int, err error) {
+	return c.server.version(ctx)
+}
+
 func (c *Client) newRequest(method string, url *url.URL, body interface{}) (*http.Request, error) {
 	// resolves a URI reference to an absolute URI from base URI
 	u := c.baseURL.ResolveReference(url)
@@ -237,13 +372,11 @@ func (c *Client) newRequest(method string, url *url.URL, body interface{}) (*htt
 		if str, ok := body.(string); ok {
 			buf = bytes.NewBufferString(str)
 		} else {
-			buf = new(bytes.Buffer)
-			enc := json.NewEncoder(buf)
-			enc.SetEscapeHTML(true)
-			err := enc.Encode(body)
+			b, err := c.codec.Marshal(body)
 			if err != nil {
 				return nil, err
 			}
+			buf = bytes.NewBuffer(b)
 		}
 	}
 
@@ -252,6 +385,16 @@ func (c *Client) newRequest(method string, url *url.URL, body interface{}) (*htt
 		return nil, err
 	}
 
+	for key, values := range c.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+
+	if len(c.userAgent) != 0 {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
 	if auth := req.Header.Get("Authorization"); len(auth) == 0 {
 		req.Header.Set("Authorization", "Bearer anonymous")
 	}
@@ -267,7 +410,8 @@ func (c *Client) newRequest(method string, url *url.URL, body interface{}) (*htt
 }
 
 type errorMessage struct {
-	Message string `json:"message"`
+	Exception string `json:"exception"`
+	Message   string `json:"message"`
 }
 
 func drainupAndCloseResponseBody(body io.ReadCloser) {
@@ -285,11 +429,217 @@ func drainupAndCloseResponseBody(body io.ReadCloser) {
 
 func (c *Client) do(ctx context.Context,
 	req *http.Request, resContent interface{}, watchRequest bool) (statusCode int, err error) {
+	if !watchRequest {
+		var cancel context.CancelFunc
+		ctx, cancel = c.withRequestTimeout(ctx)
+		defer cancel()
+	}
+
+	if !watchRequest && c.cache != nil && isCacheableRequest(req) && !hasCallCredentialsOrBaseURL(ctx) {
+		return c.doCached(ctx, req, resContent)
+	}
+
+	if watchRequest && c.instrumentation != nil {
+		operation := operationName(req, true)
+		c.instrumentation.ObserveWatchStarted(operation)
+		defer c.instrumentation.ObserveWatchFinished(operation)
+	}
+
+	res, metricLabels, statusCode, err := c.send(ctx, req, watchRequest)
+	if err != nil {
+		return
+	}
+
+	// handling status code
+	startAt := time.Now()
+	if !watchRequest || statusCode != http.StatusNotModified {
+		if statusCode < 200 || statusCode >= 300 {
+			err = decodeErrorResponse(res.Body, statusCode, req.Header.Get(RequestIDHeader))
+		} else if resContent != nil {
+			err = decodeBody(c.codec, res.Body, resContent)
+		}
+	}
+
+	// report metric
+	if metricCollector := c.loadMetricCollector(); metricCollector != nil {
+		metricCollector.MeasureSinceWithLabels([]string{"parseDuration"}, startAt, metricLabels)
+	}
+
+	// never forget to drain up and close before returning
+	drainupAndCloseResponseBody(res.Body)
+
+	return
+}
+
+// withRequestTimeout applies the timeouts of the non-watch requests to the context.
+func (c *Client) withRequestTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	cancel := func() {}
+	if timeout, ok := callTimeoutFromContext(ctx); ok {
+		if timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+		}
+	} else if c.requestTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.requestTimeout)
+	} else if _, ok := ctx.Deadline(); !ok && c.defaultTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, c.defaultTimeout)
+	}
+	return ctx, cancel
+}
+
+// doCached sends the request using the response cache. http.StatusOK is returned when the cached response
+// is used. A conditional request cannot revalidate the contents because the server treats it as a watch, so
+// a relative revision is normalized first and the response is cached at the absolute revision.
+func (c *Client) doCached(ctx context.Context, req *http.Request, resContent interface{}) (int, error) {
+	relativeKey := ""
+	if !isImmutableRequest(req) {
+		projectName, repoName, ok := contentsRequestRepo(req)
+		if !ok {
+			return c.doUncached(ctx, req, resContent)
+		}
+		revision := req.URL.Query().Get("revision")
+		if len(revision) == 0 {
+			revision = Head.String()
+		}
+		relativeKey = req.URL.String()
+		normalized, httpStatusCode, err := c.repository.normalizeRevision(ctx, projectName, repoName, revision)
+		if err == ErrCircuitOpen {
+			if cached := c.cache.get(relativeKey); cached != nil {
+				// Degrade to the last response at the relative revision while the server keeps failing.
+				return http.StatusOK, c.decodeCachedResponse(cached, resContent)
+			}
+		}
+		if err != nil {
+			return httpStatusCode, err
+		}
+		query := req.URL.Query()
+		query.Set("revision", strconv.FormatInt(normalized, 10))
+		req.URL.RawQuery = query.Encode()
+	}
+
+	key := req.URL.String()
+	cached := c.cache.get(key)
+	if cached != nil {
+		return http.StatusOK, c.decodeCachedResponse(cached, resContent)
+	}
+
+	res, _, statusCode, err := c.send(ctx, req, false)
+	if err != nil {
+		return statusCode, err
+	}
+	defer drainupAndCloseResponseBody(res.Body)
+
+	if statusCode < 200 || statusCode >= 300 {
+		return statusCode, decodeErrorResponse(res.Body, statusCode, req.Header.Get(RequestIDHeader))
+	}
+
+	body, err := ioutil.ReadAll(res.Body)
+	if err != nil {
+		return statusCode, err
+	}
+	if statusCode == http.StatusOK {
+		c.cache.put(&cachedResponse{key: key, body: body})
+		if len(relativeKey) != 0 {
+			// Only used while the circuit is open because it may be older than the latest revision.
+			c.cache.put(&cachedResponse{key: relativeKey, body: body})
+		}
+	}
+	return statusCode, c.decodeCachedResponse(&cachedResponse{body: body}, resContent)
+}
+
+// doUncached sends the request which cannot be cached and decodes the response.
+func (c *Client) doUncached(ctx context.Context, req *http.Request, resContent interface{}) (int, error) {
+	res, _, statusCode, err := c.send(ctx, req, false)
+	if err != nil {
+		return statusCode, err
+	}
+	defer drainupAndCloseResponseBody(res.Body)
+
+	if statusCode < 200 || statusCode >= 300 {
+		return statusCode, decodeErrorResponse(res.Body, statusCode, req.Header.Get(RequestIDHeader))
+	}
+	if resContent != nil {
+		err = decodeBody(c.codec, res.Body, resContent)
+	}
+	return statusCode, err
+}
+
+func (c *Client) decodeCachedResponse(cached *cachedResponse, resContent interface{}) error {
+	if resContent == nil || len(cached.body) == 0 {
+		return nil
+	}
+	return c.codec.Unmarshal(cached.body, resContent)
+}
+
+// doStream sends the request and returns the response body without decoding it. The caller must close
+// the returned body.
+func (c *Client) doStream(ctx context.Context, req *http.Request) (body io.ReadCloser, statusCode int, err error) {
+	body, _, statusCode, err = c.doStreamWithHeader(ctx, req)
+	return
+}
+
+// doStreamWithHeader is the same as doStream except that it also returns the response headers.
+func (c *Client) doStreamWithHeader(ctx context.Context, req *http.Request) (body io.ReadCloser, header http.Header,
+	statusCode int, err error) {
+	ctx, cancel := c.withRequestTimeout(ctx)
+
+	res, _, statusCode, err := c.send(ctx, req, false)
+	if err != nil {
+		cancel()
+		return nil, nil, statusCode, err
+	}
+
+	if statusCode < 200 || statusCode >= 300 {
+		err = decodeErrorResponse(res.Body, statusCode, req.Header.Get(RequestIDHeader))
+		drainupAndCloseResponseBody(res.Body)
+		cancel()
+		return nil, nil, statusCode, err
+	}
+	return &cancelOnCloseBody{ReadCloser: res.Body, cancel: cancel}, res.Header, statusCode, nil
+}
+
+// send sends the request and reports the request metrics.
+func (c *Client) send(ctx context.Context, req *http.Request,
+	watchRequest bool) (res *http.Response, metricLabels []metrics.Label, statusCode int, err error) {
+	// The header is shared with the caller which reads it to set the RequestID of an APIError.
+	requestID := RequestIDFromContext(ctx)
+	if len(requestID) == 0 {
+		requestID = newRequestID()
+	}
+	req.Header.Set(RequestIDHeader, requestID)
+
+	rebased, err := c.applyCallOverrides(ctx, req)
+	if err != nil {
+		statusCode = UnknownHttpStatusCode
+		return
+	}
+
+	if c.tracer != nil {
+		var finish func(statusCode int, err error)
+		ctx, finish = c.tracer.Start(ctx, req, newRequestInfo(req, watchRequest))
+		defer func() {
+			finish(statusCode, err)
+		}()
+	}
 	req = req.WithContext(ctx)
 
+	if c.limiter != nil {
+		var release func()
+		if release, err = c.limiter.acquire(ctx, watchRequest); err != nil {
+			statusCode = UnknownHttpStatusCode
+			return
+		}
+		defer func() {
+			if err != nil {
+				release()
+			} else {
+				res.Body = &cancelOnCloseBody{ReadCloser: res.Body, cancel: release}
+			}
+		}()
+	}
+
 	// prepare metrics
-	var metricLabels []metrics.Label
-	if c.metricCollector != nil {
+	metricCollector := c.loadMetricCollector()
+	if metricCollector != nil {
 		metricLabels = []metrics.Label{
 			{Name: "method", Value: req.Method},
 			{Name: "host", Value: req.URL.Host},              // included port
@@ -298,11 +648,30 @@ func (c *Client) do(ctx context.Context,
 		}
 	}
 
+	if c.breaker != nil && !c.breaker.allow() {
+		statusCode = UnknownHttpStatusCode
+		err = ErrCircuitOpen
+		return
+	}
+
 	// mark the time point when request begins
 	startAt := time.Now()
 
 	// make request
-	res, err := c.client.Do(req)
+	c.logger.Debugf("Sending a request: %s %s, id=%s", req.Method, redactURL(req.URL), requestID)
+	attempts := 1
+	client := c.client
+	if _, ok := callTokenFromContext(ctx); ok && c.unauthenticated != nil {
+		client = c.unauthenticated
+	}
+	if c.endpoints != nil && !rebased {
+		res, attempts, err = c.endpoints.do(client, req, c.baseURL, watchRequest)
+	} else {
+		res, err = client.Do(req)
+	}
+	if c.breaker != nil {
+		c.breaker.done(ctx, res, err)
+	}
 
 	// get response status code
 	if err == nil {
@@ -310,50 +679,50 @@ func (c *Client) do(ctx context.Context,
 	} else {
 		statusCode = UnknownHttpStatusCode
 	}
+	recordResponseMeta(ctx, req, res, err, attempts, time.Since(startAt))
 
 	// report duration metric (even if error happened)
-	if c.metricCollector != nil {
+	if metricCollector != nil {
 		metricLabels = append(metricLabels, metrics.Label{Name: "statusCode", Value: strconv.Itoa(statusCode)})
-		c.metricCollector.MeasureSinceWithLabels([]string{"requestDuration"}, startAt, metricLabels)
+		metricCollector.MeasureSinceWithLabels([]string{"requestDuration"}, startAt, metricLabels)
+	}
+	if c.instrumentation != nil {
+		c.instrumentation.ObserveRequest(operationName(req, watchRequest), statusCode, time.Since(startAt), err)
 	}
 
 	// check request error
 	if err != nil {
-		if c.metricCollector != nil {
-			c.metricCollector.IncrCounter([]string{"totalRequestFail"}, 1)
-		}
-		return
-	}
-
-	// handling status code
-	startAt = time.Now()
-	if !watchRequest || statusCode != http.StatusNotModified {
-		if statusCode < 200 || statusCode >= 300 {
-			errorMessage := &errorMessage{}
-
-			err = json.NewDecoder(res.Body).Decode(errorMessage)
-			if err != nil {
-				err = fmt.Errorf("status: %v", statusCode)
-			} else {
-				err = fmt.Errorf("%s (status: %v)", errorMessage.Message, statusCode)
-			}
-		} else if resContent != nil {
-			err = json.NewDecoder(res.Body).Decode(resContent)
-			if err == io.EOF { // empty response body
-				err = nil
-			}
+		c.logger.Debugf("Failed to send a request: %s %s, err=%v, id=%s",
+			req.Method, redactURL(req.URL), err, requestID)
+		if metricCollector != nil {
+			metricCollector.IncrCounter([]string{"totalRequestFail"}, 1)
 		}
+	} else {
+		c.logger.Debugf("Received a response: %s %s, status=%v, elapsed=%v, id=%s",
+			req.Method, redactURL(req.URL), statusCode, time.Since(startAt), requestID)
 	}
+	return
+}
 
-	// report metric
-	if c.metricCollector != nil {
-		c.metricCollector.MeasureSinceWithLabels([]string{"parseDuration"}, startAt, metricLabels)
+func decodeErrorResponse(body io.Reader, statusCode int, requestID string) error {
+	errorMessage := &errorMessage{}
+	if err := json.NewDecoder(body).Decode(errorMessage); err != nil {
+		errorMessage = nil
 	}
+	apiError := newAPIError(statusCode, errorMessage)
+	apiError.RequestID = requestID
+	return apiError
+}
 
-	// never forget to drain up and close before returning
-	drainupAndCloseResponseBody(res.Body)
+// cancelOnCloseBody cancels the context of the request when the response body is closed.
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
 
-	return
+func (b *cancelOnCloseBody) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
 }
 
 // CreateProject creates a project.
@@ -361,6 +730,13 @@ func (c *Client) CreateProject(ctx context.Context, name string) (pro *Project,
 	return c.project.create(ctx, name)
 }
 
+// CreateProjectWithOptions creates a project and then adds the members and tokens specified in the options.
+// If any of them fails to be added, the project is removed and purged so that the provisioning can be retried.
+func (c *Client) CreateProjectWithOptions(ctx context.Context, name string, opts *ProjectOptions) (pro *Project,
+	httpStatusCode int, err error) {
+	return c.project.createWithOptions(ctx, name, opts)
+}
+
 // RemoveProject removes a project. A removed project can be unremoved using UnremoveProject.
 func (c *Client) RemoveProject(ctx context.Context, name string) (httpStatusCode int, err error) {
 	return c.project.remove(ctx, name)
@@ -386,12 +762,35 @@ func (c *Client) ListRemovedProjects(ctx context.Context) (removedPros []*Projec
 	return c.project.listRemoved(ctx)
 }
 
+// ListProjectsIterator returns a ProjectIterator over the projects with the status specified in the options.
+// The projects are fetched page by page so that thousands of projects are not loaded at once. For example:
+//
+//	it := client.ListProjectsIterator(ctx, &centraldogma.ProjectListOptions{NamePrefix: "team-"})
+//	for it.Next() {
+//	    project := it.Project()
+//	    ...
+//	}
+//	if err := it.Err(); err != nil {
+//	    ...
+//	}
+func (c *Client) ListProjectsIterator(ctx context.Context, opts *ProjectListOptions) *ProjectIterator {
+	return c.project.listIterator(ctx, opts)
+}
+
 // CreateRepository creates a repository.
 func (c *Client) CreateRepository(
 	ctx context.Context, projectName, repoName string) (repo *Repository, httpStatusCode int, err error) {
 	return c.repository.create(ctx, projectName, repoName)
 }
 
+// CreateRepositoryWithOptions creates a repository and then pushes the initial files specified in the options
+// on top of its initial commit. If the files fail to be pushed, the repository is removed and purged so that
+// the creation can be retried.
+func (c *Client) CreateRepositoryWithOptions(ctx context.Context, projectName, repoName string,
+	opts *RepositoryOptions) (repo *Repository, httpStatusCode int, err error) {
+	return c.repository.createWithOptions(ctx, projectName, repoName, opts)
+}
+
 // RemoveRepository removes a repository. A removed repository can be unremoved using UnremoveRepository.
 func (c *Client) RemoveRepository(ctx context.Context, projectName, repoName string) (httpStatusCode int, err error) {
 	return c.repository.remove(ctx, projectName, repoName)
@@ -427,6 +826,13 @@ func (c *Client) NormalizeRevision(
 	return c.repository.normalizeRevision(ctx, projectName, repoName, revision)
 }
 
+// NormalizeRevisions converts the relative revision numbers to the absolute revision numbers with a single
+// request which retrieves the latest revision. An error is returned if any of the revisions does not exist.
+func (c *Client) NormalizeRevisions(ctx context.Context, projectName, repoName string,
+	revisions ...string) (normalizedRevs []int64, httpStatusCode int, err error) {
+	return c.repository.normalizeRevisions(ctx, projectName, repoName, revisions)
+}
+
 // ListFiles returns the list of files that match the given path pattern. A path pattern is a variant of glob:
 //
 //     - "/**": find all files recursively
@@ -440,6 +846,86 @@ func (c *Client) ListFiles(ctx context.Context,
 	return c.content.listFiles(ctx, projectName, repoName, revision, pathPattern)
 }
 
+// ListFilesByType returns the list of files that match the given path pattern and one of the entryTypes.
+// If no entryTypes are specified, all entries are returned. Note that the filtering is done on the client side.
+func (c *Client) ListFilesByType(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	entryTypes ...EntryType) (entries []*Entry, httpStatusCode int, err error) {
+	entries, httpStatusCode, err = c.content.listFiles(ctx, projectName, repoName, revision, pathPattern)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return filterEntries(entries, entryTypes), httpStatusCode, nil
+}
+
+// ListFilesIterator returns an EntryIterator over the files that match the given path pattern and one of
+// the entryTypes. The entries are decoded from the response one at a time, so a repository which has tens of
+// thousands of entries can be listed without holding all of them in memory. The EntryIterator must be closed
+// after use. For example:
+//
+//	it, _, err := client.ListFilesIterator(ctx, "foo", "bar", "-1", "/**", centraldogma.JSON)
+//	if err != nil {
+//	    ...
+//	}
+//	defer it.Close()
+//	for it.Next() {
+//	    entry := it.Entry()
+//	    ...
+//	}
+//	if err := it.Err(); err != nil {
+//	    ...
+//	}
+func (c *Client) ListFilesIterator(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	entryTypes ...EntryType) (it *EntryIterator, httpStatusCode int, err error) {
+	return c.content.listFilesIterator(ctx, projectName, repoName, revision, pathPattern, entryTypes)
+}
+
+// GetFilesIterator returns an EntryIterator which decodes the files matched by the pathPattern one at a time
+// instead of loading all of them into memory. The iterator must be closed after use.
+func (c *Client) GetFilesIterator(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	entryTypes ...EntryType) (it *EntryIterator, httpStatusCode int, err error) {
+	return c.content.getFilesIterator(ctx, projectName, repoName, revision, pathPattern, entryTypes)
+}
+
+// OpenFile returns the content of the file at the specified revision and path as an io.ReadCloser which reads
+// the content while the response is being received, so that a large file is not loaded into memory at once.
+// The content of a text file is returned unescaped and that of a JSON file is returned as JSON. The returned
+// io.ReadCloser must be closed after use. For example:
+//
+//	content, _, err := client.OpenFile(ctx, "foo", "bar", "-1", "/large.txt")
+//	if err != nil {
+//	    ...
+//	}
+//	defer content.Close()
+//	_, err = io.Copy(dst, content)
+func (c *Client) OpenFile(ctx context.Context, projectName, repoName, revision, path string) (content io.ReadCloser,
+	httpStatusCode int, err error) {
+	return c.content.openFile(ctx, projectName, repoName, revision, path)
+}
+
+// GetRawFile returns the exact bytes of the file at the specified revision and path. The raw content is
+// requested from the server so that the content is not re-encoded in a JSON envelope. If the server does not
+// support the raw content, the content is extracted from the entry as OpenFile does.
+func (c *Client) GetRawFile(ctx context.Context, projectName, repoName, revision, path string) (content []byte,
+	httpStatusCode int, err error) {
+	var buf bytes.Buffer
+	_, httpStatusCode, err = c.content.downloadTo(ctx, projectName, repoName, revision, path, &buf)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return buf.Bytes(), httpStatusCode, nil
+}
+
+// DownloadTo writes the raw content of the file at the specified revision and path to the w while the response
+// is being received, and returns the number of the written bytes. For example:
+//
+//	f, err := os.Create("large.txt")
+//	...
+//	_, _, err = client.DownloadTo(ctx, "foo", "bar", "-1", "/large.txt", f)
+func (c *Client) DownloadTo(ctx context.Context, projectName, repoName, revision, path string,
+	w io.Writer) (written int64, httpStatusCode int, err error) {
+	return c.content.downloadTo(ctx, projectName, repoName, revision, path, w)
+}
+
 // GetFile returns the file at the specified revision and path with the specified Query.
 func (c *Client) GetFile(
 	ctx context.Context, projectName, repoName, revision string, query *Query) (entry *Entry,
@@ -447,6 +933,26 @@ func (c *Client) GetFile(
 	return c.content.getFile(ctx, projectName, repoName, revision, query)
 }
 
+// GetBinaryFile returns the binary data of the file at the path which was pushed using NewUpsertBinary.
+func (c *Client) GetBinaryFile(ctx context.Context,
+	projectName, repoName, revision, path string) (data []byte, httpStatusCode int, err error) {
+	return c.content.getBinaryFile(ctx, projectName, repoName, revision, path)
+}
+
+// GetMergedEntry returns the merged entry of the JSON files specified in the MergeQuery. The files are merged
+// in the order of the MergeSources at the specified revision. For example:
+//
+//	mergeQuery := &MergeQuery{
+//	    MergeSources: []*MergeSource{{Path: "/a.json"}, {Path: "/b.json", Optional: true}},
+//	    Type:         Identity,
+//	}
+//	mergedEntry, _, err := client.GetMergedEntry(ctx, "foo", "bar", "-1", mergeQuery)
+func (c *Client) GetMergedEntry(ctx context.Context,
+	projectName, repoName, revision string, mergeQuery *MergeQuery) (mergedEntry *MergedEntry,
+	httpStatusCode int, err error) {
+	return c.content.getMergedEntry(ctx, projectName, repoName, revision, mergeQuery)
+}
+
 // GetFiles returns the files that match the given path pattern. A path pattern is a variant of glob:
 //
 //     - "/**": find all files recursively
@@ -460,6 +966,36 @@ func (c *Client) GetFiles(ctx context.Context,
 	return c.content.getFiles(ctx, projectName, repoName, revision, pathPattern)
 }
 
+// GetFilesWithJSONPaths returns the files that match the given path pattern at the revision as GetFiles does,
+// except that the server applies the JSON path expressions to the content of every matched JSON entry, so that
+// only the projected contents are returned. The contents of the other entries are returned as they are.
+// For example:
+//
+//	// returns the "timeout" field of every JSON file under /services
+//	entries, _, err := client.GetFilesWithJSONPaths(ctx, "foo", "bar", "-1", "/services/*.json", "$.timeout")
+func (c *Client) GetFilesWithJSONPaths(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	jsonPaths ...string) (entries []*Entry, httpStatusCode int, err error) {
+	if len(jsonPaths) == 0 {
+		return nil, UnknownHttpStatusCode, errors.New("jsonPaths should not be empty")
+	}
+	return c.content.getFilesWithJSONPaths(ctx, projectName, repoName, revision, pathPattern, jsonPaths)
+}
+
+// ExportRepository writes the files that match the given path pattern at the revision to the dst and returns
+// the number of the exported files. The files are written in the order of their paths so that the same
+// snapshot is created for the same revision. The dst is not closed by this method. For example:
+//
+//	f, err := os.Create("snapshot.tar")
+//	...
+//	dst := centraldogma.NewTarExportTarget(f)
+//	_, _, err = client.ExportRepository(ctx, "foo", "bar", "-1", "/**", dst)
+//	...
+//	err = dst.Close()
+func (c *Client) ExportRepository(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	dst ExportTarget) (exported int, httpStatusCode int, err error) {
+	return c.content.exportRepository(ctx, projectName, repoName, revision, pathPattern, dst)
+}
+
 // GetHistory returns the history of the files that match the given path pattern. A path pattern is
 // a variant of glob:
 //
@@ -476,6 +1012,51 @@ func (c *Client) GetHistory(ctx context.Context,
 	return c.content.getHistory(ctx, projectName, repoName, from, to, pathPattern, maxCommits)
 }
 
+// GetCommit returns the commit at the revision along with the changes it made, which are retrieved by comparing
+// the revision with the previous one.
+func (c *Client) GetCommit(ctx context.Context, projectName, repoName, revision string) (commit *CommitDetail,
+	httpStatusCode int, err error) {
+	return c.content.getCommit(ctx, projectName, repoName, revision)
+}
+
+// LastModified returns the latest commit which modified the file at or before the revision, e.g. to show who
+// changed the file last. If the revision is not specified, the latest revision is used. If no commit modified
+// the file, the error is an *APIError which corresponds to ErrEntryNotFound.
+func (c *Client) LastModified(ctx context.Context, projectName, repoName, path, atRevision string) (commit *Commit,
+	httpStatusCode int, err error) {
+	return c.content.lastModified(ctx, projectName, repoName, path, atRevision)
+}
+
+// GetHistoryWithOptions returns the history of a repository which is specified in the options. Unlike GetHistory,
+// the commits can be filtered by the time when they were pushed. For example:
+//
+//	commits, _, err := client.GetHistoryWithOptions(ctx, "foo", "bar", &centraldogma.HistoryOptions{
+//	    PathPattern: "/a.json",
+//	    Since:       time.Now().Add(-24 * time.Hour),
+//	})
+func (c *Client) GetHistoryWithOptions(ctx context.Context, projectName, repoName string,
+	opts *HistoryOptions) (commits []*Commit, httpStatusCode int, err error) {
+	return c.content.getHistoryWithOptions(ctx, projectName, repoName, opts)
+}
+
+// GetHistoryIterator returns a HistoryIterator which iterates over the history of the files that match
+// the given path pattern from the from revision to the to revision. The commits are fetched in batches
+// as the iteration proceeds. If the from and to are not specified, this will iterate from the latest to
+// the init revision. For example:
+//
+//	it := client.GetHistoryIterator(ctx, "foo", "bar", "", "", "/**")
+//	for it.Next() {
+//	    commit := it.Commit()
+//	    ...
+//	}
+//	if err := it.Err(); err != nil {
+//	    ...
+//	}
+func (c *Client) GetHistoryIterator(ctx context.Context,
+	projectName, repoName, from, to, pathPattern string) *HistoryIterator {
+	return c.content.historyIterator(ctx, projectName, repoName, from, to, pathPattern)
+}
+
 // GetDiff returns the diff of a file between two revisions. If the from and to are not specified, this will
 // return the diff from the init to the latest revision.
 func (c *Client) GetDiff(ctx context.Context,
@@ -498,12 +1079,94 @@ func (c *Client) GetDiffs(ctx context.Context,
 	return c.content.getDiffs(ctx, projectName, repoName, from, to, pathPattern)
 }
 
+// PreviewDiffs returns the diffs which would be applied if the specified changes were pushed on top of
+// the baseRevision. Nothing is committed to the repository.
+func (c *Client) PreviewDiffs(ctx context.Context, projectName, repoName, baseRevision string,
+	changes []*Change) (diffs []*Change, httpStatusCode int, err error) {
+	return c.content.previewDiffs(ctx, projectName, repoName, baseRevision, changes)
+}
+
+// DiffAsJSONPatch fetches the latest content of the JSON file at the path and returns an ApplyJSONPatch
+// Change which transforms the content into the newValue. ErrRedundantChange is returned if the content is
+// already equal to the newValue. For example:
+//
+//	change, _, err := client.DiffAsJSONPatch(ctx, "foo", "bar", "/a.json", myConfig)
+//	if err == centraldogma.ErrRedundantChange {
+//	    return // nothing to push
+//	}
+func (c *Client) DiffAsJSONPatch(ctx context.Context, projectName, repoName, path string,
+	newValue interface{}) (change *Change, httpStatusCode int, err error) {
+	return c.content.diffAsJSONPatch(ctx, projectName, repoName, path, newValue)
+}
+
 // Push pushes the specified changes to the repository.
 func (c *Client) Push(ctx context.Context, projectName, repoName, baseRevision string,
 	commitMessage *CommitMessage, changes []*Change) (result *PushResult, httpStatusCode int, err error) {
 	return c.content.push(ctx, projectName, repoName, baseRevision, commitMessage, changes)
 }
 
+// PushWithOptions pushes the changes to the repository as specified in the options. If the options specify
+// a dry run, the changes are validated without being committed: the result has the revision which the commit
+// would have and the diffs which would be applied, and ErrRedundantChange is returned if the changes would
+// change nothing. Otherwise, the result has only the PushResult of the commit.
+func (c *Client) PushWithOptions(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, changes []*Change, opts *PushOptions) (result *PushResultDetail,
+	httpStatusCode int, err error) {
+	return c.content.pushWithOptions(ctx, projectName, repoName, baseRevision, commitMessage, changes, opts)
+}
+
+// PushDirectory pushes the files in the localDir to the remotePrefix of the repository as a single commit.
+// The files whose names end with ".json" are pushed as JSON files and the others are pushed as text files.
+// The files whose content is the same as the remote files are skipped and ErrRedundantChange is returned if
+// there is nothing to push. For example:
+//
+//	result, _, err := client.PushDirectory(ctx, "foo", "bar", "-1", commitMessage, "./configs", "/configs",
+//	    centraldogma.WithExcludePatterns("*.bak"), centraldogma.WithRemoveMissing())
+func (c *Client) PushDirectory(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, localDir, remotePrefix string, opts ...PushDirectoryOption) (result *PushResult,
+	httpStatusCode int, err error) {
+	return c.content.pushDirectory(ctx, projectName, repoName, baseRevision, commitMessage, localDir,
+		remotePrefix, opts...)
+}
+
+// PushIfUnchanged pushes the specified changes to the repository only if the latest revision is still the
+// baseRevision, which must be an absolute revision. ErrChangeConflict is returned as the cause of the
+// APIError if the repository has been changed since the baseRevision.
+func (c *Client) PushIfUnchanged(ctx context.Context, projectName, repoName string, baseRevision Revision,
+	commitMessage *CommitMessage, changes []*Change) (result *PushResult, httpStatusCode int, err error) {
+	return c.content.pushIfUnchanged(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+}
+
+// PushCAS pushes the changes returned by the transform on top of the latest revision. If the repository is
+// changed by others before the changes are pushed, the transform is invoked again with the new latest
+// revision and the push is retried up to maxRetries times. For example:
+//
+//	result, _, err := client.PushCAS(ctx, "foo", "bar", commitMessage,
+//	    func(ctx context.Context, baseRevision centraldogma.Revision) ([]*centraldogma.Change, error) {
+//	        entry, _, err := client.GetFile(ctx, "foo", "bar", baseRevision.String(), query)
+//	        if err != nil {
+//	            return nil, err
+//	        }
+//	        ... // build the changes from the entry
+//	    }, 3)
+func (c *Client) PushCAS(ctx context.Context, projectName, repoName string, commitMessage *CommitMessage,
+	transform PushTransform, maxRetries int) (result *PushResult, httpStatusCode int, err error) {
+	return c.content.pushCAS(ctx, projectName, repoName, commitMessage, transform, maxRetries)
+}
+
+// PushWithResultDetail pushes the specified changes to the repository and returns the author of the commit
+// and the changes which were actually applied by the server after normalization, e.g. an UpsertJSON change
+// is returned as an ApplyJSONPatch change.
+//
+// The server does not return the detail of a push, so it is retrieved with two more requests after the push,
+// i.e. the history and the diffs of the new revision, which are not atomic with the push. If either of them
+// fails, the result which holds the PushResult is returned with a *PushDetailError, and the push must not be
+// retried.
+func (c *Client) PushWithResultDetail(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, changes []*Change) (result *PushResultDetail, httpStatusCode int, err error) {
+	return c.content.pushWithResultDetail(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+}
+
 func (c *Client) watchWithWatcher(w *Watcher) (result <-chan WatchResult, closer func()) {
 	// setup watching channel
 	ch := make(chan WatchResult, DefaultChannelBuffer)
@@ -616,6 +1279,71 @@ func (c *Client) WatchRepository(
 	return
 }
 
+// WatchPaths watches on the changes of the entries that match any of the patterns with a single watch request
+// instead of one per pattern. The Changes of each WatchResult hold the changes of the matched entries since
+// the previously notified revision. The first WatchResult holds the changes since the initial revision of
+// the repository. Usage:
+//
+//	patterns := []string{"/config/*.json", "/feature-flags/**"}
+//	changes, closer, err := client.WatchPaths(ctx, "foo", "bar", patterns, 2 * time.Minute)
+//	if err != nil {
+//	    panic(err)
+//	}
+//	defer closer() // stop watching and release underlying resources.
+//
+//	for change := range changes {
+//	    for _, c := range change.Changes {
+//	        // c.Path is changed at change.Revision
+//	        ...
+//	    }
+//	}
+func (c *Client) WatchPaths(
+	ctx context.Context,
+	projectName, repoName string, patterns []string,
+	timeout time.Duration,
+) (result <-chan WatchResult, closer func(), err error) {
+
+	var w *Watcher
+
+	// initialize watcher
+	w, err = c.watch.pathsWatcherWithTimeout(ctx, projectName, repoName, patterns, timeout)
+	if err != nil {
+		return
+	}
+
+	result, closer = c.watchWithWatcher(w)
+	return
+}
+
+// WatchRepositoryOnce sends a single long-polling watch request and returns the new revision of the repository
+// when any file that matches the pathPattern is changed after the lastKnownRevision. If nothing is changed
+// during the timeout, the lastKnownRevision is returned with http.StatusNotModified. For example:
+//
+//	revision := int64(1)
+//	for {
+//	    newRevision, _, err := client.WatchRepositoryOnce(ctx, "foo", "bar", "/**", revision, time.Minute)
+//	    if err != nil {
+//	        ...
+//	    }
+//	    if newRevision != revision {
+//	        // invalidate the cache
+//	        revision = newRevision
+//	    }
+//	}
+func (c *Client) WatchRepositoryOnce(ctx context.Context,
+	projectName, repoName, pathPattern string, lastKnownRevision int64,
+	timeout time.Duration) (revision int64, httpStatusCode int, err error) {
+	result := c.watch.watchRepo(ctx, projectName, repoName, strconv.FormatInt(lastKnownRevision, 10),
+		pathPattern, timeout)
+	if result.Err != nil {
+		return lastKnownRevision, result.HttpStatusCode, result.Err
+	}
+	if result.HttpStatusCode == http.StatusNotModified {
+		return lastKnownRevision, result.HttpStatusCode, nil
+	}
+	return result.Revision, result.HttpStatusCode, nil
+}
+
 // FileWatcher returns a Watcher which notifies its listeners when the result of the given Query becomes
 // available or changes. For example:
 //
@@ -627,8 +1355,11 @@ func (c *Client) WatchRepository(
 //        myCh <- value
 //    })
 //    myValue := <-myCh
-func (c *Client) FileWatcher(projectName, repoName string, query *Query) (*Watcher, error) {
-	fw, err := c.watch.fileWatcher(context.Background(), projectName, repoName, query)
+//
+// The opts such as WithNotifyEntryNotFound configure the watcher.
+func (c *Client) FileWatcher(projectName, repoName string, query *Query,
+	opts ...WatcherOption) (*Watcher, error) {
+	fw, err := c.watch.fileWatcher(context.Background(), projectName, repoName, query, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -655,6 +1386,150 @@ func (c *Client) RepoWatcher(projectName, repoName, pathPattern string) (*Watche
 	return rw, nil
 }
 
+// CreateToken creates an application token. The secret of the token is only available in the returned Token.
+// Only an administrator can create an admin token.
+func (c *Client) CreateToken(ctx context.Context, appID string, isAdmin bool) (token *Token,
+	httpStatusCode int, err error) {
+	return c.token.create(ctx, appID, isAdmin)
+}
+
+// ListTokens returns the list of application tokens.
+func (c *Client) ListTokens(ctx context.Context) (tokens []*Token, httpStatusCode int, err error) {
+	return c.token.list(ctx)
+}
+
+// ActivateToken activates a deactivated application token.
+func (c *Client) ActivateToken(ctx context.Context, appID string) (token *Token, httpStatusCode int, err error) {
+	return c.token.activate(ctx, appID)
+}
+
+// DeactivateToken deactivates an application token. A deactivated token can be activated using ActivateToken.
+func (c *Client) DeactivateToken(ctx context.Context, appID string) (token *Token, httpStatusCode int, err error) {
+	return c.token.deactivate(ctx, appID)
+}
+
+// DeleteToken deletes an application token.
+func (c *Client) DeleteToken(ctx context.Context, appID string) (httpStatusCode int, err error) {
+	return c.token.remove(ctx, appID)
+}
+
+// GetProjectMetadata returns the metadata of a project which contains its members, tokens and the permissions
+// of its repositories.
+func (c *Client) GetProjectMetadata(ctx context.Context, projectName string) (projectMetadata *ProjectMetadata,
+	httpStatusCode int, err error) {
+	return c.metadata.get(ctx, projectName)
+}
+
+// AddMember adds a member to a project with the specified role.
+func (c *Client) AddMember(ctx context.Context, projectName, login string, role ProjectRole) (httpStatusCode int,
+	err error) {
+	return c.metadata.addMember(ctx, projectName, login, role)
+}
+
+// UpdateMemberRole updates the role of a member of a project.
+func (c *Client) UpdateMemberRole(ctx context.Context, projectName, login string, role ProjectRole) (
+	httpStatusCode int, err error) {
+	return c.metadata.updateMemberRole(ctx, projectName, login, role)
+}
+
+// RemoveMember removes a member from a project.
+func (c *Client) RemoveMember(ctx context.Context, projectName, login string) (httpStatusCode int, err error) {
+	return c.metadata.removeMember(ctx, projectName, login)
+}
+
+// AddTokenToProject registers an application token to a project with the specified role.
+func (c *Client) AddTokenToProject(ctx context.Context, projectName, appID string, role ProjectRole) (
+	httpStatusCode int, err error) {
+	return c.metadata.addToken(ctx, projectName, appID, role)
+}
+
+// RemoveTokenFromProject unregisters an application token from a project.
+func (c *Client) RemoveTokenFromProject(ctx context.Context, projectName, appID string) (httpStatusCode int,
+	err error) {
+	return c.metadata.removeToken(ctx, projectName, appID)
+}
+
+// SetRepoRolePermissions replaces the permissions of a repository for each ProjectRole.
+func (c *Client) SetRepoRolePermissions(ctx context.Context, projectName, repoName string,
+	perRolePermissions *PerRolePermissions) (httpStatusCode int, err error) {
+	return c.metadata.setRolePermissions(ctx, projectName, repoName, perRolePermissions)
+}
+
+// SetRepoUserPermissions sets the permissions of a repository for a member. The existing permissions of
+// the member are replaced.
+func (c *Client) SetRepoUserPermissions(ctx context.Context, projectName, repoName, login string,
+	permissions []Permission) (httpStatusCode int, err error) {
+	return c.metadata.setUserPermissions(ctx, projectName, repoName, login, permissions)
+}
+
+// SetRepoTokenPermissions sets the permissions of a repository for an application token. The existing
+// permissions of the token are replaced.
+func (c *Client) SetRepoTokenPermissions(ctx context.Context, projectName, repoName, appID string,
+	permissions []Permission) (httpStatusCode int, err error) {
+	return c.metadata.setTokenPermissions(ctx, projectName, repoName, appID, permissions)
+}
+
+// ListMirrors returns the list of the mirroring configurations of a project.
+func (c *Client) ListMirrors(ctx context.Context, projectName string) (mirrors []*Mirror, httpStatusCode int,
+	err error) {
+	return c.mirror.listMirrors(ctx, projectName)
+}
+
+// GetMirror returns the mirroring configuration of the specified ID.
+func (c *Client) GetMirror(ctx context.Context, projectName, id string) (mirror *Mirror, httpStatusCode int,
+	err error) {
+	return c.mirror.getMirror(ctx, projectName, id)
+}
+
+// CreateMirror creates a mirroring configuration.
+func (c *Client) CreateMirror(ctx context.Context, projectName string, mirror *Mirror) (httpStatusCode int,
+	err error) {
+	return c.mirror.createMirror(ctx, projectName, mirror)
+}
+
+// UpdateMirror updates the mirroring configuration which has the same ID as the specified mirror.
+func (c *Client) UpdateMirror(ctx context.Context, projectName string, mirror *Mirror) (httpStatusCode int,
+	err error) {
+	return c.mirror.updateMirror(ctx, projectName, mirror)
+}
+
+// RemoveMirror removes the mirroring configuration of the specified ID.
+func (c *Client) RemoveMirror(ctx context.Context, projectName, id string) (httpStatusCode int, err error) {
+	return c.mirror.removeMirror(ctx, projectName, id)
+}
+
+// ListCredentials returns the list of the credentials of a project which are used for mirroring.
+func (c *Client) ListCredentials(ctx context.Context, projectName string) (credentials []*Credential,
+	httpStatusCode int, err error) {
+	return c.mirror.listCredentials(ctx, projectName)
+}
+
+// CreateCredential creates a credential which is used for mirroring.
+func (c *Client) CreateCredential(ctx context.Context, projectName string, credential *Credential) (
+	httpStatusCode int, err error) {
+	return c.mirror.createCredential(ctx, projectName, credential)
+}
+
+// UpdateCredential updates the credential which has the same ID as the specified credential.
+func (c *Client) UpdateCredential(ctx context.Context, projectName string, credential *Credential) (
+	httpStatusCode int, err error) {
+	return c.mirror.updateCredential(ctx, projectName, credential)
+}
+
+// RemoveCredential removes the credential of the specified ID.
+func (c *Client) RemoveCredential(ctx context.Context, projectName, id string) (httpStatusCode int, err error) {
+	return c.mirror.removeCredential(ctx, projectName, id)
+}
+
+// Close stops the background tasks of the client, i.e. the health checks of the endpoints specified by
+// WithEndpoints, and waits for them to return. The watchers created by the client are not closed. The client
+// can still send requests after Close, but an endpoint which becomes unhealthy is no longer checked.
+func (c *Client) Close() {
+	if c.endpoints != nil {
+		c.endpoints.close()
+	}
+}
+
 // SetMetricCollector sets metric collector for the client.
 // For example, with Prometheus:
 //     config := centraldogma.DefaultMetricCollectorConfig("client_name")
@@ -669,5 +1544,10 @@ func (c *Client) RepoWatcher(projectName, repoName, pathPattern string) (*Watche
 //     }
 //     client.SetMetricCollector(metricCollector)
 func (c *Client) SetMetricCollector(m *metrics.Metrics) {
-	c.metricCollector = m
+	c.metricCollector.Store(m)
+}
+
+func (c *Client) loadMetricCollector() *metrics.Metrics {
+	m, _ := c.metricCollector.Load().(*metrics.Metrics)
+	return m
 }
@@ -0,0 +1,353 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const defaultHealthCheckInterval = 10 * time.Second
+
+// endpointGroup selects the endpoint of the requests among the replicas. All requests are sent to the current
+// endpoint until it becomes unhealthy so that the watch requests stick to the same replica. An unhealthy
+// endpoint is health-checked periodically until it becomes healthy again or the group is closed.
+type endpointGroup struct {
+	endpoints           []*endpoint
+	current             int32 // index of the current endpoint
+	healthCheckInterval time.Duration
+	hedgeDelay          time.Duration // the delay of the hedged requests. 0 if disabled.
+
+	client *http.Client
+	logger Logger
+
+	ctx    context.Context // done when the group is closed
+	cancel context.CancelFunc
+	lock   sync.Mutex // guards closed so that no health check starts after close
+	closed bool
+	checks sync.WaitGroup // the running health checks
+}
+
+type endpoint struct {
+	url       *url.URL
+	unhealthy int32 // 1 if unhealthy
+}
+
+func newEndpointGroup(baseURLs []*url.URL, healthCheckInterval time.Duration,
+	client *http.Client, logger Logger) *endpointGroup {
+	if healthCheckInterval <= 0 {
+		healthCheckInterval = defaultHealthCheckInterval
+	}
+	endpoints := make([]*endpoint, len(baseURLs))
+	for i, baseURL := range baseURLs {
+		endpoints[i] = &endpoint{url: baseURL}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	return &endpointGroup{
+		endpoints:           endpoints,
+		healthCheckInterval: healthCheckInterval,
+		client:              client,
+		logger:              logger,
+		ctx:                 ctx,
+		cancel:              cancel,
+	}
+}
+
+// close stops the health checks and waits for them to return.
+func (g *endpointGroup) close() {
+	g.lock.Lock()
+	g.closed = true
+	g.lock.Unlock()
+
+	g.cancel()
+	g.checks.Wait()
+}
+
+func (e *endpoint) isHealthy() bool {
+	return atomic.LoadInt32(&e.unhealthy) == 0
+}
+
+// selectEndpoint returns the current endpoint if healthy. Otherwise, the next healthy endpoint becomes
+// the current one. The current endpoint is returned if all endpoints are unhealthy.
+func (g *endpointGroup) selectEndpoint() *endpoint {
+	current := atomic.LoadInt32(&g.current)
+	for i := 0; i < len(g.endpoints); i++ {
+		index := (int(current) + i) % len(g.endpoints)
+		if e := g.endpoints[index]; e.isHealthy() {
+			if i != 0 && atomic.CompareAndSwapInt32(&g.current, current, int32(index)) {
+				g.logger.Warnf("Failed over to the endpoint: %s", e.url)
+			}
+			return e
+		}
+	}
+	return g.endpoints[current]
+}
+
+// nextHealthyEndpoint returns the healthy endpoint next to the e, or nil if there is no other healthy endpoint.
+func (g *endpointGroup) nextHealthyEndpoint(e *endpoint) *endpoint {
+	index := 0
+	for i, candidate := range g.endpoints {
+		if candidate == e {
+			index = i
+			break
+		}
+	}
+	for i := 1; i < len(g.endpoints); i++ {
+		if next := g.endpoints[(index+i)%len(g.endpoints)]; next.isHealthy() {
+			return next
+		}
+	}
+	return nil
+}
+
+func (g *endpointGroup) markUnhealthy(e *endpoint) {
+	if atomic.CompareAndSwapInt32(&e.unhealthy, 0, 1) {
+		g.logger.Warnf("Marked the endpoint as unhealthy: %s", e.url)
+		g.lock.Lock()
+		defer g.lock.Unlock()
+		if !g.closed {
+			g.checks.Add(1)
+			go g.checkUntilHealthy(e)
+		}
+	}
+}
+
+func (g *endpointGroup) checkUntilHealthy(e *endpoint) {
+	defer g.checks.Done()
+	timer := time.NewTimer(g.healthCheckInterval)
+	defer timer.Stop()
+	for {
+		select {
+		case <-g.ctx.Done():
+			return
+		case <-timer.C:
+		}
+		if g.check(e) {
+			atomic.StoreInt32(&e.unhealthy, 0)
+			g.logger.Debugf("Marked the endpoint as healthy: %s", e.url)
+			return
+		}
+		timer.Reset(g.healthCheckInterval)
+	}
+}
+
+func (g *endpointGroup) check(e *endpoint) bool {
+	ctx, cancel := context.WithTimeout(g.ctx, g.healthCheckInterval)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodGet, e.url.String()+pathL7Check, nil)
+	if err != nil {
+		return false
+	}
+	res, err := g.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return false
+	}
+	drainupAndCloseResponseBody(res.Body)
+	return res.StatusCode == http.StatusOK
+}
+
+// rewrite returns the request whose URL is resolved against the endpoint instead of the baseURL.
+func (g *endpointGroup) rewrite(req *http.Request, baseURL *url.URL, e *endpoint) (*http.Request, error) {
+	if e.url == baseURL {
+		return req, nil
+	}
+	rawURL := req.URL.String()
+	if !strings.HasPrefix(rawURL, baseURL.String()) {
+		return req, nil // an absolute URL which is not for the Central Dogma server
+	}
+	u, err := url.Parse(e.url.String() + strings.TrimPrefix(rawURL, baseURL.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	rewritten := req.WithContext(req.Context())
+	rewritten.URL = u
+	rewritten.Host = u.Host
+	return rewritten, nil
+}
+
+// shouldFailOver returns whether the endpoint should be marked as unhealthy.
+func shouldFailOver(ctx context.Context, res *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil // not cancelled by the caller
+	}
+	return res.StatusCode >= http.StatusInternalServerError
+}
+
+// isRetriable returns whether the request can be sent again to another endpoint. A non-idempotent request
+// is retried only if it has not reached the server.
+func isRetriable(req *http.Request, res *http.Response, err error) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if req.Method == http.MethodGet || req.Method == http.MethodHead {
+		return true
+	}
+	if err != nil {
+		opError, ok := err.(*net.OpError)
+		if urlError, isURLError := err.(*url.Error); isURLError {
+			opError, ok = urlError.Err.(*net.OpError)
+		}
+		return ok && opError.Op == "dial"
+	}
+	return res.StatusCode == http.StatusServiceUnavailable
+}
+
+// isHedgeable returns whether the request can be sent to two endpoints at the same time.
+func isHedgeable(req *http.Request) bool {
+	return (req.Method == http.MethodGet || req.Method == http.MethodHead) &&
+		(req.Body == nil || req.Body == http.NoBody)
+}
+
+// do sends the request to the selected endpoint using the client and fails over to the other endpoints on
+// a connection error or a server error. A watch request is not failed over because it is sent again by the Watcher.
+// A non-watch GET request is hedged if enabled.
+func (g *endpointGroup) do(client *http.Client, req *http.Request, baseURL *url.URL,
+	watchRequest bool) (res *http.Response, attempts int, err error) {
+	if g.hedgeDelay > 0 && !watchRequest && isHedgeable(req) {
+		return g.doHedged(client, req, baseURL)
+	}
+	return g.doWithFailOver(client, req, baseURL, watchRequest)
+}
+
+func (g *endpointGroup) doWithFailOver(client *http.Client, req *http.Request, baseURL *url.URL,
+	watchRequest bool) (res *http.Response, attempts int, err error) {
+	for attempts = 1; ; attempts++ {
+		e := g.selectEndpoint()
+		rewritten, err := g.rewrite(req, baseURL, e)
+		if err != nil {
+			return nil, attempts - 1, err
+		}
+
+		res, err := client.Do(rewritten)
+		if !shouldFailOver(req.Context(), res, err) {
+			return res, attempts, err
+		}
+		g.markUnhealthy(e)
+		if watchRequest || attempts >= len(g.endpoints) || !isRetriable(req, res, err) {
+			return res, attempts, err
+		}
+
+		if res != nil {
+			drainupAndCloseResponseBody(res.Body)
+		}
+		if req.GetBody != nil {
+			var body io.ReadCloser
+			if body, err = req.GetBody(); err != nil {
+				return nil, attempts, err
+			}
+			req = req.WithContext(req.Context())
+			req.Body = body
+		}
+	}
+}
+
+type hedgedResult struct {
+	e      *endpoint
+	res    *http.Response
+	err    error
+	cancel context.CancelFunc
+}
+
+// doHedged sends the request to the selected endpoint and, if no response arrives within the hedge delay, sends
+// it to the next healthy endpoint as well. The first response which is not a failure is used and the other
+// request is cancelled. The second request is sent immediately if the first one fails before the delay, so
+// the request is failed over as well.
+func (g *endpointGroup) doHedged(client *http.Client, req *http.Request,
+	baseURL *url.URL) (res *http.Response, attempts int, err error) {
+	primary := g.selectEndpoint()
+	secondary := g.nextHealthyEndpoint(primary)
+	if secondary == nil {
+		return g.doWithFailOver(client, req, baseURL, false)
+	}
+
+	ctx := req.Context()
+	results := make(chan *hedgedResult, 2)
+	cancels := make(map[*endpoint]context.CancelFunc, 2)
+	send := func(e *endpoint) error {
+		rewritten, err := g.rewrite(req, baseURL, e)
+		if err != nil {
+			return err
+		}
+		attemptCtx, cancel := context.WithCancel(ctx)
+		cancels[e] = cancel
+		attempts++
+		go func() {
+			res, err := client.Do(rewritten.WithContext(attemptCtx))
+			results <- &hedgedResult{e: e, res: res, err: err, cancel: cancel}
+		}()
+		return nil
+	}
+	if err = send(primary); err != nil {
+		return nil, 0, err
+	}
+
+	timer := time.NewTimer(g.hedgeDelay)
+	defer timer.Stop()
+	pending := 1
+	for {
+		select {
+		case <-timer.C:
+			if attempts == 1 && send(secondary) == nil {
+				g.logger.Debugf("Hedged the request to the endpoint: %s", secondary.url)
+				pending++
+			}
+		case r := <-results:
+			pending--
+			failed := shouldFailOver(ctx, r.res, r.err)
+			if failed {
+				g.markUnhealthy(r.e)
+			}
+			if failed && attempts == 1 && send(secondary) == nil {
+				pending++
+			}
+			if failed && pending > 0 {
+				r.cancel()
+				if r.res != nil {
+					drainupAndCloseResponseBody(r.res.Body)
+				}
+				continue
+			}
+
+			// r is the result. Cancel the other request and discard its response.
+			for e, cancel := range cancels {
+				if e != r.e {
+					cancel()
+				}
+			}
+			if pending > 0 {
+				go func() {
+					if other := <-results; other.res != nil {
+						drainupAndCloseResponseBody(other.res.Body)
+					}
+				}()
+			}
+			if r.err != nil {
+				r.cancel()
+				return nil, attempts, r.err
+			}
+			r.res.Body = &cancelOnCloseBody{ReadCloser: r.res.Body, cancel: r.cancel}
+			return r.res, attempts, nil
+		}
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newReplica(t *testing.T, healthy *int32, hits *int32) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/monitor/l7check", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(healthy) == 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		if atomic.LoadInt32(healthy) == 0 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		if r.Method == http.MethodPost {
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"name":"foo"}`)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestWithEndpoints(t *testing.T) {
+	var healthy1, healthy2, hits1, hits2 int32 = 0, 1, 0, 0
+	replica1 := newReplica(t, &healthy1, &hits1)
+	defer replica1.Close()
+	replica2 := newReplica(t, &healthy2, &hits2)
+	defer replica2.Close()
+
+	c, err := NewClient(replica1.URL, WithTransport(http.DefaultTransport),
+		WithEndpoints(replica2.URL), WithHealthCheckInterval(10*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Fails over to the replica2.
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	// Sticks to the replica2.
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	if hits1, hits2 := atomic.LoadInt32(&hits1), atomic.LoadInt32(&hits2); hits1 != 1 || hits2 != 2 {
+		t.Errorf("hits: (%v, %v), want (1, 2)", hits1, hits2)
+	}
+
+	// The replica1 recovers, but the requests still stick to the replica2.
+	atomic.StoreInt32(&healthy1, 1)
+	for !c.endpoints.endpoints[0].isHealthy() {
+		time.Sleep(10 * time.Millisecond)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	if hits := atomic.LoadInt32(&hits2); hits != 3 {
+		t.Errorf("hits of the replica2: %v, want 3", hits)
+	}
+
+	// A non-idempotent request is not sent again once it reached the server.
+	atomic.StoreInt32(&healthy2, 0)
+	_, httpStatusCode, err := c.CreateProject(context.Background(), "foo")
+	if err == nil {
+		t.Errorf("CreateProject should fail")
+	}
+	testStatusCode(t, httpStatusCode, http.StatusInternalServerError)
+	if hits := atomic.LoadInt32(&hits1); hits != 1 {
+		t.Errorf("hits of the replica1: %v, want 1", hits)
+	}
+}
+
+func TestClientClose_StopsHealthChecks(t *testing.T) {
+	var checks int32
+	checking := make(chan struct{}, 1)
+	cancelled := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/monitor/l7check", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&checks, 1)
+		select {
+		case checking <- struct{}{}:
+		default:
+		}
+		<-r.Context().Done()
+		close(cancelled)
+	})
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	replica1 := httptest.NewServer(mux)
+	defer replica1.Close()
+	var healthy2, hits2 int32 = 1, 0
+	replica2 := newReplica(t, &healthy2, &hits2)
+	defer replica2.Close()
+
+	c, err := NewClient(replica1.URL, WithTransport(http.DefaultTransport),
+		WithEndpoints(replica2.URL), WithHealthCheckInterval(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.endpoints.healthCheckInterval = 10 * time.Millisecond
+	c.endpoints.markUnhealthy(c.endpoints.endpoints[0])
+	<-checking
+
+	done := make(chan struct{})
+	go func() {
+		c.Close()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Close did not return")
+	}
+	select {
+	case <-cancelled:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the health check was not cancelled")
+	}
+
+	// No health check starts after Close.
+	atomic.StoreInt32(&c.endpoints.endpoints[0].unhealthy, 0)
+	c.endpoints.markUnhealthy(c.endpoints.endpoints[0])
+	time.Sleep(50 * time.Millisecond)
+	if checks := atomic.LoadInt32(&checks); checks != 1 {
+		t.Errorf("checks: %v, want 1", checks)
+	}
+}
+
+func TestWithHedging(t *testing.T) {
+	newServer := func(name string, delay time.Duration, hits *int32) *httptest.Server {
+		mux := http.NewServeMux()
+		mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(hits, 1)
+			_, _ = ioutil.ReadAll(r.Body) // so that the cancellation of the client is noticed
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+			if r.Method == http.MethodPost {
+				w.WriteHeader(http.StatusCreated)
+			}
+			fmt.Fprintf(w, `[{"name":%q}]`, name)
+		})
+		return httptest.NewServer(mux)
+	}
+	var hits1, hits2 int32
+	slow := newServer("slow", 3*time.Second, &hits1)
+	defer slow.Close()
+	fast := newServer("fast", 0, &hits2)
+	defer fast.Close()
+
+	c, err := NewClient(slow.URL, WithTransport(http.DefaultTransport), WithEndpoints(fast.URL),
+		WithHedging(50*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+
+	// The hedged request to the fast replica wins.
+	start := time.Now()
+	projects, _, err := c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "fast", "project")
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("ListProjects took %v, want the hedged response", elapsed)
+	}
+	if hits1, hits2 := atomic.LoadInt32(&hits1), atomic.LoadInt32(&hits2); hits1 != 1 || hits2 != 1 {
+		t.Errorf("hits: (%v, %v), want (1, 1)", hits1, hits2)
+	}
+	// The slow replica is not marked as unhealthy because it did not fail.
+	if !c.endpoints.endpoints[0].isHealthy() {
+		t.Error("the slow replica is marked as unhealthy")
+	}
+
+	// A request which is not idempotent is not hedged.
+	atomic.StoreInt32(&hits1, 0)
+	atomic.StoreInt32(&hits2, 0)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	_, _, _ = c.CreateProject(ctx, "foo")
+	if hits2 := atomic.LoadInt32(&hits2); hits2 != 0 {
+		t.Errorf("hits of the fast replica: %v, want 0", hits2)
+	}
+}
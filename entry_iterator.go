@@ -0,0 +1,114 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// EntryIterator iterates over the entries decoded from a JSON array one at a time.
+type EntryIterator struct {
+	body       io.ReadCloser
+	dec        *json.Decoder
+	entryTypes []EntryType
+
+	started bool
+	entry   *Entry
+	err     error
+}
+
+func newEntryIterator(body io.ReadCloser, entryTypes []EntryType) *EntryIterator {
+	return &EntryIterator{body: body, dec: json.NewDecoder(body), entryTypes: entryTypes}
+}
+
+// Next advances the iterator to the next entry. It returns false when there are no more entries or
+// an error occurred. Err should be checked after Next returns false.
+func (it *EntryIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	if !it.started {
+		it.started = true
+		token, err := it.dec.Token()
+		if err == io.EOF { // empty response body
+			return false
+		}
+		if err != nil {
+			it.err = err
+			return false
+		}
+		if delim, ok := token.(json.Delim); !ok || delim != '[' {
+			it.err = fmt.Errorf("unexpected token: %v, want [", token)
+			return false
+		}
+	}
+
+	for it.dec.More() {
+		entry := new(Entry)
+		if it.err = it.dec.Decode(entry); it.err != nil {
+			it.entry = nil
+			return false
+		}
+		if matchEntryType(entry, it.entryTypes) {
+			it.entry = entry
+			return true
+		}
+	}
+	it.entry = nil
+	return false
+}
+
+// Entry returns the current entry.
+func (it *EntryIterator) Entry() *Entry {
+	return it.entry
+}
+
+// Err returns the error occurred during the iteration.
+func (it *EntryIterator) Err() error {
+	return it.err
+}
+
+// Close releases the underlying response body.
+func (it *EntryIterator) Close() {
+	drainupAndCloseResponseBody(it.body)
+}
+
+func matchEntryType(entry *Entry, entryTypes []EntryType) bool {
+	if len(entryTypes) == 0 {
+		return true
+	}
+	for _, entryType := range entryTypes {
+		if entry.Type == entryType {
+			return true
+		}
+	}
+	return false
+}
+
+func filterEntries(entries []*Entry, entryTypes []EntryType) []*Entry {
+	if len(entryTypes) == 0 {
+		return entries
+	}
+	filtered := make([]*Entry, 0, len(entries))
+	for _, entry := range entries {
+		if matchEntryType(entry, entryTypes) {
+			filtered = append(filtered, entry)
+		}
+	}
+	return filtered
+}
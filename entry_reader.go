@@ -0,0 +1,297 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// entryReader reads the content of an entry response while the response body is being received, so that a large
+// file is never loaded into memory at once. The escaped text content is unescaped on the fly and the JSON content
+// is returned as is.
+type entryReader struct {
+	body io.ReadCloser
+	r    *bufio.Reader
+
+	initialized bool
+	text        bool // the content is a JSON string.
+	done        bool
+	err         error
+
+	// for the JSON content
+	depth    int
+	inString bool
+	escaped  bool
+
+	pending []byte // the unescaped bytes which are not read yet.
+}
+
+func newEntryReader(body io.ReadCloser) *entryReader {
+	return &entryReader{body: body}
+}
+
+func (er *entryReader) Read(p []byte) (int, error) {
+	if er.err != nil {
+		return 0, er.err
+	}
+	if !er.initialized {
+		if er.err = er.init(); er.err != nil {
+			return 0, er.err
+		}
+	}
+
+	n := 0
+	for n < len(p) {
+		if len(er.pending) > 0 {
+			copied := copy(p[n:], er.pending)
+			er.pending = er.pending[copied:]
+			n += copied
+			continue
+		}
+		if er.done {
+			break
+		}
+
+		var err error
+		if er.text {
+			err = er.readText()
+		} else {
+			err = er.readJSON()
+		}
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			er.err = err
+			return n, err
+		}
+	}
+
+	if n == 0 && er.done && len(er.pending) == 0 {
+		er.err = io.EOF
+		return 0, io.EOF
+	}
+	return n, nil
+}
+
+func (er *entryReader) Close() error {
+	return er.body.Close()
+}
+
+// init skips to the beginning of the content in the entry object.
+func (er *entryReader) init() error {
+	er.initialized = true
+
+	dec := json.NewDecoder(er.body)
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+	for dec.More() {
+		token, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		if token != "content" {
+			var skipped json.RawMessage
+			if err = dec.Decode(&skipped); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Read the rest from the bytes buffered by the decoder and the body.
+		er.r = bufio.NewReader(io.MultiReader(dec.Buffered(), er.body))
+		c, err := er.skipSpaces()
+		if err != nil {
+			return err
+		}
+		if c != ':' {
+			return fmt.Errorf("invalid character %q after the object key", c)
+		}
+		if c, err = er.skipSpaces(); err != nil {
+			return err
+		}
+		if c == '"' {
+			er.text = true
+		} else if err = er.r.UnreadByte(); err != nil {
+			return err
+		}
+		return nil
+	}
+
+	// The entry has no content, e.g. a directory.
+	er.done = true
+	return nil
+}
+
+func expectDelim(dec *json.Decoder, delim json.Delim) error {
+	token, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if token != delim {
+		return fmt.Errorf("unexpected token %v, want %v", token, delim)
+	}
+	return nil
+}
+
+func (er *entryReader) skipSpaces() (byte, error) {
+	for {
+		c, err := er.r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+		if c != ' ' && c != '\t' && c != '\r' && c != '\n' {
+			return c, nil
+		}
+	}
+}
+
+// readText unescapes the next character of the string content into the pending bytes.
+func (er *entryReader) readText() error {
+	c, err := er.r.ReadByte()
+	if err != nil {
+		return err
+	}
+	switch {
+	case c == '"':
+		er.done = true
+		return nil
+	case c != '\\':
+		er.pending = append(er.pending[:0], c)
+		return nil
+	}
+
+	if c, err = er.r.ReadByte(); err != nil {
+		return err
+	}
+	switch c {
+	case '"', '\\', '/':
+	case 'b':
+		c = '\b'
+	case 'f':
+		c = '\f'
+	case 'n':
+		c = '\n'
+	case 'r':
+		c = '\r'
+	case 't':
+		c = '\t'
+	case 'u':
+		r, err := er.readRune()
+		if err != nil {
+			return err
+		}
+		var buf [utf8.UTFMax]byte
+		er.pending = append(er.pending[:0], buf[:utf8.EncodeRune(buf[:], r)]...)
+		return nil
+	default:
+		return fmt.Errorf("invalid escape character %q in the content", c)
+	}
+	er.pending = append(er.pending[:0], c)
+	return nil
+}
+
+// readRune reads the rune escaped as \uXXXX whose \u is already read, including the low surrogate if any.
+// A surrogate which is not a part of a valid pair is read as U+FFFD, leaving the following escape to be read
+// on its own, as encoding/json does.
+func (er *entryReader) readRune() (rune, error) {
+	r, err := er.readHex()
+	if err != nil {
+		return 0, err
+	}
+	if !utf16.IsSurrogate(r) {
+		return r, nil
+	}
+	if r >= 0xdc00 { // a low surrogate without the high surrogate
+		return utf8.RuneError, nil
+	}
+
+	// The low surrogate should follow the high surrogate.
+	next, err := er.r.Peek(6)
+	if err != nil || next[0] != '\\' || next[1] != 'u' {
+		return utf8.RuneError, nil
+	}
+	low, err := strconv.ParseUint(string(next[2:]), 16, 16)
+	if err != nil || low < 0xdc00 || low > 0xdfff {
+		return utf8.RuneError, nil
+	}
+	_, _ = er.r.Discard(6)
+	return utf16.DecodeRune(r, rune(low)), nil
+}
+
+func (er *entryReader) readHex() (rune, error) {
+	var hex [4]byte
+	if _, err := io.ReadFull(er.r, hex[:]); err != nil {
+		return 0, err
+	}
+	r, err := strconv.ParseUint(string(hex[:]), 16, 16)
+	if err != nil {
+		return 0, errors.New("invalid unicode escape in the content")
+	}
+	return rune(r), nil
+}
+
+// readJSON reads the next byte of the JSON content into the pending bytes.
+func (er *entryReader) readJSON() error {
+	c, err := er.r.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	if er.inString {
+		if er.escaped {
+			er.escaped = false
+		} else if c == '\\' {
+			er.escaped = true
+		} else if c == '"' {
+			er.inString = false
+		}
+		er.pending = append(er.pending[:0], c)
+		return nil
+	}
+
+	switch c {
+	case '"':
+		er.inString = true
+	case '{', '[':
+		er.depth++
+	case '}', ']':
+		if er.depth == 0 {
+			// the end of the entry object after a scalar content
+			er.done = true
+			return nil
+		}
+		er.depth--
+		if er.depth == 0 {
+			er.done = true
+		}
+	case ',', ' ', '\t', '\r', '\n':
+		if er.depth == 0 {
+			// the end of a scalar content
+			er.done = true
+			return nil
+		}
+	}
+	er.pending = append(er.pending[:0], c)
+	return nil
+}
@@ -0,0 +1,97 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestEntryReader(t *testing.T) {
+	text := "line1\nline2\t\"quoted\" \\ / é 中 \U0001F600 </>\r\n" + strings.Repeat("a", 10000)
+	encodedText, _ := json.Marshal(text)
+
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"text", `{"path":"/a.txt","type":"TEXT","content":` + string(encodedText) + `,"revision":2}`, text},
+		{"escaped", `{"path":"/a.txt", "content" : "\ud83d\ude00\/A\u00e9"}`, "\U0001F600/Aé"},
+		{"unpaired high surrogate", `{"path":"/a.txt", "content" : "\ud800\u0041"}`, "\uFFFDA"},
+		{"unpaired surrogates", `{"path":"/a.txt", "content" : "\ud800\ud800\ude00\udc00B\ud800"}`,
+			"\uFFFD\U00010200\uFFFDB\uFFFD"},
+		{"json", `{"path":"/a.json","type":"JSON","content":{"a":["b","}\"]"],"c":{}},"revision":2}`,
+			`{"a":["b","}\"]"],"c":{}}`},
+		{"scalar", `{"path":"/a.json","type":"JSON","content":123}`, "123"},
+		{"directory", `{"path":"/a","type":"DIRECTORY"}`, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Read one byte at a time to check the state is kept between reads.
+			r := newEntryReader(ioutil.NopCloser(iotest.OneByteReader(strings.NewReader(tc.body))))
+			content, err := ioutil.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != tc.want {
+				t.Errorf("content: %q, want %q", content, tc.want)
+			}
+		})
+	}
+}
+
+func TestEntryReader_Truncated(t *testing.T) {
+	r := newEntryReader(ioutil.NopCloser(strings.NewReader(`{"path":"/a.txt","content":"abc`)))
+	if _, err := ioutil.ReadAll(r); err == nil {
+		t.Error("expected an error for the truncated content")
+	}
+}
+
+func TestOpenFile(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.txt", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "-1")
+		fmt.Fprint(w, `{"path":"/a.txt","type":"TEXT","content":"foo\nbar\n","revision":3}`)
+	})
+
+	content, _, err := c.OpenFile(context.Background(), "foo", "bar", "-1", "/a.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer content.Close()
+
+	got, err := ioutil.ReadAll(content)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "foo\nbar\n" {
+		t.Errorf("OpenFile returned %q, want %q", got, "foo\nbar\n")
+	}
+
+	_, httpStatusCode, err := c.OpenFile(context.Background(), "foo", "bar", "-1", "/b.txt")
+	if err == nil || httpStatusCode != http.StatusNotFound {
+		t.Errorf("OpenFile returned %v, %v, want an error with 404", httpStatusCode, err)
+	}
+}
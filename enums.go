@@ -14,6 +14,10 @@
 
 package centraldogma
 
+import (
+	"encoding/json"
+)
+
 type ChangeType int
 
 const (
@@ -67,3 +71,132 @@ func (c EntryType) String() string {
 	}
 	return "UNKNOWN"
 }
+
+type ProjectRole int
+
+const (
+	RoleOwner ProjectRole = iota + 1
+	RoleMember
+	RoleGuest
+)
+
+var projectRoleMap = map[string]ProjectRole{
+	"OWNER":  RoleOwner,
+	"MEMBER": RoleMember,
+	"GUEST":  RoleGuest,
+}
+
+// String returns the string value of ProjectRole
+func (r ProjectRole) String() string {
+	for k, v := range projectRoleMap {
+		if v == r {
+			return k
+		}
+	}
+	return "UNKNOWN"
+}
+
+func (r ProjectRole) MarshalJSON() ([]byte, error) {
+	return json.Marshal(r.String())
+}
+
+func (r *ProjectRole) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	*r = projectRoleMap[str]
+	return nil
+}
+
+type Permission int
+
+const (
+	PermissionRead Permission = iota + 1
+	PermissionWrite
+)
+
+var permissionMap = map[string]Permission{
+	"READ":  PermissionRead,
+	"WRITE": PermissionWrite,
+}
+
+// String returns the string value of Permission
+func (p Permission) String() string {
+	for k, v := range permissionMap {
+		if v == p {
+			return k
+		}
+	}
+	return "UNKNOWN"
+}
+
+func (p Permission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(p.String())
+}
+
+func (p *Permission) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	*p = permissionMap[str]
+	return nil
+}
+
+type MirrorDirection int
+
+const (
+	RemoteToLocal MirrorDirection = iota + 1
+	LocalToRemote
+)
+
+var mirrorDirectionMap = map[string]MirrorDirection{
+	"REMOTE_TO_LOCAL": RemoteToLocal,
+	"LOCAL_TO_REMOTE": LocalToRemote,
+}
+
+// String returns the string value of MirrorDirection
+func (d MirrorDirection) String() string {
+	for k, v := range mirrorDirectionMap {
+		if v == d {
+			return k
+		}
+	}
+	return "UNKNOWN"
+}
+
+func (d MirrorDirection) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *MirrorDirection) UnmarshalJSON(b []byte) error {
+	var str string
+	if err := json.Unmarshal(b, &str); err != nil {
+		return err
+	}
+	*d = mirrorDirectionMap[str]
+	return nil
+}
+
+type ProjectStatus int
+
+const (
+	ProjectActive ProjectStatus = iota
+	ProjectRemoved
+)
+
+var projectStatusMap = map[string]ProjectStatus{
+	"active":  ProjectActive,
+	"removed": ProjectRemoved,
+}
+
+// String returns the string value of ProjectStatus
+func (s ProjectStatus) String() string {
+	for k, v := range projectStatusMap {
+		if v == s {
+			return k
+		}
+	}
+	return "UNKNOWN"
+}
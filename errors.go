@@ -0,0 +1,112 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"fmt"
+	"strings"
+)
+
+// These errors correspond to the exceptions raised by the Central Dogma server. An error returned by the Client
+// can be compared with them using errors.Is. For example:
+//
+//	_, _, err := client.GetFile(ctx, "foo", "bar", "-1", query)
+//	if errors.Is(err, centraldogma.ErrEntryNotFound) {
+//	    ...
+//	}
+var (
+	ErrEntryNotFound = fmt.Errorf("entry not found")
+
+	ErrRevisionNotFound = fmt.Errorf("revision not found")
+
+	ErrChangeConflict = fmt.Errorf("change conflict")
+
+	ErrRedundantChange = fmt.Errorf("redundant change")
+
+	ErrProjectExists = fmt.Errorf("project exists")
+
+	ErrProjectNotFound = fmt.Errorf("project not found")
+
+	ErrRepositoryExists = fmt.Errorf("repository exists")
+
+	ErrRepositoryNotFound = fmt.Errorf("repository not found")
+
+	ErrQueryExecution = fmt.Errorf("query execution failure")
+
+	ErrAuthorization = fmt.Errorf("authorization failure")
+
+	ErrShuttingDown = fmt.Errorf("server is shutting down")
+)
+
+// exceptionErrors maps the simple name of the server exceptions to the errors.
+var exceptionErrors = map[string]error{
+	"EntryNotFoundException":      ErrEntryNotFound,
+	"RevisionNotFoundException":   ErrRevisionNotFound,
+	"ChangeConflictException":     ErrChangeConflict,
+	"RedundantChangeException":    ErrRedundantChange,
+	"ProjectExistsException":      ErrProjectExists,
+	"ProjectNotFoundException":    ErrProjectNotFound,
+	"RepositoryExistsException":   ErrRepositoryExists,
+	"RepositoryNotFoundException": ErrRepositoryNotFound,
+	"QueryExecutionException":     ErrQueryExecution,
+	"AuthorizationException":      ErrAuthorization,
+	"ShuttingDownException":       ErrShuttingDown,
+}
+
+// APIError represents an error response from the Central Dogma server.
+type APIError struct {
+	StatusCode int
+	// Exception is the fully qualified class name of the exception raised by the server,
+	// e.g. "com.linecorp.centraldogma.common.EntryNotFoundException". It can be empty.
+	Exception string
+	Message   string
+	// RequestID is the ID of the failed request sent in the RequestIDHeader. It can be empty.
+	RequestID string
+}
+
+func newAPIError(statusCode int, errorMessage *errorMessage) *APIError {
+	apiError := &APIError{StatusCode: statusCode}
+	if errorMessage != nil {
+		apiError.Exception = errorMessage.Exception
+		apiError.Message = errorMessage.Message
+	}
+	return apiError
+}
+
+func (e *APIError) Error() string {
+	status := fmt.Sprintf("status: %v", e.StatusCode)
+	if len(e.RequestID) != 0 {
+		status += ", request id: " + e.RequestID
+	}
+	if len(e.Message) == 0 {
+		return status
+	}
+	return fmt.Sprintf("%s (%s)", e.Message, status)
+}
+
+// Unwrap returns the error which corresponds to the exception raised by the server or nil if unknown.
+func (e *APIError) Unwrap() error {
+	exception := e.Exception
+	if i := strings.LastIndex(exception, "."); i >= 0 {
+		exception = exception[i+1:]
+	}
+	return exceptionErrors[exception]
+}
+
+// Is reports whether the exception raised by the server corresponds to the target.
+func (e *APIError) Is(target error) bool {
+	unwrapped := e.Unwrap()
+	return unwrapped != nil && unwrapped == target
+}
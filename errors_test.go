@@ -0,0 +1,60 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json",
+		func(w http.ResponseWriter, r *http.Request) {
+			testHeader(t, r, RequestIDHeader, "my-request")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.EntryNotFoundException",
+"message":"/a.json does not exist"}`)
+		})
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	ctx := ContextWithRequestID(context.Background(), "my-request")
+	_, httpStatusCode, err := c.GetFile(ctx, "foo", "bar", "-1", query)
+	testStatusCode(t, httpStatusCode, http.StatusNotFound)
+
+	apiError, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("GetFile returned %T, want *APIError", err)
+	}
+	testString(t, apiError.Error(), "/a.json does not exist (status: 404, request id: my-request)", "error")
+	if !apiError.Is(ErrEntryNotFound) {
+		t.Errorf("APIError.Is(ErrEntryNotFound) returned false, want true")
+	}
+	if apiError.Is(ErrRepositoryNotFound) {
+		t.Errorf("APIError.Is(ErrRepositoryNotFound) returned true, want false")
+	}
+}
+
+func TestAPIError_UnknownException(t *testing.T) {
+	apiError := newAPIError(http.StatusInternalServerError, nil)
+	testString(t, apiError.Error(), "status: 500", "error")
+	if apiError.Unwrap() != nil {
+		t.Errorf("APIError.Unwrap() returned %v, want nil", apiError.Unwrap())
+	}
+}
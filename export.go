@@ -0,0 +1,150 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ExportTarget writes the entries exported by Client.ExportRepository.
+type ExportTarget interface {
+	// WriteEntry writes the content of the file entry.
+	WriteEntry(entry *Entry) error
+	// Close flushes the written entries. It does not close the underlying writer.
+	Close() error
+}
+
+// exportModTime is the modification time of the archived files. A fixed time is used so that the same
+// archive is created for the same revision.
+var exportModTime = time.Unix(0, 0).UTC()
+
+type directoryExportTarget struct {
+	dir string
+}
+
+// NewDirectoryExportTarget returns an ExportTarget which writes the entries to the files under the dir.
+func NewDirectoryExportTarget(dir string) ExportTarget {
+	return &directoryExportTarget{dir: dir}
+}
+
+func (d *directoryExportTarget) WriteEntry(entry *Entry) error {
+	filename := filepath.Join(d.dir, filepath.FromSlash(exportPath(entry)))
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, entry.Content, 0644)
+}
+
+func (d *directoryExportTarget) Close() error {
+	return nil
+}
+
+type tarExportTarget struct {
+	writer *tar.Writer
+}
+
+// NewTarExportTarget returns an ExportTarget which writes the entries to the w as a tar archive.
+func NewTarExportTarget(w io.Writer) ExportTarget {
+	return &tarExportTarget{writer: tar.NewWriter(w)}
+}
+
+func (t *tarExportTarget) WriteEntry(entry *Entry) error {
+	header := &tar.Header{
+		Name:    exportPath(entry),
+		Mode:    0644,
+		Size:    int64(len(entry.Content)),
+		ModTime: exportModTime,
+	}
+	if err := t.writer.WriteHeader(header); err != nil {
+		return err
+	}
+	_, err := t.writer.Write(entry.Content)
+	return err
+}
+
+func (t *tarExportTarget) Close() error {
+	return t.writer.Close()
+}
+
+type zipExportTarget struct {
+	writer *zip.Writer
+}
+
+// NewZipExportTarget returns an ExportTarget which writes the entries to the w as a zip archive.
+func NewZipExportTarget(w io.Writer) ExportTarget {
+	return &zipExportTarget{writer: zip.NewWriter(w)}
+}
+
+func (z *zipExportTarget) WriteEntry(entry *Entry) error {
+	header := &zip.FileHeader{Name: exportPath(entry), Method: zip.Deflate}
+	header.Modified = exportModTime
+	header.SetMode(0644)
+	w, err := z.writer.CreateHeader(header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(entry.Content)
+	return err
+}
+
+func (z *zipExportTarget) Close() error {
+	return z.writer.Close()
+}
+
+// exportPath returns the relative path of the entry which cannot escape from the export root.
+func exportPath(entry *Entry) string {
+	return strings.TrimPrefix(path.Clean("/"+entry.Path), "/")
+}
+
+func (con *contentService) exportRepository(ctx context.Context,
+	projectName, repoName, revision, pathPattern string, dst ExportTarget) (int, int, error) {
+	// Normalize the revision first so that all entries are exported from the same revision.
+	normalizedRevision, httpStatusCode, err := (*repositoryService)(con).normalizeRevision(ctx,
+		projectName, repoName, revision)
+	if err != nil {
+		return 0, httpStatusCode, err
+	}
+
+	entries, httpStatusCode, err := con.getFiles(ctx, projectName, repoName,
+		Rev(normalizedRevision).String(), pathPattern)
+	if err != nil {
+		return 0, httpStatusCode, err
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].Path < entries[j].Path
+	})
+	exported := 0
+	for _, entry := range entries {
+		if entry.Type == Directory {
+			continue
+		}
+		if err = dst.WriteEntry(entry); err != nil {
+			return exported, httpStatusCode, err
+		}
+		exported++
+	}
+	return exported, httpStatusCode, nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func setupExport(t *testing.T) (*Client, func()) {
+	c, mux, teardown := setup()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"revision":3}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/**", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "3")
+		fmt.Fprint(w, `[{"path":"/b/c.txt", "type":"TEXT", "content":"hello\n"},
+{"path":"/b", "type":"DIRECTORY"},
+{"path":"/a.json", "type":"JSON", "content":{"a":"b"}}]`)
+	})
+	return c, teardown
+}
+
+func TestExportRepository_Directory(t *testing.T) {
+	c, teardown := setupExport(t)
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "centraldogma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	exported, _, err := c.ExportRepository(context.Background(), "foo", "bar", "-1", "/**",
+		NewDirectoryExportTarget(dir))
+	if err != nil {
+		t.Fatalf("ExportRepository returned error: %v", err)
+	}
+	if exported != 2 {
+		t.Errorf("ExportRepository exported %v files, want %v", exported, 2)
+	}
+	content, _ := ioutil.ReadFile(filepath.Join(dir, "b", "c.txt"))
+	testString(t, string(content), "hello\n", "b/c.txt")
+	content, _ = ioutil.ReadFile(filepath.Join(dir, "a.json"))
+	testString(t, string(content), `{"a":"b"}`, "a.json")
+}
+
+func TestExportRepository_Archive(t *testing.T) {
+	c, teardown := setupExport(t)
+	defer teardown()
+
+	var tarBuf bytes.Buffer
+	dst := NewTarExportTarget(&tarBuf)
+	if _, _, err := c.ExportRepository(context.Background(), "foo", "bar", "-1", "/**", dst); err != nil {
+		t.Fatalf("ExportRepository returned error: %v", err)
+	}
+	dst.Close()
+
+	var names []string
+	tarReader := tar.NewReader(&tarBuf)
+	for header, err := tarReader.Next(); err == nil; header, err = tarReader.Next() {
+		names = append(names, header.Name)
+	}
+	testString(t, fmt.Sprint(names), "[a.json b/c.txt]", "tar entries")
+
+	var zipBuf bytes.Buffer
+	dst = NewZipExportTarget(&zipBuf)
+	if _, _, err := c.ExportRepository(context.Background(), "foo", "bar", "-1", "/**", dst); err != nil {
+		t.Fatalf("ExportRepository returned error: %v", err)
+	}
+	dst.Close()
+
+	zipReader, err := zip.NewReader(bytes.NewReader(zipBuf.Bytes()), int64(zipBuf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	names = nil
+	for _, file := range zipReader.File {
+		names = append(names, file.Name)
+	}
+	testString(t, fmt.Sprint(names), "[a.json b/c.txt]", "zip entries")
+}
@@ -1,10 +1,12 @@
 module go.linecorp.com/centraldogma
 
 require (
+	github.com/BurntSushi/toml v1.2.1
 	github.com/armon/go-metrics v0.0.0-20190430140413-ec5e00d3c878
 	github.com/prometheus/client_golang v1.1.0
 	github.com/sirupsen/logrus v1.4.2
 	github.com/veqryn/h2c v1.0.0
 	golang.org/x/net v0.0.0-20190813141303-74dc4d7220e7
 	golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45
+	gopkg.in/yaml.v3 v3.0.1
 )
@@ -0,0 +1,192 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+)
+
+const defaultHistoryBatchSize = 100
+
+// HistoryIterator iterates over the commits of a repository, fetching them in batches so that the whole
+// history is never loaded into memory at once.
+type HistoryIterator struct {
+	ctx         context.Context
+	content     *contentService
+	projectName string
+	repoName    string
+	from        string
+	to          string
+	pathPattern string
+	batchSize   int64
+
+	initialized bool
+	cur         int64 // the next revision to fetch
+	end         int64 // the last revision to fetch
+	descending  bool
+
+	buf    []*Commit
+	commit *Commit
+	err    error
+}
+
+func (con *contentService) historyIterator(ctx context.Context,
+	projectName, repoName, from, to, pathPattern string) *HistoryIterator {
+	if len(from) == 0 {
+		from = "-1"
+	}
+	if len(to) == 0 {
+		to = "1"
+	}
+	return &HistoryIterator{
+		ctx:         ctx,
+		content:     con,
+		projectName: projectName,
+		repoName:    repoName,
+		from:        from,
+		to:          to,
+		pathPattern: pathPattern,
+		batchSize:   defaultHistoryBatchSize,
+	}
+}
+
+// Next advances the iterator to the next commit. It returns false when there are no more commits or
+// an error occurred. Err should be checked after Next returns false.
+func (it *HistoryIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	if !it.initialized {
+		if it.err = it.init(); it.err != nil {
+			return false
+		}
+	}
+
+	for len(it.buf) == 0 {
+		if it.isDone() {
+			it.commit = nil
+			return false
+		}
+		if it.err = it.fetch(); it.err != nil {
+			it.commit = nil
+			return false
+		}
+	}
+
+	it.commit = it.buf[0]
+	it.buf = it.buf[1:]
+	return true
+}
+
+// Commit returns the current commit.
+func (it *HistoryIterator) Commit() *Commit {
+	return it.commit
+}
+
+// Err returns the error occurred during the iteration.
+func (it *HistoryIterator) Err() error {
+	return it.err
+}
+
+func (it *HistoryIterator) init() error {
+	repository := (*repositoryService)(it.content)
+	from, _, err := repository.normalizeRevision(it.ctx, it.projectName, it.repoName, it.from)
+	if err != nil {
+		return err
+	}
+	to, _, err := repository.normalizeRevision(it.ctx, it.projectName, it.repoName, it.to)
+	if err != nil {
+		return err
+	}
+
+	it.cur = from
+	it.end = to
+	it.descending = from > to
+	it.initialized = true
+	return nil
+}
+
+func (it *HistoryIterator) isDone() bool {
+	if it.descending {
+		return it.cur < it.end
+	}
+	return it.cur > it.end
+}
+
+func (it *HistoryIterator) fetch() error {
+	var batchEnd int64
+	if it.descending {
+		batchEnd = it.cur - it.batchSize + 1
+		if batchEnd < it.end {
+			batchEnd = it.end
+		}
+	} else {
+		batchEnd = it.cur + it.batchSize - 1
+		if batchEnd > it.end {
+			batchEnd = it.end
+		}
+	}
+
+	commits, _, err := it.content.getHistory(it.ctx, it.projectName, it.repoName,
+		strconv.FormatInt(it.cur, 10), strconv.FormatInt(batchEnd, 10), it.pathPattern, int(it.batchSize))
+	if err != nil {
+		return err
+	}
+
+	sort.Slice(commits, func(i, j int) bool {
+		if it.descending {
+			return commits[i].Revision > commits[j].Revision
+		}
+		return commits[i].Revision < commits[j].Revision
+	})
+	it.buf = commits
+
+	if it.descending {
+		it.cur = batchEnd - 1
+	} else {
+		it.cur = batchEnd + 1
+	}
+	return nil
+}
+
+// lastModified returns the latest commit which modified the file at or before the revision. Only the latest
+// commit is requested from the server, which walks the history backward until it finds the one.
+func (con *contentService) lastModified(ctx context.Context,
+	projectName, repoName, filePath, atRevision string) (*Commit, int, error) {
+	if len(filePath) == 0 {
+		return nil, UnknownHttpStatusCode, errors.New("the path should not be empty")
+	}
+	if len(atRevision) == 0 {
+		atRevision = Head.String()
+	}
+
+	commits, httpStatusCode, err := con.getHistory(ctx, projectName, repoName, atRevision, Init.String(),
+		filePath, 1)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	if len(commits) == 0 {
+		return nil, http.StatusNotFound, newAPIError(http.StatusNotFound, &errorMessage{
+			Exception: "com.linecorp.centraldogma.common.EntryNotFoundException",
+			Message:   fmt.Sprintf("no commit modified %s at or before the revision %v", filePath, atRevision),
+		})
+	}
+	return commits[0], httpStatusCode, nil
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestGetHistoryIterator(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	const head = 5
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/", func(w http.ResponseWriter, r *http.Request) {
+		revision, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/revision/"))
+		if revision < 0 {
+			revision = head + revision + 1
+		}
+		fmt.Fprintf(w, `{"revision":%d}`, revision)
+	})
+
+	numRequests := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/", func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+		testURLQuery(t, r, "maxCommits", "2")
+		from, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/commits/"))
+		to, _ := strconv.Atoi(r.URL.Query().Get("to"))
+
+		var commits []*Commit
+		for rev := from; rev >= to; rev-- {
+			commits = append(commits, &Commit{Revision: int64(rev)})
+		}
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+
+	it := c.content.historyIterator(context.Background(), "foo", "bar", "", "", "/**")
+	it.batchSize = 2
+
+	var want int64 = head
+	for it.Next() {
+		if it.Commit().Revision != want {
+			t.Errorf("HistoryIterator returned revision %v, want %v", it.Commit().Revision, want)
+		}
+		want--
+	}
+	if it.Err() != nil {
+		t.Fatal(it.Err())
+	}
+	if want != 0 {
+		t.Errorf("HistoryIterator stopped before revision %v", want)
+	}
+	if numRequests != 3 {
+		t.Errorf("HistoryIterator sent %v requests, want 3", numRequests)
+	}
+}
+
+func TestLastModified(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	// a.json was modified at revision 2, and the other files after that.
+	numRequests := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/", func(w http.ResponseWriter, r *http.Request) {
+		numRequests++
+		testURLQuery(t, r, "path", "/a.json")
+		testURLQuery(t, r, "to", "1")
+		testURLQuery(t, r, "maxCommits", "1")
+		from := strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/commits/")
+
+		var commits []*Commit
+		if from == "-1" {
+			commits = append(commits, &Commit{Revision: 2, Author: Author{Name: "minux", Email: "minux@m.x"}})
+		}
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+
+	commit, _, err := c.LastModified(context.Background(), "foo", "bar", "/a.json", "")
+	if err != nil {
+		t.Fatalf("LastModified returned error: %v", err)
+	}
+	if commit.Revision != 2 || commit.Author.Name != "minux" {
+		t.Errorf("LastModified returned %+v, want the commit at revision 2 by minux", commit)
+	}
+	if numRequests != 1 {
+		t.Errorf("LastModified sent %v requests, want 1", numRequests)
+	}
+
+	_, httpStatusCode, err := c.LastModified(context.Background(), "foo", "bar", "/a.json", "1")
+	if apiError, ok := err.(*APIError); !ok || !apiError.Is(ErrEntryNotFound) {
+		t.Errorf("LastModified returned %v, want an APIError of %v", err, ErrEntryNotFound)
+	}
+	testStatusCode(t, httpStatusCode, http.StatusNotFound)
+}
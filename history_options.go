@@ -0,0 +1,120 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// HistoryOptions specifies the commits returned by GetHistoryWithOptions.
+type HistoryOptions struct {
+	// From is the revision to start from. Head is used if not specified.
+	From Revision
+	// To is the revision to end at. Init is used if not specified. The commits are returned in descending
+	// order if From is after To, and in ascending order otherwise.
+	To Revision
+	// PathPattern filters out the commits which did not change the matched files. "/**" is used if not
+	// specified.
+	PathPattern string
+	// MaxCommits is the maximum number of the commits to return. The server's default is used if not specified.
+	MaxCommits int
+	// Since filters out the commits pushed before it unless it is zero.
+	Since time.Time
+	// Until filters out the commits pushed after it unless it is zero.
+	Until time.Time
+}
+
+// defaultMaxCommits is the default maximum number of the commits which the server returns.
+const defaultMaxCommits = 100
+
+func (o *HistoryOptions) validate() error {
+	if o.MaxCommits < 0 {
+		return fmt.Errorf("maxCommits should not be negative (maxCommits: %v)", o.MaxCommits)
+	}
+	if !o.Since.IsZero() && !o.Until.IsZero() && o.Since.After(o.Until) {
+		return fmt.Errorf("since should not be after until (since: %v, until: %v)", o.Since, o.Until)
+	}
+	return nil
+}
+
+func (o *HistoryOptions) hasTimeRange() bool {
+	return !o.Since.IsZero() || !o.Until.IsZero()
+}
+
+// PushedTime returns the time when the commit was pushed.
+func (c *Commit) PushedTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, c.PushedAt)
+}
+
+func (con *contentService) getHistoryWithOptions(ctx context.Context, projectName, repoName string,
+	opts *HistoryOptions) ([]*Commit, int, error) {
+	if opts == nil {
+		opts = &HistoryOptions{}
+	}
+	if err := opts.validate(); err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	from, to := opts.From, opts.To
+	if from == 0 {
+		from = Head
+	}
+	if to == 0 {
+		to = Init
+	}
+	pathPattern := opts.PathPattern
+	if len(pathPattern) == 0 {
+		pathPattern = "/**"
+	}
+	if !opts.hasTimeRange() {
+		return con.getHistory(ctx, projectName, repoName, from.String(), to.String(), pathPattern,
+			opts.MaxCommits)
+	}
+
+	maxCommits := opts.MaxCommits
+	if maxCommits == 0 {
+		maxCommits = defaultMaxCommits
+	}
+	// The server cannot filter the commits by time, so iterate over the history until the time range is passed.
+	it := con.historyIterator(ctx, projectName, repoName, from.String(), to.String(), pathPattern)
+	var commits []*Commit
+	for len(commits) < maxCommits && it.Next() {
+		commit := it.Commit()
+		pushedAt, err := commit.PushedTime()
+		if err != nil {
+			return nil, UnknownHttpStatusCode, err
+		}
+		if !opts.Since.IsZero() && pushedAt.Before(opts.Since) {
+			if it.descending {
+				break
+			}
+			continue
+		}
+		if !opts.Until.IsZero() && pushedAt.After(opts.Until) {
+			if !it.descending {
+				break
+			}
+			continue
+		}
+		commits = append(commits, commit)
+	}
+	if err := it.Err(); err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+	return commits, http.StatusOK, nil
+}
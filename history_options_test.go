@@ -0,0 +1,115 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetHistoryWithOptions(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "to", "3")
+		testURLQuery(t, r, "path", "/a.json")
+		testURLQuery(t, r, "maxCommits", "2")
+		fmt.Fprint(w, `[{"revision":5}, {"revision":4}]`)
+	})
+
+	opts := &HistoryOptions{To: 3, PathPattern: "/a.json", MaxCommits: 2}
+	commits, _, err := c.GetHistoryWithOptions(context.Background(), "foo", "bar", opts)
+	if err != nil {
+		t.Fatalf("GetHistoryWithOptions returned error: %v", err)
+	}
+	if len(commits) != 2 || commits[0].Revision != 5 || commits[1].Revision != 4 {
+		t.Errorf("GetHistoryWithOptions returned %+v", commits)
+	}
+}
+
+func TestGetHistoryWithOptions_TimeRange(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	// Revision N is pushed at N o'clock.
+	const head = 10
+	pushedAt := func(rev int) time.Time {
+		return time.Date(2017, 5, 22, rev, 0, 0, 0, time.UTC)
+	}
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/", func(w http.ResponseWriter, r *http.Request) {
+		revision, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/revision/"))
+		if revision < 0 {
+			revision = head + revision + 1
+		}
+		fmt.Fprintf(w, `{"revision":%d}`, revision)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/", func(w http.ResponseWriter, r *http.Request) {
+		from, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/projects/foo/repos/bar/commits/"))
+		to, _ := strconv.Atoi(r.URL.Query().Get("to"))
+		step := -1
+		if from < to {
+			step = 1
+		}
+		var commits []*Commit
+		for rev := from; rev != to+step; rev += step {
+			commits = append(commits, &Commit{Revision: int64(rev), PushedAt: pushedAt(rev).Format(time.RFC3339)})
+		}
+		_ = json.NewEncoder(w).Encode(commits)
+	})
+
+	opts := &HistoryOptions{Since: pushedAt(4), Until: pushedAt(7)}
+	commits, _, err := c.GetHistoryWithOptions(context.Background(), "foo", "bar", opts)
+	if err != nil {
+		t.Fatalf("GetHistoryWithOptions returned error: %v", err)
+	}
+	var revisions []int64
+	for _, commit := range commits {
+		revisions = append(revisions, commit.Revision)
+	}
+	if fmt.Sprint(revisions) != "[7 6 5 4]" {
+		t.Errorf("GetHistoryWithOptions returned %v, want [7 6 5 4]", revisions)
+	}
+
+	opts = &HistoryOptions{From: Init, To: Head, Since: pushedAt(4), MaxCommits: 2}
+	if commits, _, err = c.GetHistoryWithOptions(context.Background(), "foo", "bar", opts); err != nil {
+		t.Fatalf("GetHistoryWithOptions returned error: %v", err)
+	}
+	if len(commits) != 2 || commits[0].Revision != 4 || commits[1].Revision != 5 {
+		t.Errorf("GetHistoryWithOptions returned %+v, want revision 4 and 5", commits)
+	}
+}
+
+func TestGetHistoryWithOptions_Validation(t *testing.T) {
+	c, _, teardown := setup()
+	defer teardown()
+
+	now := time.Now()
+	for _, opts := range []*HistoryOptions{
+		{MaxCommits: -1},
+		{Since: now, Until: now.Add(-time.Hour)},
+	} {
+		if _, _, err := c.GetHistoryWithOptions(context.Background(), "foo", "bar", opts); err == nil {
+			t.Errorf("GetHistoryWithOptions should fail for %+v", opts)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Instrumentation observes the operations of a Client. An operation is the method and the path template of
+// the request, e.g. "GET /api/v1/projects/{}/repos/{}/contents". The method of a watch request is "WATCH".
+// The implementation must be safe for concurrent use.
+type Instrumentation interface {
+	// ObserveRequest is invoked when the response headers of a request are received or the request fails.
+	ObserveRequest(operation string, statusCode int, duration time.Duration, err error)
+	// ObserveRetry is invoked when a failed operation is retried.
+	ObserveRetry(operation string)
+	// ObserveWatchStarted is invoked when a watch request is sent.
+	ObserveWatchStarted(operation string)
+	// ObserveWatchFinished is invoked when a watch request is completed.
+	ObserveWatchFinished(operation string)
+}
+
+const watchMethod = "WATCH"
+
+// watcherOperation is the operation of the watch requests sent by a Watcher.
+var watcherOperation = watchMethod + " /" + defaultPathPrefix + projects + "/{}/" + repos + "/{}/" + contents
+
+// pathKeywords are the path segments which are kept in an operation. The other segments are replaced with "{}".
+var pathKeywords = map[string]bool{
+	"api": true, "v1": true, projects: true, repos: true, contents: true, commits: true, tokens: true,
+	metadata: true, members: true, mirrors: true, credentials: true, actionList: true, actionCompare: true,
+	actionRemoved: true, actionMerge: true, actionPreview: true, "revision": true, "perm": true, "role": true,
+	"users": true, "status": true, "monitor": true, "l7check": true, "version": true,
+	pathSecurityEnabled: true, "login": true,
+}
+
+// namedCollections are the path segments which are followed by a name, e.g. "projects/{name}".
+var namedCollections = map[string]bool{
+	projects: true, repos: true, metadata: true, members: true, tokens: true, mirrors: true, credentials: true,
+	commits: true, "revision": true,
+}
+
+// operationName returns the operation of the request. The names of the resources are replaced with "{}" and
+// the file paths are removed so that the number of the operations is bounded.
+func operationName(req *http.Request, watchRequest bool) string {
+	method := req.Method
+	if watchRequest {
+		method = watchMethod
+	}
+
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	template := make([]string, 0, len(segments))
+	for i := 0; i < len(segments); i++ {
+		segment := segments[i]
+		if !pathKeywords[segment] {
+			template = append(template, "{}")
+			continue
+		}
+		template = append(template, segment)
+		if segment == contents || segment == actionList {
+			break // the rest is a file path
+		}
+		if namedCollections[segment] && i+1 < len(segments) {
+			template = append(template, "{}")
+			i++
+		}
+	}
+	return method + " /" + strings.Join(template, "/")
+}
+
+type prometheusInstrumentation struct {
+	requests      *prometheus.CounterVec
+	duration      *prometheus.HistogramVec
+	retries       *prometheus.CounterVec
+	activeWatches *prometheus.GaugeVec
+}
+
+// NewPrometheusInstrumentation returns an Instrumentation which records the following metrics to
+// the registerer:
+//
+//   - centraldogma_client_requests_total{operation, status}
+//   - centraldogma_client_request_duration_seconds{operation}
+//   - centraldogma_client_retries_total{operation}
+//   - centraldogma_client_active_watches{operation}
+//
+// The metrics which have already been registered are shared, so it can be called for each Client.
+func NewPrometheusInstrumentation(registerer prometheus.Registerer) (Instrumentation, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	requests := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "centraldogma_client",
+		Name:      "requests_total",
+		Help:      "The number of the requests sent to the Central Dogma server.",
+	}, []string{"operation", "status"})
+	duration := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "centraldogma_client",
+		Name:      "request_duration_seconds",
+		Help:      "The time taken until the response headers are received.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation"})
+	retries := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "centraldogma_client",
+		Name:      "retries_total",
+		Help:      "The number of the retried operations.",
+	}, []string{"operation"})
+	activeWatches := prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "centraldogma_client",
+		Name:      "active_watches",
+		Help:      "The number of the watch requests in flight.",
+	}, []string{"operation"})
+
+	collectors := []prometheus.Collector{requests, duration, retries, activeWatches}
+	for i, collector := range collectors {
+		registered, err := registerCollector(registerer, collector)
+		if err != nil {
+			return nil, err
+		}
+		collectors[i] = registered
+	}
+	return &prometheusInstrumentation{
+		requests:      collectors[0].(*prometheus.CounterVec),
+		duration:      collectors[1].(*prometheus.HistogramVec),
+		retries:       collectors[2].(*prometheus.CounterVec),
+		activeWatches: collectors[3].(*prometheus.GaugeVec),
+	}, nil
+}
+
+// registerCollector registers the collector and returns the registered one. If the same collector has already
+// been registered, the existing one is returned.
+func registerCollector(registerer prometheus.Registerer, collector prometheus.Collector) (prometheus.Collector,
+	error) {
+	if err := registerer.Register(collector); err != nil {
+		if registered, ok := err.(prometheus.AlreadyRegisteredError); ok {
+			return registered.ExistingCollector, nil
+		}
+		return nil, err
+	}
+	return collector, nil
+}
+
+func (p *prometheusInstrumentation) ObserveRequest(operation string, statusCode int, duration time.Duration,
+	err error) {
+	p.requests.WithLabelValues(operation, strconv.Itoa(statusCode)).Inc()
+	p.duration.WithLabelValues(operation).Observe(duration.Seconds())
+}
+
+func (p *prometheusInstrumentation) ObserveRetry(operation string) {
+	p.retries.WithLabelValues(operation).Inc()
+}
+
+func (p *prometheusInstrumentation) ObserveWatchStarted(operation string) {
+	p.activeWatches.WithLabelValues(operation).Inc()
+}
+
+func (p *prometheusInstrumentation) ObserveWatchFinished(operation string) {
+	p.activeWatches.WithLabelValues(operation).Dec()
+}
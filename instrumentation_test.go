@@ -0,0 +1,101 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+func TestOperationName(t *testing.T) {
+	tests := []struct {
+		method       string
+		path         string
+		watchRequest bool
+		want         string
+	}{
+		{http.MethodGet, "/api/v1/projects", false, "GET /api/v1/projects"},
+		{http.MethodGet, "/api/v1/projects/foo/repos/bar/contents/a/b.json", false,
+			"GET /api/v1/projects/{}/repos/{}/contents"},
+		{http.MethodGet, "/api/v1/projects/foo/repos/bar/contents/a.json", true,
+			"WATCH /api/v1/projects/{}/repos/{}/contents"},
+		{http.MethodGet, "/api/v1/projects/foo/repos/bar/list/a/**", false, "GET /api/v1/projects/{}/repos/{}/list"},
+		{http.MethodGet, "/api/v1/projects/foo/repos/bar/revision/-1", false,
+			"GET /api/v1/projects/{}/repos/{}/revision/{}"},
+		{http.MethodPatch, "/api/v1/metadata/foo/members/alice", false, "PATCH /api/v1/metadata/{}/members/{}"},
+		{http.MethodPost, "/api/v1/metadata/foo/repos/bar/perm/users", false,
+			"POST /api/v1/metadata/{}/repos/{}/perm/users"},
+		{http.MethodGet, "/monitor/l7check", false, "GET /monitor/l7check"},
+	}
+
+	for _, test := range tests {
+		req := &http.Request{Method: test.method, URL: &url.URL{Path: test.path}}
+		testString(t, operationName(req, test.watchRequest), test.want, test.path)
+	}
+}
+
+func TestWithPrometheusRegistry(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+
+	registry := prometheus.NewRegistry()
+	for i := 0; i < 2; i++ {
+		// The metrics are shared by the clients.
+		c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithPrometheusRegistry(registry))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, _, err = c.ListProjects(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, err := NewClient(server.URL, WithPrometheusRegistry(registry),
+		WithInstrumentation(&prometheusInstrumentation{})); err != ErrInstrumentationConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrInstrumentationConflict)
+	}
+
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, family := range families {
+		if family.GetName() != "centraldogma_client_requests_total" {
+			continue
+		}
+		metric := family.GetMetric()[0]
+		if got := metric.GetCounter().GetValue(); got != 2 {
+			t.Errorf("requests_total: %v, want %v", got, 2)
+		}
+		for _, label := range metric.GetLabel() {
+			if label.GetName() == "operation" {
+				testString(t, label.GetValue(), "GET /api/v1/projects", "operation")
+			}
+		}
+		return
+	}
+	t.Errorf("centraldogma_client_requests_total is not registered")
+}
@@ -0,0 +1,428 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
+)
+
+// A tokenCreateCommand creates an application token. The secret of the token is printed because it is only
+// available when the token is created.
+type tokenCreateCommand struct {
+	remoteURL string
+	appID     string
+	isAdmin   bool
+	style     PrintStyle
+}
+
+func (tc *tokenCreateCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, tc.remoteURL)
+	if err != nil {
+		return err
+	}
+	return tc.executeWithDogmaClient(client)
+}
+
+func (tc *tokenCreateCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	token, httpStatusCode, err := client.CreateToken(context.Background(), tc.appID, tc.isAdmin)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusCreated && httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to create the token: %s (status: %d)", tc.appID, httpStatusCode)
+	}
+	if isStructured(tc.style) {
+		return printWithStyle(token, tc.style)
+	}
+	fmt.Printf("Created the token: %s\nSecret: %s\n", token.AppID, token.Secret)
+	return nil
+}
+
+// A tokenListCommand lists the application tokens.
+type tokenListCommand struct {
+	remoteURL string
+	style     PrintStyle
+}
+
+func (tl *tokenListCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, tl.remoteURL)
+	if err != nil {
+		return err
+	}
+
+	tokens, httpStatusCode, err := client.ListTokens(context.Background())
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the list of tokens. (status: %d)", httpStatusCode)
+	}
+	return printWithStyle(tokens, tl.style)
+}
+
+// A tokenActivationCommand activates or deactivates an application token.
+type tokenActivationCommand struct {
+	remoteURL string
+	appID     string
+	activate  bool
+	style     PrintStyle
+}
+
+func (ta *tokenActivationCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, ta.remoteURL)
+	if err != nil {
+		return err
+	}
+
+	action, message, activateToken := "deactivate", "Deactivated", client.DeactivateToken
+	if ta.activate {
+		action, message, activateToken = "activate", "Activated", client.ActivateToken
+	}
+	token, httpStatusCode, err := activateToken(context.Background(), ta.appID)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to %s the token: %s (status: %d)", action, ta.appID, httpStatusCode)
+	}
+	if isStructured(ta.style) {
+		return printWithStyle(token, ta.style)
+	}
+	fmt.Printf("%s the token: %s\n", message, ta.appID)
+	return nil
+}
+
+// memberOutput is the structured output of the commands which add or remove a member.
+type memberOutput struct {
+	Project string `json:"project"`
+	Login   string `json:"login,omitempty"`
+	AppID   string `json:"appId,omitempty"`
+	Role    string `json:"role,omitempty"`
+}
+
+// A memberCommand adds a member to the project or removes it. The member is an application token if isToken
+// is true.
+type memberCommand struct {
+	remoteURL string
+	projName  string
+	id        string // The login of the user or the application ID of the token.
+	isToken   bool
+	role      centraldogma.ProjectRole // Zero if the member is removed.
+	style     PrintStyle
+}
+
+func (m *memberCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, m.remoteURL)
+	if err != nil {
+		return err
+	}
+	return m.executeWithDogmaClient(client)
+}
+
+func (m *memberCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	ctx := context.Background()
+	var httpStatusCode int
+	var err error
+	switch {
+	case m.role != 0 && m.isToken:
+		httpStatusCode, err = client.AddTokenToProject(ctx, m.projName, m.id, m.role)
+	case m.role != 0:
+		httpStatusCode, err = client.AddMember(ctx, m.projName, m.id, m.role)
+	case m.isToken:
+		httpStatusCode, err = client.RemoveTokenFromProject(ctx, m.projName, m.id)
+	default:
+		httpStatusCode, err = client.RemoveMember(ctx, m.projName, m.id)
+	}
+	if err != nil {
+		return err
+	}
+
+	action := "add"
+	if m.role == 0 {
+		action = "remove"
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to %s the member %s of %s (status: %d)", action, m.id, m.projName,
+			httpStatusCode)
+	}
+
+	if isStructured(m.style) {
+		output := &memberOutput{Project: m.projName}
+		if m.isToken {
+			output.AppID = m.id
+		} else {
+			output.Login = m.id
+		}
+		if m.role != 0 {
+			output.Role = m.role.String()
+		}
+		return printWithStyle(output, m.style)
+	}
+	if m.role != 0 {
+		fmt.Printf("Added the member %s to %s as %s\n", m.id, m.projName, m.role)
+	} else {
+		fmt.Printf("Removed the member %s from %s\n", m.id, m.projName)
+	}
+	return nil
+}
+
+// permissionOutput is the structured output of the perm set command.
+type permissionOutput struct {
+	Project     string                    `json:"project"`
+	Repository  string                    `json:"repository"`
+	Login       string                    `json:"login,omitempty"`
+	AppID       string                    `json:"appId,omitempty"`
+	Role        string                    `json:"role,omitempty"`
+	Permissions []centraldogma.Permission `json:"permissions"`
+}
+
+// A permSetCommand sets the permissions of the repository for a user, an application token or a role.
+// Only one of login, appID and role is set.
+type permSetCommand struct {
+	remoteURL   string
+	projName    string
+	repoName    string
+	login       string
+	appID       string
+	role        centraldogma.ProjectRole
+	permissions []centraldogma.Permission
+	style       PrintStyle
+}
+
+func (ps *permSetCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, ps.remoteURL)
+	if err != nil {
+		return err
+	}
+	return ps.executeWithDogmaClient(client)
+}
+
+func (ps *permSetCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	ctx := context.Background()
+	var httpStatusCode int
+	var err error
+	var target string
+	switch {
+	case len(ps.login) != 0:
+		target = "the user " + ps.login
+		httpStatusCode, err = client.SetRepoUserPermissions(ctx, ps.projName, ps.repoName, ps.login,
+			ps.permissions)
+	case len(ps.appID) != 0:
+		target = "the token " + ps.appID
+		httpStatusCode, err = client.SetRepoTokenPermissions(ctx, ps.projName, ps.repoName, ps.appID,
+			ps.permissions)
+	default:
+		target = "the role " + ps.role.String()
+		httpStatusCode, err = ps.setRolePermissions(client)
+	}
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to set the permissions of /%s/%s for %s (status: %d)",
+			ps.projName, ps.repoName, target, httpStatusCode)
+	}
+
+	if isStructured(ps.style) {
+		output := &permissionOutput{Project: ps.projName, Repository: ps.repoName, Login: ps.login,
+			AppID: ps.appID, Permissions: ps.permissions}
+		if ps.role != 0 {
+			output.Role = ps.role.String()
+		}
+		return printWithStyle(output, ps.style)
+	}
+	fmt.Printf("Set the permissions of /%s/%s for %s: %s\n", ps.projName, ps.repoName, target,
+		permissionsString(ps.permissions))
+	return nil
+}
+
+// setRolePermissions replaces the permissions of the role keeping the ones of the other roles, because the
+// server replaces the permissions of all the roles at once.
+func (ps *permSetCommand) setRolePermissions(client *centraldogma.Client) (int, error) {
+	ctx := context.Background()
+	metadata, httpStatusCode, err := client.GetProjectMetadata(ctx, ps.projName)
+	if err != nil {
+		return httpStatusCode, err
+	}
+	if httpStatusCode != http.StatusOK {
+		return httpStatusCode, nil
+	}
+	repo, ok := metadata.Repos[ps.repoName]
+	if !ok {
+		return http.StatusNotFound, nil
+	}
+
+	var perRolePermissions centraldogma.PerRolePermissions
+	if repo.PerRolePermissions != nil {
+		perRolePermissions = *repo.PerRolePermissions
+	}
+	switch ps.role {
+	case centraldogma.RoleOwner:
+		perRolePermissions.Owner = ps.permissions
+	case centraldogma.RoleMember:
+		perRolePermissions.Member = ps.permissions
+	case centraldogma.RoleGuest:
+		perRolePermissions.Guest = ps.permissions
+	}
+	return client.SetRepoRolePermissions(ctx, ps.projName, ps.repoName, &perRolePermissions)
+}
+
+func permissionsString(permissions []centraldogma.Permission) string {
+	if len(permissions) == 0 {
+		return "none"
+	}
+	names := make([]string, len(permissions))
+	for i, permission := range permissions {
+		names[i] = permission.String()
+	}
+	return strings.Join(names, ", ")
+}
+
+// parseProjectRole parses the role such as "owner", "member" or "guest" case-insensitively.
+func parseProjectRole(value string) (centraldogma.ProjectRole, error) {
+	for _, role := range []centraldogma.ProjectRole{
+		centraldogma.RoleOwner, centraldogma.RoleMember, centraldogma.RoleGuest} {
+		if strings.EqualFold(role.String(), value) {
+			return role, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown role: %s (expected: owner, member or guest)", value)
+}
+
+// getProjectRole returns the role specified with the role flag, or zero if it is not specified.
+func getProjectRole(c *cli.Context) (centraldogma.ProjectRole, error) {
+	if role := c.String("role"); len(role) != 0 {
+		return parseProjectRole(role)
+	}
+	return 0, nil
+}
+
+// parsePermissions parses the permission which is "read", "write" or "none". The write permission includes
+// the read permission.
+func parsePermissions(value string) ([]centraldogma.Permission, error) {
+	switch strings.ToLower(value) {
+	case "":
+		return nil, errors.New("the permission should be specified with --permission (expected: read, write or none)")
+	case "none":
+		return []centraldogma.Permission{}, nil
+	case "read":
+		return []centraldogma.Permission{centraldogma.PermissionRead}, nil
+	case "write":
+		return []centraldogma.Permission{centraldogma.PermissionRead, centraldogma.PermissionWrite}, nil
+	default:
+		return nil, fmt.Errorf("unknown permission: %s (expected: read, write or none)", value)
+	}
+}
+
+// newTokenCreateCommand creates the tokenCreateCommand.
+func newTokenCreateCommand(c *cli.Context, style PrintStyle) (Command, error) {
+	if len(c.Args()) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenCreateCommand{remoteURL: remoteURL, appID: c.Args().First(), isAdmin: c.Bool("admin"),
+		style: style}, nil
+}
+
+// newTokenListCommand creates the tokenListCommand.
+func newTokenListCommand(c *cli.Context, style PrintStyle) (Command, error) {
+	if len(c.Args()) != 0 {
+		return nil, newCommandLineError(c)
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenListCommand{remoteURL: remoteURL, style: style}, nil
+}
+
+// newTokenActivationCommand creates the tokenActivationCommand.
+func newTokenActivationCommand(c *cli.Context, style PrintStyle, activate bool) (Command, error) {
+	if len(c.Args()) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &tokenActivationCommand{remoteURL: remoteURL, appID: c.Args().First(), activate: activate,
+		style: style}, nil
+}
+
+// newMemberCommand creates the memberCommand which adds the member with the role or removes it if the role
+// is zero.
+func newMemberCommand(c *cli.Context, style PrintStyle, role centraldogma.ProjectRole) (Command, error) {
+	if len(c.Args()) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	split := splitPath(c.Args().First())
+	if len(split) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	login, appID := c.String("user"), c.String("app")
+	if (len(login) == 0) == (len(appID) == 0) {
+		return nil, newCommandLineError(c)
+	}
+	m := &memberCommand{remoteURL: remoteURL, projName: split[0], id: login, role: role, style: style}
+	if len(appID) != 0 {
+		m.id = appID
+		m.isToken = true
+	}
+	return m, nil
+}
+
+// newPermSetCommand creates the permSetCommand.
+func newPermSetCommand(c *cli.Context, style PrintStyle, role centraldogma.ProjectRole,
+	permissions []centraldogma.Permission) (Command, error) {
+	if len(c.Args()) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	split := splitPath(c.Args().First())
+	if len(split) != 2 {
+		return nil, newCommandLineError(c)
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+
+	ps := &permSetCommand{remoteURL: remoteURL, projName: split[0], repoName: split[1],
+		login: c.String("user"), appID: c.String("app"), role: role, permissions: permissions, style: style}
+	targets := 0
+	for _, set := range []bool{len(ps.login) != 0, len(ps.appID) != 0, ps.role != 0} {
+		if set {
+			targets++
+		}
+	}
+	if targets != 1 {
+		return nil, newCommandLineError(c)
+	}
+	return ps, nil
+}
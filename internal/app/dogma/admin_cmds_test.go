@@ -0,0 +1,133 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestParsePermissions(t *testing.T) {
+	var tests = []struct {
+		value string
+		want  []dogma.Permission
+	}{
+		{"none", []dogma.Permission{}},
+		{"read", []dogma.Permission{dogma.PermissionRead}},
+		{"WRITE", []dogma.Permission{dogma.PermissionRead, dogma.PermissionWrite}},
+	}
+
+	for _, test := range tests {
+		got, err := parsePermissions(test.value)
+		if err != nil || !reflect.DeepEqual(got, test.want) {
+			t.Errorf("parsePermissions(%q) = (%v, %v), want: %v", test.value, got, err, test.want)
+		}
+	}
+	for _, value := range []string{"", "admin"} {
+		if _, err := parsePermissions(value); err == nil {
+			t.Errorf("parsePermissions(%q) should return an error", value)
+		}
+	}
+}
+
+func TestParseProjectRole(t *testing.T) {
+	if role, err := parseProjectRole("Owner"); role != dogma.RoleOwner || err != nil {
+		t.Errorf("parseProjectRole(%q) = (%v, %v), want: %v", "Owner", role, err, dogma.RoleOwner)
+	}
+	if _, err := parseProjectRole("admin"); err == nil {
+		t.Errorf("parseProjectRole(%q) should return an error", "admin")
+	}
+}
+
+func TestMemberCommand(t *testing.T) {
+	var method, path, body string
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			method, path = r.Method, r.URL.Path
+			b, _ := ioutil.ReadAll(r.Body)
+			body = strings.TrimSpace(string(b))
+		}))
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	var tests = []struct {
+		command  memberCommand
+		want     string
+		wantBody string
+	}{
+		{memberCommand{projName: "foo", id: "alice", role: dogma.RoleOwner},
+			"POST /api/v1/metadata/foo/members", `{"id":"alice","role":"OWNER"}`},
+		{memberCommand{projName: "foo", id: "my-app", isToken: true, role: dogma.RoleMember},
+			"POST /api/v1/metadata/foo/tokens", `{"id":"my-app","role":"MEMBER"}`},
+		{memberCommand{projName: "foo", id: "alice"}, "DELETE /api/v1/metadata/foo/members/alice", ""},
+		{memberCommand{projName: "foo", id: "my-app", isToken: true},
+			"DELETE /api/v1/metadata/foo/tokens/my-app", ""},
+	}
+
+	for _, test := range tests {
+		var err error
+		runCommandAndCaptureStdout(func() { err = test.command.executeWithDogmaClient(client) })
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := method + " " + path; got != test.want {
+			t.Errorf("Got request %s; want %s", got, test.want)
+		}
+		if body != test.wantBody {
+			t.Errorf("Got body %s; want %s", body, test.wantBody)
+		}
+	}
+}
+
+func TestPermSetRole(t *testing.T) {
+	var body string
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodGet {
+				fmt.Fprint(w, `{"name":"foo", "repos":{"bar":{"name":"bar",
+					"perRolePermissions":{"owner":["READ","WRITE"],"member":["READ","WRITE"],"guest":["READ"]}}}}`)
+				return
+			}
+			if r.URL.Path != "/api/v1/metadata/foo/repos/bar/perm/role" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			b, _ := ioutil.ReadAll(r.Body)
+			body = strings.TrimSpace(string(b))
+		}))
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	ps := permSetCommand{projName: "foo", repoName: "bar", role: dogma.RoleGuest,
+		permissions: []dogma.Permission{}}
+	var err error
+	out := runCommandAndCaptureStdout(func() { err = ps.executeWithDogmaClient(client) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"owner":["READ","WRITE"],"member":["READ","WRITE"],"guest":[]}`; body != want {
+		t.Errorf("Got body %s; want %s", body, want)
+	}
+	if want := "Set the permissions of /foo/bar for the role GUEST: none\n"; string(out) != want {
+		t.Errorf("Got output %q; want %q", out, want)
+	}
+}
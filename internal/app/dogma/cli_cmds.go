@@ -16,6 +16,7 @@ package main
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/urfave/cli"
 )
@@ -46,7 +47,7 @@ var toRevisionFlag = cli.StringFlag{
 }
 
 var maxCommitsFlag = cli.IntFlag{
-	Name:  "max-commits",
+	Name:  "max-commits, max",
 	Usage: "Specifies the number of maximum commits to fetch",
 }
 
@@ -60,7 +61,186 @@ var listenerFlag = cli.StringFlag{
 	Usage: "Specifies the `executable` path that handles watch events",
 }
 
+var diffFlag = cli.BoolFlag{
+	Name:  "diff",
+	Usage: "Specifies whether to print the diff from the previous revision instead of the content",
+}
+
+var diffFormatFlag = cli.StringFlag{
+	Name:  "format",
+	Value: "jsonpatch",
+	Usage: "Specifies the `format` of the diff: unified, jsonpatch or summary",
+}
+
+var colorFlag = cli.StringFlag{
+	Name:  "color",
+	Value: "auto",
+	Usage: "Specifies `when` to color the diff: auto, always or never",
+}
+
+var exitCodeFlag = cli.BoolFlag{
+	Name:  "exit-code",
+	Usage: "Specifies whether to exit with 1 if there are differences and 2 if an error occurs",
+}
+
+var includeFlag = cli.StringSliceFlag{
+	Name:  "include",
+	Usage: "Specifies the `glob` of the files to include. A glob without / matches the file name",
+}
+
+var excludeFlag = cli.StringSliceFlag{
+	Name:  "exclude",
+	Usage: "Specifies the `glob` of the files to exclude. A glob without / matches the file name",
+}
+
+var dryRunFlag = cli.BoolFlag{
+	Name:  "dry-run",
+	Usage: "Specifies whether to only print the files without changing them",
+}
+
+var logFilterFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "since",
+		Usage: "Specifies the `time` after which the commits are pushed, e.g. 2006-01-02, 2h, 3d or 1w",
+	},
+	cli.StringFlag{
+		Name:  "until",
+		Usage: "Specifies the `time` before which the commits are pushed, e.g. 2006-01-02, 2h, 3d or 1w",
+	},
+	cli.StringFlag{
+		Name:  "author",
+		Usage: "Specifies the `name` or the email of the author, which is matched case-insensitively",
+	},
+	cli.StringFlag{
+		Name:  "follow",
+		Usage: "Specifies the `path` of the file to show only the commits which changed it",
+	},
+	cli.BoolFlag{
+		Name:  "oneline",
+		Usage: "Specifies whether to print each commit in a line with its revision and summary",
+	},
+}
+
+var adminFlag = cli.BoolFlag{
+	Name:  "admin",
+	Usage: "Specifies whether the token is an administrator token",
+}
+
+var userFlag = cli.StringFlag{
+	Name:  "user",
+	Usage: "Specifies the `login` of the user",
+}
+
+var appFlag = cli.StringFlag{
+	Name:  "app",
+	Usage: "Specifies the `app_id` of the application token",
+}
+
+var permissionFlag = cli.StringFlag{
+	Name:  "permission, p",
+	Usage: "Specifies the `permission` of the repository: read, write or none",
+}
+
+var mirrorFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "repo",
+		Usage: "Specifies the `name` of the local repository",
+	},
+	cli.StringFlag{
+		Name:  "local-path",
+		Usage: "Specifies the `path` of the directory in the local repository (default: /)",
+	},
+	cli.StringFlag{
+		Name:  "remote",
+		Usage: "Specifies the `URL` of the remote Git repository, e.g. https://github.com/foo/bar.git",
+	},
+	cli.StringFlag{
+		Name:  "remote-path",
+		Usage: "Specifies the `path` of the directory in the remote repository (default: /)",
+	},
+	cli.StringFlag{
+		Name:  "branch",
+		Usage: "Specifies the `branch` of the remote repository (default: the default branch)",
+	},
+	cli.StringFlag{
+		Name:  "direction",
+		Usage: "Specifies the `direction` of the mirror: remote-to-local or local-to-remote (default: remote-to-local)",
+	},
+	cli.StringFlag{
+		Name:  "schedule",
+		Usage: "Specifies the Quartz `cron` expression of the schedule (default: \"0 * * * * ?\")",
+	},
+	cli.StringFlag{
+		Name:  "credential",
+		Usage: "Specifies the `id` of the credential to access the remote repository",
+	},
+	cli.BoolFlag{
+		Name:  "enable",
+		Usage: "Specifies whether to enable the mirror",
+	},
+	cli.BoolFlag{
+		Name:  "disable",
+		Usage: "Specifies whether to disable the mirror",
+	},
+	cli.BoolFlag{
+		Name:  "test",
+		Usage: "Specifies whether to only check the mirror and the credential without saving the mirror",
+	},
+}
+
+var credentialFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "type",
+		Value: "none",
+		Usage: "Specifies the `type` of the credential: none, password, access_token or public_key",
+	},
+	cli.StringSliceFlag{
+		Name:  "hostname",
+		Usage: "Specifies the regular expression of the `hostname`s which the credential is used for",
+	},
+	cli.StringFlag{
+		Name:  "username",
+		Usage: "Specifies the `username` of the password or public_key credential",
+	},
+	cli.StringFlag{
+		Name:   "password",
+		EnvVar: "DOGMA_CREDENTIAL_PASSWORD",
+		Usage:  "Specifies the `password` of the password credential",
+	},
+	cli.StringFlag{
+		Name:   "access-token",
+		EnvVar: "DOGMA_CREDENTIAL_ACCESS_TOKEN",
+		Usage:  "Specifies the `token` of the access_token credential",
+	},
+	cli.StringFlag{
+		Name:  "public-key",
+		Usage: "Specifies the `file` of the public key of the public_key credential",
+	},
+	cli.StringFlag{
+		Name:  "private-key",
+		Usage: "Specifies the `file` of the private key of the public_key credential",
+	},
+	cli.StringFlag{
+		Name:   "passphrase",
+		EnvVar: "DOGMA_CREDENTIAL_PASSPHRASE",
+		Usage:  "Specifies the `passphrase` of the private key",
+	},
+	cli.BoolFlag{
+		Name:  "disable",
+		Usage: "Specifies whether to disable the credential",
+	},
+}
+
+var yesFlag = cli.BoolFlag{
+	Name:  "yes, y",
+	Usage: "Specifies whether to push the changes without the confirmation",
+}
+
 var printFormatFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "output, o",
+		Usage: "Specifies the `format` of the output for the machines: json, yaml or table",
+	},
 	cli.BoolFlag{
 		Name:   "pretty",
 		Hidden: true,
@@ -82,24 +262,41 @@ const (
 	Pretty
 	Simple
 	JSON
+	YAML
+	Table
 )
 
 func getPrintStyle(c *cli.Context) (PrintStyle, error) {
 	var ps PrintStyle
+	switch output := c.String("output"); output {
+	case "":
+	case "json":
+		ps = JSON
+	case "yaml":
+		ps = YAML
+	case "table":
+		ps = Table
+	default:
+		return 0, fmt.Errorf("unknown output format: %s (expected: json, yaml or table)", output)
+	}
 	if c.Bool("pretty") {
+		if ps != 0 {
+			return 0, fmt.Errorf("duplicate print style (output: %q, pretty: %t, simple: %t, json: %t)\n",
+				c.String("output"), c.Bool("pretty"), c.Bool("simple"), c.Bool("json"))
+		}
 		ps = Pretty
 	}
 	if c.Bool("simple") {
 		if ps != 0 {
-			return 0, fmt.Errorf("duplicate print style (pretty: %t, simple: %t, json: %t)\n",
-				c.Bool("pretty"), c.Bool("simple"), c.Bool("json"))
+			return 0, fmt.Errorf("duplicate print style (output: %q, pretty: %t, simple: %t, json: %t)\n",
+				c.String("output"), c.Bool("pretty"), c.Bool("simple"), c.Bool("json"))
 		}
 		ps = Simple
 	}
 	if c.Bool("json") {
 		if ps != 0 {
-			return 0, fmt.Errorf("duplicate print style (pretty: %t, simple: %t, json: %t)\n",
-				c.Bool("pretty"), c.Bool("simple"), c.Bool("json"))
+			return 0, fmt.Errorf("duplicate print style (output: %q, pretty: %t, simple: %t, json: %t)\n",
+				c.String("output"), c.Bool("pretty"), c.Bool("simple"), c.Bool("json"))
 		}
 		ps = JSON
 	}
@@ -109,19 +306,13 @@ func getPrintStyle(c *cli.Context) (PrintStyle, error) {
 	return ps, nil
 }
 
-func printWithStyle(data interface{}, format PrintStyle) {
-	// TODO implement this method
-	buf, _ := marshalIndentObject(data)
-	fmt.Printf("%s\n", buf)
-}
-
 func newCommandLineError(c *cli.Context) *cli.ExitError {
 	com := c.Command
 	return cli.NewExitError("usage: "+com.Name+" "+com.ArgsUsage, 1)
 }
 
 func CLICommands() []cli.Command {
-	return []cli.Command{
+	commands := []cli.Command{
 		{
 			Name:      "ls",
 			Usage:     "Lists the projects, repositories or files",
@@ -147,8 +338,13 @@ func CLICommands() []cli.Command {
 			Name:      "new",
 			Usage:     "Creates a project or repository",
 			ArgsUsage: "<project_name>[/<repository_name>]",
+			Flags:     printFormatFlags,
 			Action: func(c *cli.Context) error {
-				command, err := newNewCommand(c)
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newNewCommand(c, style)
 				if err != nil {
 					return newCommandLineError(c)
 				}
@@ -163,9 +359,40 @@ func CLICommands() []cli.Command {
 			Name:      "put",
 			Usage:     "Puts a file to the repository",
 			ArgsUsage: "<project_name>/<repository_name>[/<path>] file_path",
-			Flags:     []cli.Flag{revisionFlag, commitMessageFlag},
+			Flags:     append(printFormatFlags, revisionFlag, commitMessageFlag),
+			Action: func(c *cli.Context) error {
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newPutCommand(c, style)
+				if err != nil {
+					return newCommandLineError(c)
+				}
+				err = command.execute(c)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "put-dir",
+			Usage: "Puts the files in a directory to the repository as one commit",
+			Description: `The files under <local_directory_path> are put under <path> keeping their relative paths.
+
+   e.g.
+     # Put the JSON files under ./conf except the ones under ./conf/test to /conf
+     dogma put-dir --include '*.json' --exclude 'test/**' /pj/repo/conf ./conf`,
+			ArgsUsage: "<project_name>/<repository_name>[/<path>] <local_directory_path>",
+			Flags: append(printFormatFlags, revisionFlag, commitMessageFlag, includeFlag, excludeFlag,
+				dryRunFlag),
 			Action: func(c *cli.Context) error {
-				command, err := newPutCommand(c)
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newPutDirCommand(c, style)
 				if err != nil {
 					return newCommandLineError(c)
 				}
@@ -177,12 +404,18 @@ func CLICommands() []cli.Command {
 			},
 		},
 		{
-			Name:      "edit",
-			Usage:     "Edits a file in the path",
+			Name:  "edit",
+			Usage: "Edits a file in the path",
+			Description: `The edited file is validated if it is a JSON or YAML file, and the diff from the file in
+   the repository is shown to confirm the changes before they are pushed.`,
 			ArgsUsage: "<project_name>/<repository_name>/<path>",
-			Flags:     []cli.Flag{revisionFlag, commitMessageFlag},
+			Flags:     append(printFormatFlags, revisionFlag, commitMessageFlag, yesFlag),
 			Action: func(c *cli.Context) error {
-				command, err := newEditCommand(c)
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newEditCommand(c, style)
 				if err != nil {
 					return newCommandLineError(c)
 				}
@@ -210,6 +443,33 @@ func CLICommands() []cli.Command {
 				return nil
 			},
 		},
+		{
+			Name:  "get-dir",
+			Usage: "Downloads the files in a directory or matched by a path pattern",
+			Description: `The files are downloaded to <local_directory_path>, or the current directory if not specified,
+   keeping their paths relative to the directory or the part of the path pattern before its first glob.
+
+   e.g.
+     # Download the files under /conf to ./conf
+     dogma get-dir /pj/repo/conf ./conf`,
+			ArgsUsage: "<project_name>/<repository_name>[/<path or path pattern>] [<local_directory_path>]",
+			Flags:     append(printFormatFlags, revisionFlag, includeFlag, excludeFlag, dryRunFlag),
+			Action: func(c *cli.Context) error {
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newGetDirCommand(c, style)
+				if err != nil {
+					return newCommandLineError(c)
+				}
+				err = command.execute(c)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+				return nil
+			},
+		},
 		{
 			Name:      "cat",
 			Usage:     "Prints a file in the path",
@@ -229,22 +489,29 @@ func CLICommands() []cli.Command {
 		},
 		{
 			Name:  "watch",
-			Usage: "Watches a file in the path",
+			Usage: "Watches a file or the files matched by a path pattern",
 			Description: `Watch events are printed to stdout by default.
+   A path which ends with "/" or contains "*" or "," is a path pattern. The diff from the previous revision
+   is printed for a path pattern, and also for a file if --diff option is specified.
    You can customize this behavior by using --listener <executable> option.
    The command you specified as <executable> can read updated content body through its STDIN.
    Other meta data about the watch event are available via environment variables below.
 
-     DOGMA_WATCH_EVENT_PATH - The path to the file you're watching
-     DOGMA_WATCH_EVENT_CONTENT_TYPE - The content type of the file, JSON or TEXT
+     DOGMA_WATCH_EVENT_PATH - The path to the file or the path pattern you're watching
+     DOGMA_WATCH_EVENT_CONTENT_TYPE - The content type of the file, JSON or TEXT, or DIFF for the diff
      DOGMA_WATCH_EVENT_REV - The revision number of the watch event
-     DOGMA_WATCH_EVENT_URL - The URL of the target file
+     DOGMA_WATCH_EVENT_URL - The URL of the target file, which is not set for the diff
+
+   The diff is given to <executable> as a JSON array of the changes.
 
    e.g.
      # Print foo.json content when it gets updated
-     dogma watch --listener cat /pj/repo/foo.json`,
-			ArgsUsage: "<project_name>/<repository_name>/<path>",
-			Flags:     []cli.Flag{revisionFlag, jsonPathFlag, streamingFlag, listenerFlag},
+     dogma watch --listener cat /pj/repo/foo.json
+
+     # Keep printing the diff of the JSON files under /conf
+     dogma watch --streaming '/pj/repo/conf/*.json'`,
+			ArgsUsage: "<project_name>/<repository_name>/<path or path pattern>",
+			Flags:     []cli.Flag{revisionFlag, jsonPathFlag, streamingFlag, listenerFlag, diffFlag},
 			Action: func(c *cli.Context) error {
 				command, err := newWatchCommand(c)
 				if err != nil {
@@ -261,9 +528,13 @@ func CLICommands() []cli.Command {
 			Name:      "rm",
 			Usage:     "Removes a file in the path",
 			ArgsUsage: "<project_name>/<repository_name>/<path>",
-			Flags:     []cli.Flag{revisionFlag, commitMessageFlag},
+			Flags:     append(printFormatFlags, revisionFlag, commitMessageFlag),
 			Action: func(c *cli.Context) error {
-				command, err := newRMCommand(c)
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newRMCommand(c, style)
 				if err != nil {
 					return newCommandLineError(c)
 				}
@@ -275,37 +546,70 @@ func CLICommands() []cli.Command {
 			},
 		},
 		{
-			Name:      "diff",
-			Usage:     "Gets diff of given path",
-			ArgsUsage: "<project_name>/<repository_name>[/<path>]",
-			Flags:     append(printFormatFlags, fromRevisionFlag, toRevisionFlag),
+			Name:  "diff",
+			Usage: "Gets diff of given path",
+			Description: `The diff between the from and to revisions is printed by default. If <local_file_path> is
+   specified, the diff which turns the file at the revision into the local file is printed instead.
+
+   e.g.
+     # Fail the CI build if the local foo.json is different from the one in the repository
+     dogma diff --format unified --exit-code /pj/repo/foo.json foo.json`,
+			ArgsUsage: "<project_name>/<repository_name>[/<path>] [<local_file_path>]",
+			Flags: append(printFormatFlags, fromRevisionFlag, toRevisionFlag, revisionFlag, diffFormatFlag,
+				colorFlag, exitCodeFlag),
 			Action: func(c *cli.Context) error {
 				style, err := getPrintStyle(c)
 				if err != nil {
 					return err
 				}
-				command, err := newDiffCommand(c, style)
+				format, err := getDiffFormat(c)
+				if err != nil {
+					return err
+				}
+				color, err := getColorEnabled(c)
+				if err != nil {
+					return err
+				}
+				command, err := newDiffCommand(c, style, format, color)
 				if err != nil {
 					return newCommandLineError(c)
 				}
 				err = command.execute(c)
+				if err == errDiffFound {
+					return cli.NewExitError("", 1)
+				}
 				if err != nil {
+					if c.Bool("exit-code") {
+						return cli.NewExitError(err, 2)
+					}
 					return cli.NewExitError(err, 1)
 				}
 				return nil
 			},
 		},
 		{
-			Name:      "log",
-			Usage:     "Shows commit logs of the path",
+			Name:    "log",
+			Aliases: []string{"history"},
+			Usage:   "Shows commit logs of the path",
+			Description: `The commits are printed from the latest one by default. --since, --until and --author
+   filter the commits, and --follow restricts them to the ones which changed the file.
+
+   e.g.
+     # Print the commits of alice which changed foo.json in the last week
+     dogma history --author alice --since 1w --oneline --follow /foo.json pj/repo`,
 			ArgsUsage: "<project_name>/<repository_name>[/<path>]",
-			Flags:     append(printFormatFlags, fromRevisionFlag, toRevisionFlag, maxCommitsFlag),
+			Flags: append(append(printFormatFlags, fromRevisionFlag, toRevisionFlag, maxCommitsFlag),
+				logFilterFlags...),
 			Action: func(c *cli.Context) error {
 				style, err := getPrintStyle(c)
 				if err != nil {
 					return err
 				}
-				command, err := newLogCommand(c, style)
+				filter, err := getCommitFilter(c)
+				if err != nil {
+					return err
+				}
+				command, err := newLogCommand(c, style, filter)
 				if err != nil {
 					return newCommandLineError(c)
 				}
@@ -320,9 +624,43 @@ func CLICommands() []cli.Command {
 			Name:      "normalize",
 			Usage:     "Normalizes a revision into an absolute revision",
 			ArgsUsage: "<project_name>/<repository_name>",
-			Flags:     []cli.Flag{revisionFlag},
+			Flags:     append(printFormatFlags, revisionFlag),
+			Action: func(c *cli.Context) error {
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newNormalizeCommand(c, style)
+				if err != nil {
+					return newCommandLineError(c)
+				}
+				err = command.execute(c)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "cp",
+			Usage: "Copies files to another path, repository or project in one commit",
+			Description: `The source is a file, a directory or a path pattern read at the revision. A file is
+   renamed to the destination unless the destination ends with /, and the files in a directory are
+   copied into the destination directory.
+
+   e.g.
+     # Copy the configurations of the beta project to the production project
+     dogma cp --exclude "*.local.json" beta/conf/ production/conf/`,
+			ArgsUsage: "<project_name>/<repository_name>/<path> " +
+				"<project_name>/<repository_name>[/<path>]",
+			Flags: append(printFormatFlags, revisionFlag, commitMessageFlag, includeFlag, excludeFlag,
+				dryRunFlag),
 			Action: func(c *cli.Context) error {
-				command, err := newNormalizeCommand(c)
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newCopyCommand(c, style, false)
 				if err != nil {
 					return newCommandLineError(c)
 				}
@@ -333,5 +671,469 @@ func CLICommands() []cli.Command {
 				return nil
 			},
 		},
+		{
+			Name:  "mv",
+			Usage: "Moves files to another path, repository or project",
+			Description: `The files are copied as cp does and then removed from the source. They are moved in
+   one commit in the same repository, but removed in another commit after they are copied to another
+   repository.`,
+			ArgsUsage: "<project_name>/<repository_name>/<path> " +
+				"<project_name>/<repository_name>[/<path>]",
+			Flags: append(printFormatFlags, revisionFlag, commitMessageFlag, includeFlag, excludeFlag,
+				dryRunFlag),
+			Action: func(c *cli.Context) error {
+				style, err := getPrintStyle(c)
+				if err != nil {
+					return err
+				}
+				command, err := newCopyCommand(c, style, true)
+				if err != nil {
+					return newCommandLineError(c)
+				}
+				err = command.execute(c)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "sync",
+			Usage: "Mirrors the files in the path to a local directory and keeps them up to date",
+			Description: `The files are written atomically, and only the changed files are written. The files
+   which were synced before are removed when they are removed from the repository, which are recorded in
+   the .dogma-sync file in the local directory. The command specified with --on-change is executed with
+   the DOGMA_SYNC_REV and DOGMA_SYNC_DIR environment variables whenever the files are changed.
+
+   e.g.
+     # Reload nginx whenever its configurations are changed
+     dogma sync --on-change "nginx -s reload" infra/nginx/conf.d/ /etc/nginx/conf.d`,
+			ArgsUsage: "<project_name>/<repository_name>/<path> <local_directory_path>",
+			Flags: []cli.Flag{includeFlag, excludeFlag,
+				cli.StringFlag{
+					Name:  "on-change",
+					Usage: "Specifies the shell `command` to execute after the files are changed",
+				},
+				cli.BoolFlag{
+					Name:  "once",
+					Usage: "Specifies whether to exit after the files are synced once",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				command, err := newSyncCommand(c)
+				if err != nil {
+					return newCommandLineError(c)
+				}
+				err = command.execute(c)
+				if err != nil {
+					return cli.NewExitError(err, 1)
+				}
+				return nil
+			},
+		},
+		{
+			Name:  "token",
+			Usage: "Manages the application tokens",
+			Subcommands: []cli.Command{
+				{
+					Name:      "create",
+					Usage:     "Creates an application token and prints its secret",
+					ArgsUsage: "<app_id>",
+					Flags:     append(printFormatFlags, adminFlag),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newTokenCreateCommand(c, style)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "list",
+					Usage: "Lists the application tokens",
+					Flags: printFormatFlags,
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newTokenListCommand(c, style)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "activate",
+					Usage:     "Activates a deactivated application token",
+					ArgsUsage: "<app_id>",
+					Flags:     printFormatFlags,
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newTokenActivationCommand(c, style, true)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "deactivate",
+					Usage:     "Deactivates an application token",
+					ArgsUsage: "<app_id>",
+					Flags:     printFormatFlags,
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newTokenActivationCommand(c, style, false)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name:  "member",
+			Usage: "Manages the members of a project",
+			Subcommands: []cli.Command{
+				{
+					Name:      "add",
+					Usage:     "Adds a user or an application token to a project",
+					ArgsUsage: "<project_name> (--user <login> | --app <app_id>)",
+					Flags: append(printFormatFlags, userFlag, appFlag, cli.StringFlag{
+						Name:  "role",
+						Value: "member",
+						Usage: "Specifies the `role` of the member: owner, member or guest",
+					}),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						role, err := getProjectRole(c)
+						if err != nil {
+							return err
+						}
+						command, err := newMemberCommand(c, style, role)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "remove",
+					Usage:     "Removes a user or an application token from a project",
+					ArgsUsage: "<project_name> (--user <login> | --app <app_id>)",
+					Flags:     append(printFormatFlags, userFlag, appFlag),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newMemberCommand(c, style, 0)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name:  "perm",
+			Usage: "Manages the permissions of the repositories",
+			Subcommands: []cli.Command{
+				{
+					Name:  "set",
+					Usage: "Sets the permission of a repository for a user, an application token or a role",
+					Description: `The write permission includes the read permission, and none removes the permissions.
+
+   e.g.
+     # Let the members of the project only read the repository
+     dogma perm set --role member --permission read pj/repo`,
+					ArgsUsage: "<project_name>/<repository_name> (--user <login> | --app <app_id> | --role <role>)",
+					Flags: append(printFormatFlags, userFlag, appFlag, permissionFlag, cli.StringFlag{
+						Name:  "role",
+						Usage: "Specifies the `role` whose permission is set: owner, member or guest",
+					}),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						role, err := getProjectRole(c)
+						if err != nil {
+							return err
+						}
+						permissions, err := parsePermissions(c.String("permission"))
+						if err != nil {
+							return err
+						}
+						command, err := newPermSetCommand(c, style, role, permissions)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name:  "mirror",
+			Usage: "Manages the mirrors between the repositories and the Git repositories",
+			Subcommands: []cli.Command{
+				{
+					Name:      "list",
+					Usage:     "Lists the mirrors of a project",
+					ArgsUsage: "<project_name>",
+					Flags:     printFormatFlags,
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newMirrorListCommand(c, style)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:  "create",
+					Usage: "Creates a mirror",
+					Description: `The schedule is validated before the mirror is created. --test also checks whether the
+   local repository and the credential exist and whether the credential can be used for the remote repository.
+
+   e.g.
+     dogma mirror create --repo bar --remote https://github.com/foo/bar.git --credential github foo my-mirror`,
+					ArgsUsage: "<project_name> <mirror_id>",
+					Flags:     append(printFormatFlags, mirrorFlags...),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newMirrorCommand(c, style, false)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "update",
+					Usage:     "Updates the specified fields of a mirror",
+					ArgsUsage: "<project_name> <mirror_id>",
+					Flags:     append(printFormatFlags, mirrorFlags...),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newMirrorCommand(c, style, true)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "delete",
+					Usage:     "Deletes a mirror",
+					ArgsUsage: "<project_name> <mirror_id>",
+					Action: func(c *cli.Context) error {
+						command, err := newMirrorRemoveCommand(c)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name:  "credential",
+			Usage: "Manages the credentials which are used to access the Git repositories",
+			Subcommands: []cli.Command{
+				{
+					Name:      "list",
+					Usage:     "Lists the credentials of a project",
+					ArgsUsage: "<project_name>",
+					Flags:     printFormatFlags,
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						command, err := newCredentialListCommand(c, style)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "create",
+					Usage:     "Creates a credential",
+					ArgsUsage: "<project_name> <credential_id>",
+					Flags:     append(printFormatFlags, credentialFlags...),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						credential, err := newCredentialFromFlags(c)
+						if err != nil {
+							return err
+						}
+						command, err := newCredentialCommand(c, style, credential, false)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "update",
+					Usage:     "Replaces a credential with the specified one",
+					ArgsUsage: "<project_name> <credential_id>",
+					Flags:     append(printFormatFlags, credentialFlags...),
+					Action: func(c *cli.Context) error {
+						style, err := getPrintStyle(c)
+						if err != nil {
+							return err
+						}
+						credential, err := newCredentialFromFlags(c)
+						if err != nil {
+							return err
+						}
+						command, err := newCredentialCommand(c, style, credential, true)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+				{
+					Name:      "delete",
+					Usage:     "Deletes a credential",
+					ArgsUsage: "<project_name> <credential_id>",
+					Action: func(c *cli.Context) error {
+						command, err := newCredentialRemoveCommand(c)
+						if err != nil {
+							return newCommandLineError(c)
+						}
+						err = command.execute(c)
+						if err != nil {
+							return cli.NewExitError(err, 1)
+						}
+						return nil
+					},
+				},
+			},
+		},
+		{
+			Name:  "completion",
+			Usage: "Prints the shell completion script",
+			Description: `The script completes the project names, the repository names and the paths in the repository
+   by querying the server specified with --connect or the profile.
+
+   e.g.
+     # Enable the completion in the current bash session
+     source <(dogma completion bash)
+
+     # Enable the completion in fish
+     dogma completion fish > ~/.config/fish/completions/dogma.fish`,
+			ArgsUsage:    "bash|zsh|fish",
+			BashComplete: completeShells,
+			Action: func(c *cli.Context) error {
+				command, err := newCompletionCommand(c)
+				if err != nil {
+					return newCommandLineError(c)
+				}
+				return command.execute(c)
+			},
+		},
+	}
+	for i := range commands {
+		if strings.Contains(commands[i].ArgsUsage, "<project_name>") {
+			commands[i].BashComplete = completeRepositoryPath
+		}
+		for j := range commands[i].Subcommands {
+			if strings.Contains(commands[i].Subcommands[j].ArgsUsage, "<project_name>") {
+				commands[i].Subcommands[j].BashComplete = completeRepositoryPath
+			}
+		}
 	}
+	return commands
 }
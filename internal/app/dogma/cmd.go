@@ -50,6 +50,20 @@ func getRemoteURL(remoteURL string) (string, error) {
 	return remoteURL, nil
 }
 
+// getRemoteURLFromContext returns the address specified with --connect, or the host of the current profile
+// if --connect is not specified.
+func getRemoteURLFromContext(c *cli.Context) (string, error) {
+	remoteURL := c.GlobalString("connect")
+	if len(remoteURL) == 0 {
+		p, err := currentProfile(c)
+		if err != nil {
+			return "", err
+		}
+		remoteURL = p.host
+	}
+	return getRemoteURL(remoteURL)
+}
+
 type repositoryRequestInfo struct {
 	remoteURL string
 	projName  string
@@ -69,7 +83,7 @@ func newRepositoryRequestInfo(c *cli.Context) (repositoryRequestInfo, error) {
 		return repo, newCommandLineError(c)
 	}
 
-	remoteURL, err := getRemoteURL(c.Parent().String("connect"))
+	remoteURL, err := getRemoteURLFromContext(c)
 	if err != nil {
 		return repo, err
 	}
@@ -144,24 +158,42 @@ func getRemoteFileEntry(c *cli.Context,
 	return entry, nil
 }
 
+// newDogmaClient creates a client which connects to the baseURL. The token and the TLS settings of
+// the current profile are used only if the baseURL is the host of the profile, so that the token is not
+// sent to the server specified with --connect.
 func newDogmaClient(c *cli.Context, baseURL string) (client *centraldogma.Client, err error) {
-	enabled, err := checkIfSecurityEnabled(baseURL)
+	p, err := currentProfile(c)
+	if err != nil {
+		return nil, err
+	}
+	if p.host != baseURL {
+		p = &profile{}
+	}
+	transport, err := p.transport()
+	if err != nil {
+		return nil, err
+	}
+
+	enabled, err := checkIfSecurityEnabled(baseURL, transport)
 	if err != nil {
 		return nil, err
 	}
 
 	if !enabled {
 		// Create a client with the anonymous token.
-		return centraldogma.NewClientWithToken(baseURL, "anonymous", nil)
+		return centraldogma.NewClientWithToken(baseURL, "anonymous", transport)
 	}
 
-	token := c.Parent().String("token")
+	token := c.GlobalString("token")
+	if len(token) == 0 {
+		token = p.token
+	}
 	if len(token) != 0 {
-		if client, err = centraldogma.NewClientWithToken(baseURL, token, nil); err != nil {
+		if client, err = centraldogma.NewClientWithToken(baseURL, token, transport); err != nil {
 			return nil, err
 		}
 	} else {
-		return nil, cli.NewExitError("You must specify a token using '--token'.", 1)
+		return nil, cli.NewExitError("You must specify a token using '--token' or a profile.", 1)
 	}
 
 	return client, nil
@@ -175,9 +207,9 @@ func createQuery(repoPath string, jsonPaths []string) *centraldogma.Query {
 	}
 }
 
-func checkIfSecurityEnabled(baseURL string) (bool, error) {
+func checkIfSecurityEnabled(baseURL string, transport http.RoundTripper) (bool, error) {
 	// Create a client with the anonymous token just to check the security is enabled.
-	client, err := centraldogma.NewClientWithToken(baseURL, "anonymous", nil)
+	client, err := centraldogma.NewClientWithToken(baseURL, "anonymous", transport)
 	if err != nil {
 		return false, err
 	}
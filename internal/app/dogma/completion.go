@@ -0,0 +1,285 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
+)
+
+const (
+	completionTimeout  = 3 * time.Second
+	completionCacheTTL = 30 * time.Second
+)
+
+// completeRepositoryPath prints the candidates of the <project_name>/<repository_name>/<path> argument
+// which is being typed. The completion scripts give the word being typed as the last argument.
+func completeRepositoryPath(c *cli.Context) {
+	if len(c.Args()) != 1 || strings.HasPrefix(c.Args().First(), "-") {
+		// Only the first argument is a repository path. The shell completes the local files for the others.
+		return
+	}
+	remoteURL := c.GlobalString("connect")
+	if len(remoteURL) == 0 {
+		p, err := currentProfile(c)
+		if err != nil || len(p.host) == 0 {
+			// Do not prompt for the server address while completing.
+			return
+		}
+		remoteURL = p.host
+	}
+	cache := loadCompletionCache()
+	candidates := repositoryPathCandidates(c.Args().First(), remoteURL, cache,
+		func() (*centraldogma.Client, error) { return newDogmaClient(c, remoteURL) })
+	cache.save()
+	for _, candidate := range candidates {
+		fmt.Println(candidate)
+	}
+}
+
+// repositoryPathCandidates returns the project names, the repository names or the paths in the repository
+// which complete the partial argument, according to how many "/"s it has.
+func repositoryPathCandidates(partial, remoteURL string, cache *completionCache,
+	newClient func() (*centraldogma.Client, error)) []string {
+	prefix := ""
+	if strings.HasPrefix(partial, "/") {
+		prefix = "/"
+	}
+	split := strings.SplitN(strings.TrimPrefix(partial, "/"), "/", 3)
+
+	var client *centraldogma.Client
+	getClient := func() (*centraldogma.Client, error) {
+		if client != nil {
+			return client, nil
+		}
+		var err error
+		client, err = newClient()
+		return client, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), completionTimeout)
+	defer cancel()
+
+	var candidates []string
+	switch len(split) {
+	case 1:
+		names := cache.get(remoteURL+" projects", func() ([]string, error) {
+			client, err := getClient()
+			if err != nil {
+				return nil, err
+			}
+			projects, httpStatusCode, err := client.ListProjects(ctx)
+			if err != nil || httpStatusCode != http.StatusOK {
+				return nil, fmt.Errorf("failed to get the list of projects (status: %d): %v", httpStatusCode, err)
+			}
+			names := make([]string, len(projects))
+			for i, project := range projects {
+				names[i] = project.Name
+			}
+			return names, nil
+		})
+		for _, name := range names {
+			candidates = append(candidates, prefix+name+"/")
+		}
+	case 2:
+		projName := split[0]
+		names := cache.get(remoteURL+" repos "+projName, func() ([]string, error) {
+			client, err := getClient()
+			if err != nil {
+				return nil, err
+			}
+			repos, httpStatusCode, err := client.ListRepositories(ctx, projName)
+			if err != nil || httpStatusCode != http.StatusOK {
+				return nil, fmt.Errorf("failed to get the list of repositories (status: %d): %v", httpStatusCode, err)
+			}
+			names := make([]string, len(repos))
+			for i, repo := range repos {
+				names[i] = repo.Name
+			}
+			return names, nil
+		})
+		for _, name := range names {
+			candidates = append(candidates, prefix+projName+"/"+name+"/")
+		}
+	default:
+		projName, repoName := split[0], split[1]
+		dir := "/" + split[2][:strings.LastIndex(split[2], "/")+1]
+		paths := cache.get(remoteURL+" files "+projName+"/"+repoName+dir, func() ([]string, error) {
+			client, err := getClient()
+			if err != nil {
+				return nil, err
+			}
+			entries, httpStatusCode, err := client.ListFiles(ctx, projName, repoName, "-1", dir+"*")
+			if err != nil || httpStatusCode != http.StatusOK {
+				return nil, fmt.Errorf("failed to get the list of files (status: %d): %v", httpStatusCode, err)
+			}
+			paths := make([]string, len(entries))
+			for i, entry := range entries {
+				paths[i] = entry.Path
+				if entry.Type == centraldogma.Directory {
+					paths[i] += "/"
+				}
+			}
+			return paths, nil
+		})
+		for _, path := range paths {
+			candidates = append(candidates, prefix+projName+"/"+repoName+path)
+		}
+	}
+
+	// The shells filter the candidates, but filter them here as well for the shells which do not.
+	matched := candidates[:0]
+	for _, candidate := range candidates {
+		if strings.HasPrefix(candidate, partial) {
+			matched = append(matched, candidate)
+		}
+	}
+	sort.Strings(matched)
+	return matched
+}
+
+// A completionCache keeps the lists fetched from the server for a while, so that pressing the tab key
+// repeatedly does not send a request every time.
+type completionCache struct {
+	filePath string
+	now      func() time.Time
+	Entries  map[string]*completionCacheEntry `json:"entries"`
+	dirty    bool
+}
+
+type completionCacheEntry struct {
+	Values    []string  `json:"values"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// loadCompletionCache loads the cache from the user cache directory. An empty cache which is not saved
+// is returned if there is no user cache directory.
+func loadCompletionCache() *completionCache {
+	cache := &completionCache{now: time.Now, Entries: make(map[string]*completionCacheEntry)}
+	if cacheDir, err := os.UserCacheDir(); err == nil {
+		cache.filePath = filepath.Join(cacheDir, "dogma", "completion.json")
+		if b, err := ioutil.ReadFile(cache.filePath); err == nil {
+			_ = json.Unmarshal(b, cache)
+		}
+	}
+	return cache
+}
+
+func (cc *completionCache) get(key string, load func() ([]string, error)) []string {
+	if entry, ok := cc.Entries[key]; ok && cc.now().Before(entry.ExpiresAt) {
+		return entry.Values
+	}
+	values, err := load()
+	if err != nil {
+		return nil
+	}
+	cc.Entries[key] = &completionCacheEntry{Values: values, ExpiresAt: cc.now().Add(completionCacheTTL)}
+	cc.dirty = true
+	return values
+}
+
+func (cc *completionCache) save() {
+	if !cc.dirty || len(cc.filePath) == 0 {
+		return
+	}
+	for key, entry := range cc.Entries {
+		if !cc.now().Before(entry.ExpiresAt) {
+			delete(cc.Entries, key)
+		}
+	}
+	b, err := json.Marshal(cc)
+	if err != nil {
+		return
+	}
+	if err = os.MkdirAll(filepath.Dir(cc.filePath), 0700); err == nil {
+		_ = ioutil.WriteFile(cc.filePath, b, 0600)
+	}
+}
+
+var completionScripts = map[string]string{
+	"bash": `_dogma_complete() {
+  local cur="${COMP_WORDS[COMP_CWORD]}"
+  local IFS=$'\n'
+  COMPREPLY=($(compgen -W "$("${COMP_WORDS[@]:0:$COMP_CWORD}" "$cur" --generate-bash-completion 2>/dev/null)" \
+    -- "$cur"))
+  if [[ ${#COMPREPLY[@]} -eq 1 && ${COMPREPLY[0]} == */ ]]; then
+    compopt -o nospace
+  fi
+}
+complete -o default -F _dogma_complete dogma
+`,
+	"zsh": `#compdef dogma
+_dogma() {
+  local -a candidates
+  candidates=(${(f)"$(${words[1,CURRENT-1]} "${words[CURRENT]}" --generate-bash-completion 2>/dev/null)"})
+  if (( ${#candidates} == 0 )); then
+    _files
+    return
+  fi
+  compadd -S '' -- ${(M)candidates:#*/}
+  compadd -- ${candidates:#*/}
+}
+compdef _dogma dogma
+`,
+	"fish": `function __dogma_complete
+    set -l tokens (commandline -opc)
+    set -l current (commandline -ct)
+    $tokens "$current" --generate-bash-completion 2>/dev/null
+end
+complete -c dogma -a '(__dogma_complete)'
+`,
+}
+
+// A completionCommand prints the completion script of the shell.
+type completionCommand struct {
+	shell string
+}
+
+func (cc *completionCommand) execute(c *cli.Context) error {
+	fmt.Print(completionScripts[cc.shell])
+	return nil
+}
+
+func newCompletionCommand(c *cli.Context) (Command, error) {
+	if len(c.Args()) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	shell := c.Args().First()
+	if _, ok := completionScripts[shell]; !ok {
+		return nil, newCommandLineError(c)
+	}
+	return &completionCommand{shell: shell}, nil
+}
+
+func completeShells(c *cli.Context) {
+	if len(c.Args()) > 1 {
+		return
+	}
+	for _, shell := range []string{"bash", "fish", "zsh"} {
+		if strings.HasPrefix(shell, c.Args().First()) {
+			fmt.Println(shell)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestRepositoryPathCandidates(t *testing.T) {
+	requests := 0
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			switch r.URL.Path {
+			case "/api/v1/projects":
+				fmt.Fprint(w, `[{"name": "foo"}, {"name": "bar"}]`)
+			case "/api/v1/projects/foo/repos":
+				fmt.Fprint(w, `[{"name": "conf"}, {"name": "meta"}]`)
+			case "/api/v1/projects/foo/repos/conf/list/a/*":
+				fmt.Fprint(w, `[{"path": "/a/b.json", "type": "JSON"}, {"path": "/a/c", "type": "DIRECTORY"}]`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+	defer server.Close()
+	newClient := func() (*dogma.Client, error) {
+		return dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+	}
+
+	now := time.Now()
+	cache := &completionCache{
+		now:     func() time.Time { return now },
+		Entries: make(map[string]*completionCacheEntry),
+	}
+
+	tests := []struct {
+		partial      string
+		want         []string
+		wantRequests int
+	}{
+		{"", []string{"bar/", "foo/"}, 1},
+		{"f", []string{"foo/"}, 1}, // cached
+		{"/foo/c", []string{"/foo/conf/"}, 2},
+		{"foo/conf/a/", []string{"foo/conf/a/b.json", "foo/conf/a/c/"}, 3},
+		{"foo/conf/a/b", []string{"foo/conf/a/b.json"}, 3}, // cached
+		{"foo/unknown/", nil, 4},
+	}
+	for _, test := range tests {
+		got := repositoryPathCandidates(test.partial, server.URL, cache, newClient)
+		if len(got) != 0 || len(test.want) != 0 {
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("repositoryPathCandidates(%q) = %q; want %q", test.partial, got, test.want)
+			}
+		}
+		if requests != test.wantRequests {
+			t.Errorf("Got %d requests after completing %q; want %d", requests, test.partial, test.wantRequests)
+		}
+	}
+
+	// The cache expires.
+	now = now.Add(completionCacheTTL)
+	repositoryPathCandidates("", server.URL, cache, newClient)
+	if requests != 5 {
+		t.Errorf("Got %d requests after the cache expired; want 5", requests)
+	}
+}
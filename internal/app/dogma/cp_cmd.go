@@ -0,0 +1,247 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
+)
+
+// A copyCommand copies the files matched by the source path to the destination in one commit. If the source
+// path is a file, the destination is the new path of the file unless it ends with "/". Otherwise, the files are
+// copied into the destination directory keeping their paths relative to the source directory. The source
+// files are removed as well if move is true, in the same commit if the source and destination repositories are
+// the same.
+type copyCommand struct {
+	src    repositoryRequestInfo
+	dst    repositoryRequestInfo
+	filter *fileFilter
+	move   bool
+	dryRun bool
+	style  PrintStyle
+}
+
+// copyOutput is the structured output of a file which is copied.
+type copyOutput struct {
+	Source      string `json:"source"`
+	Destination string `json:"destination"`
+}
+
+// A copyPlan is the changes of the copyCommand.
+type copyPlan struct {
+	upserts []*centraldogma.Change // The changes of the destination files.
+	removes []*centraldogma.Change // The changes of the source files if they are moved.
+	files   []*copyOutput
+}
+
+func (cp *copyCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, cp.src.remoteURL)
+	if err != nil {
+		return err
+	}
+	plan, err := cp.plan(client)
+	if err != nil {
+		return err
+	}
+	if cp.dryRun {
+		return cp.printFiles(plan)
+	}
+	commitMessage, err := getCommitMessage(c, fmt.Sprintf("/%s/%s%s", cp.dst.projName, cp.dst.repoName,
+		cp.dst.path), addition)
+	if err != nil {
+		return err
+	}
+	return cp.push(client, commitMessage, plan)
+}
+
+// plan returns the copyPlan of the files matched by the source path. The source files which are overwritten
+// by the other files in the same repository are not removed.
+func (cp *copyCommand) plan(client *centraldogma.Client) (*copyPlan, error) {
+	src := cp.src
+	pathPattern, singleFile := dirPathPattern(src.path), false
+	if !strings.ContainsAny(src.path, "*,") && !strings.HasSuffix(src.path, "/") {
+		// The path may be a file or a directory.
+		pathPattern = src.path + "," + pathPattern
+		singleFile = true
+	}
+	entries, httpStatusCode, err := client.GetFiles(
+		context.Background(), src.projName, src.repoName, src.revision, pathPattern)
+	if err != nil {
+		return nil, err
+	}
+	if httpStatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the files: /%s/%s%s revision: %q (status: %d)",
+			src.projName, src.repoName, pathPattern, src.revision, httpStatusCode)
+	}
+
+	var files []*centraldogma.Entry
+	for _, entry := range entries {
+		if entry.Type != centraldogma.Directory {
+			files = append(files, entry)
+		}
+	}
+	singleFile = singleFile && len(files) == 1 && files[0].Path == src.path
+	basePath := strings.TrimSuffix(src.path, "/") + "/"
+	if !singleFile && strings.ContainsAny(src.path, "*,") {
+		basePath = patternBasePath(pathPattern)
+	}
+
+	sameRepo := cp.isSameRepo()
+	plan := &copyPlan{}
+	upserted := make(map[string]bool)
+	var moved []*centraldogma.Entry
+	for _, entry := range files {
+		var dstPath string
+		if singleFile {
+			dstPath = cp.dst.path
+			if strings.HasSuffix(dstPath, "/") {
+				dstPath += path.Base(entry.Path)
+			}
+		} else {
+			relPath := strings.TrimPrefix(entry.Path, basePath)
+			if !cp.filter.matches(relPath) {
+				continue
+			}
+			dstPath = path.Join(cp.dst.path, relPath)
+		}
+		if sameRepo && dstPath == entry.Path {
+			return nil, fmt.Errorf("cannot copy /%s/%s%s to itself", src.projName, src.repoName, entry.Path)
+		}
+
+		change := &centraldogma.Change{Path: dstPath}
+		if entry.Type == centraldogma.JSON {
+			change.Type = centraldogma.UpsertJSON
+			change.Content = json.RawMessage(entry.Content)
+		} else {
+			change.Type = centraldogma.UpsertText
+			change.Content = string(entry.Content)
+		}
+		plan.upserts = append(plan.upserts, change)
+		plan.files = append(plan.files, &copyOutput{
+			Source:      fmt.Sprintf("/%s/%s%s", src.projName, src.repoName, entry.Path),
+			Destination: fmt.Sprintf("/%s/%s%s", cp.dst.projName, cp.dst.repoName, dstPath),
+		})
+		upserted[dstPath] = true
+		moved = append(moved, entry)
+	}
+	if len(plan.upserts) == 0 {
+		return nil, fmt.Errorf("no files to copy in /%s/%s%s", src.projName, src.repoName, src.path)
+	}
+
+	if cp.move {
+		for _, entry := range moved {
+			if !sameRepo || !upserted[entry.Path] {
+				plan.removes = append(plan.removes, &centraldogma.Change{Path: entry.Path, Type: centraldogma.Remove})
+			}
+		}
+	}
+	return plan, nil
+}
+
+func (cp *copyCommand) isSameRepo() bool {
+	return cp.src.projName == cp.dst.projName && cp.src.repoName == cp.dst.repoName
+}
+
+func (cp *copyCommand) push(client *centraldogma.Client, commitMessage *centraldogma.CommitMessage,
+	plan *copyPlan) error {
+	src, dst := cp.src, cp.dst
+	changes := plan.upserts
+	if cp.isSameRepo() {
+		changes = append(changes, plan.removes...)
+	}
+	result, httpStatusCode, err := client.Push(context.Background(),
+		dst.projName, dst.repoName, dst.revision, commitMessage, changes)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to push the files to /%s/%s%s revision: %q (status: %d)",
+			dst.projName, dst.repoName, dst.path, dst.revision, httpStatusCode)
+	}
+
+	if !cp.isSameRepo() && len(plan.removes) != 0 {
+		// The files in the other repository cannot be removed in the same commit.
+		_, httpStatusCode, err := client.Push(context.Background(),
+			src.projName, src.repoName, "-1", commitMessage, plan.removes)
+		if err != nil {
+			return fmt.Errorf("copied the files but failed to remove them from /%s/%s: %v",
+				src.projName, src.repoName, err)
+		}
+		if httpStatusCode != http.StatusOK {
+			return fmt.Errorf("copied the files but failed to remove them from /%s/%s (status: %d)",
+				src.projName, src.repoName, httpStatusCode)
+		}
+	}
+
+	paths := make([]string, len(plan.upserts))
+	for i, change := range plan.upserts {
+		paths[i] = change.Path
+	}
+	if !isStructured(cp.style) {
+		if err = cp.printFiles(plan); err != nil {
+			return err
+		}
+	}
+	action := "Copied"
+	if cp.move {
+		action = "Moved"
+	}
+	return printPushResult(cp.style, fmt.Sprintf("%s %d files to /%s/%s%s (revision: %v)",
+		action, len(plan.upserts), dst.projName, dst.repoName, dst.path, result.Revision),
+		dst.projName, dst.repoName, paths, result)
+}
+
+func (cp *copyCommand) printFiles(plan *copyPlan) error {
+	if isStructured(cp.style) {
+		return printWithStyle(plan.files, cp.style)
+	}
+	for _, file := range plan.files {
+		fmt.Printf("%s -> %s\n", file.Source, file.Destination)
+	}
+	return nil
+}
+
+// newCopyCommand creates the copyCommand which moves the files if move is true.
+func newCopyCommand(c *cli.Context, style PrintStyle, move bool) (Command, error) {
+	if len(c.Args()) != 2 {
+		return nil, newCommandLineError(c)
+	}
+	src, err := newRepositoryRequestInfo(c)
+	if err != nil {
+		return nil, err
+	}
+	split := splitPath(c.Args().Get(1))
+	if len(split) < 2 {
+		return nil, newCommandLineError(c)
+	}
+	dst := repositoryRequestInfo{remoteURL: src.remoteURL, projName: split[0], repoName: split[1], path: "/",
+		revision: "-1"}
+	if len(split) > 2 {
+		dst.path = split[2]
+	}
+	filter, err := newFileFilter(c.StringSlice("include"), c.StringSlice("exclude"))
+	if err != nil {
+		return nil, err
+	}
+	return &copyCommand{src: src, dst: dst, filter: filter, move: move, dryRun: c.Bool("dry-run"),
+		style: style}, nil
+}
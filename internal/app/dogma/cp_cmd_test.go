@@ -0,0 +1,145 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+type pushRequest struct {
+	Changes []struct {
+		Path    string          `json:"path"`
+		Type    string          `json:"type"`
+		Content json.RawMessage `json:"content"`
+	} `json:"changes"`
+}
+
+// newCopyTestServer returns the server which responds the entries to the requests of the files, and records
+// the changes of the pushes in the form of "<repository> <type> <path>".
+func newCopyTestServer(t *testing.T, entries string, pushes *[]string) *httptest.Server {
+	return httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			fmt.Fprint(w, entries)
+			return
+		}
+		var push pushRequest
+		b, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(b, &push); err != nil {
+			t.Error(err)
+		}
+		for _, change := range push.Changes {
+			*pushes = append(*pushes, r.URL.Path+" "+change.Type+" "+change.Path)
+		}
+		fmt.Fprint(w, `{"revision":2, "pushedAt":"2026-10-16T00:00:00Z"}`)
+	}))
+}
+
+func TestCopyFile(t *testing.T) {
+	var pushes []string
+	server := newCopyTestServer(t, `[{"path":"/a.json", "type":"JSON", "content":{"a":1}}]`, &pushes)
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	filter, _ := newFileFilter(nil, nil)
+	cp := copyCommand{
+		src:    repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/a.json", revision: "-1"},
+		dst:    repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/b.json", revision: "-1"},
+		filter: filter,
+		move:   true,
+	}
+	plan, err := cp.plan(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	runCommandAndCaptureStdout(func() { err = cp.push(client, &dogma.CommitMessage{Summary: "Rename"}, plan) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"/api/v1/projects/foo/repos/bar/contents UPSERT_JSON /b.json",
+		"/api/v1/projects/foo/repos/bar/contents REMOVE /a.json",
+	}
+	if !reflect.DeepEqual(pushes, want) {
+		t.Errorf("Got pushes %q; want %q", pushes, want)
+	}
+}
+
+func TestCopyDirectory(t *testing.T) {
+	var pushes []string
+	server := newCopyTestServer(t, `[{"path":"/conf/a.json", "type":"JSON", "content":{"a":1}},
+		{"path":"/conf/sub", "type":"DIRECTORY"},
+		{"path":"/conf/sub/b.txt", "type":"TEXT", "content":"b"},
+		{"path":"/conf/test/c.txt", "type":"TEXT", "content":"c"}]`, &pushes)
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	filter, _ := newFileFilter(nil, []string{"test/**"})
+	cp := copyCommand{
+		src:    repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/conf/", revision: "3"},
+		dst:    repositoryRequestInfo{projName: "baz", repoName: "qux", path: "/", revision: "-1"},
+		filter: filter,
+		move:   true,
+	}
+	plan, err := cp.plan(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantFiles := []*copyOutput{
+		{Source: "/foo/bar/conf/a.json", Destination: "/baz/qux/a.json"},
+		{Source: "/foo/bar/conf/sub/b.txt", Destination: "/baz/qux/sub/b.txt"},
+	}
+	if !reflect.DeepEqual(plan.files, wantFiles) {
+		t.Errorf("Got files %+v; want %+v", plan.files, wantFiles)
+	}
+
+	runCommandAndCaptureStdout(func() { err = cp.push(client, &dogma.CommitMessage{Summary: "Move"}, plan) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		"/api/v1/projects/baz/repos/qux/contents UPSERT_JSON /a.json",
+		"/api/v1/projects/baz/repos/qux/contents UPSERT_TEXT /sub/b.txt",
+		"/api/v1/projects/foo/repos/bar/contents REMOVE /conf/a.json",
+		"/api/v1/projects/foo/repos/bar/contents REMOVE /conf/sub/b.txt",
+	}
+	if !reflect.DeepEqual(pushes, want) {
+		t.Errorf("Got pushes %q; want %q", pushes, want)
+	}
+}
+
+func TestCopyToItself(t *testing.T) {
+	var pushes []string
+	server := newCopyTestServer(t, `[{"path":"/a.json", "type":"JSON", "content":{"a":1}}]`, &pushes)
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	filter, _ := newFileFilter(nil, nil)
+	cp := copyCommand{
+		src:    repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/a.json", revision: "-1"},
+		dst:    repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/", revision: "-1"},
+		filter: filter,
+	}
+	if _, err := cp.plan(client); err == nil {
+		t.Error("plan() should return an error when a file is copied to itself")
+	}
+}
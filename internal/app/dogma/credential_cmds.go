@@ -0,0 +1,232 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
+)
+
+// A credentialListCommand lists the credentials of the project which are used for mirroring.
+type credentialListCommand struct {
+	remoteURL string
+	projName  string
+	style     PrintStyle
+}
+
+func (cl *credentialListCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, cl.remoteURL)
+	if err != nil {
+		return err
+	}
+
+	credentials, httpStatusCode, err := client.ListCredentials(context.Background(), cl.projName)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the list of credentials in %s. (status: %d)", cl.projName,
+			httpStatusCode)
+	}
+	return printWithStyle(credentials, cl.style)
+}
+
+// A credentialCommand creates a credential, or replaces the credential of the same ID if update is true.
+type credentialCommand struct {
+	remoteURL  string
+	projName   string
+	credential *centraldogma.Credential
+	update     bool
+	style      PrintStyle
+}
+
+func (cc *credentialCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, cc.remoteURL)
+	if err != nil {
+		return err
+	}
+	return cc.executeWithDogmaClient(client)
+}
+
+func (cc *credentialCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	action, message := "create", "Created"
+	createCredential := client.CreateCredential
+	if cc.update {
+		action, message = "update", "Updated"
+		createCredential = client.UpdateCredential
+	}
+	httpStatusCode, err := createCredential(context.Background(), cc.projName, cc.credential)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK && httpStatusCode != http.StatusCreated {
+		return fmt.Errorf("failed to %s the credential %s of %s (status: %d)", action, cc.credential.ID,
+			cc.projName, httpStatusCode)
+	}
+	if isStructured(cc.style) {
+		// Do not print the secrets.
+		return printWithStyle(&centraldogma.Credential{ID: cc.credential.ID, Type: cc.credential.Type,
+			HostnamePatterns: cc.credential.HostnamePatterns, Enabled: cc.credential.Enabled,
+			Username: cc.credential.Username}, cc.style)
+	}
+	fmt.Printf("%s the credential: %s\n", message, cc.credential.ID)
+	return nil
+}
+
+// A credentialRemoveCommand removes a credential.
+type credentialRemoveCommand struct {
+	remoteURL string
+	projName  string
+	id        string
+}
+
+func (cr *credentialRemoveCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, cr.remoteURL)
+	if err != nil {
+		return err
+	}
+
+	httpStatusCode, err := client.RemoveCredential(context.Background(), cr.projName, cr.id)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK && httpStatusCode != http.StatusNoContent {
+		return fmt.Errorf("failed to remove the credential %s of %s (status: %d)", cr.id, cr.projName,
+			httpStatusCode)
+	}
+	fmt.Printf("Removed the credential: %s\n", cr.id)
+	return nil
+}
+
+// validateCredential returns an error if the credential misses a field which its type requires.
+func validateCredential(credential *centraldogma.Credential) error {
+	var missing []string
+	switch credential.Type {
+	case "none":
+	case "password":
+		if len(credential.Username) == 0 {
+			missing = append(missing, "--username")
+		}
+		if len(credential.Password) == 0 {
+			missing = append(missing, "--password")
+		}
+	case "access_token":
+		if len(credential.AccessToken) == 0 {
+			missing = append(missing, "--access-token")
+		}
+	case "public_key":
+		if len(credential.Username) == 0 {
+			missing = append(missing, "--username")
+		}
+		if len(credential.PublicKey) == 0 {
+			missing = append(missing, "--public-key")
+		}
+		if len(credential.PrivateKey) == 0 {
+			missing = append(missing, "--private-key")
+		}
+	default:
+		return fmt.Errorf("unknown credential type: %s (expected: none, password, access_token or public_key)",
+			credential.Type)
+	}
+	if len(missing) != 0 {
+		return fmt.Errorf("the %s credential requires %s", credential.Type, strings.Join(missing, ", "))
+	}
+	return nil
+}
+
+// newCredentialFromFlags creates the credential of the <project_name> <id> arguments from the flags. The keys
+// are read from the files.
+func newCredentialFromFlags(c *cli.Context) (*centraldogma.Credential, error) {
+	_, id, err := mirrorArgs(c)
+	if err != nil {
+		return nil, err
+	}
+	credential := &centraldogma.Credential{
+		ID:               id,
+		Type:             strings.ToLower(strings.Replace(c.String("type"), "-", "_", -1)),
+		HostnamePatterns: c.StringSlice("hostname"),
+		Enabled:          !c.Bool("disable"),
+		Username:         c.String("username"),
+		Password:         c.String("password"),
+		AccessToken:      c.String("access-token"),
+		Passphrase:       c.String("passphrase"),
+	}
+	for _, key := range []struct {
+		flag  string
+		value *string
+	}{{"public-key", &credential.PublicKey}, {"private-key", &credential.PrivateKey}} {
+		if filePath := c.String(key.flag); len(filePath) != 0 {
+			b, err := ioutil.ReadFile(filePath)
+			if err != nil {
+				return nil, err
+			}
+			*key.value = string(b)
+		}
+	}
+	if err := validateCredential(credential); err != nil {
+		return nil, err
+	}
+	return credential, nil
+}
+
+// newCredentialListCommand creates the credentialListCommand.
+func newCredentialListCommand(c *cli.Context, style PrintStyle) (Command, error) {
+	if len(c.Args()) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	split := splitPath(c.Args().First())
+	if len(split) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &credentialListCommand{remoteURL: remoteURL, projName: split[0], style: style}, nil
+}
+
+// newCredentialCommand creates the credentialCommand.
+func newCredentialCommand(c *cli.Context, style PrintStyle, credential *centraldogma.Credential,
+	update bool) (Command, error) {
+	projName, _, err := mirrorArgs(c)
+	if err != nil {
+		return nil, err
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &credentialCommand{remoteURL: remoteURL, projName: projName, credential: credential, update: update,
+		style: style}, nil
+}
+
+// newCredentialRemoveCommand creates the credentialRemoveCommand.
+func newCredentialRemoveCommand(c *cli.Context) (Command, error) {
+	projName, id, err := mirrorArgs(c)
+	if err != nil {
+		return nil, err
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &credentialRemoveCommand{remoteURL: remoteURL, projName: projName, id: id}, nil
+}
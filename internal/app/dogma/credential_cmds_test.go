@@ -0,0 +1,41 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"testing"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestValidateCredential(t *testing.T) {
+	var tests = []struct {
+		credential dogma.Credential
+		valid      bool
+	}{
+		{dogma.Credential{Type: "none"}, true},
+		{dogma.Credential{Type: "password", Username: "foo", Password: "bar"}, true},
+		{dogma.Credential{Type: "password", Username: "foo"}, false},
+		{dogma.Credential{Type: "access_token", AccessToken: "foo"}, true},
+		{dogma.Credential{Type: "public_key", Username: "git", PublicKey: "ssh-ed25519 AAAA"}, false},
+		{dogma.Credential{Type: "ssh"}, false},
+	}
+
+	for _, test := range tests {
+		if err := validateCredential(&test.credential); (err == nil) != test.valid {
+			t.Errorf("validateCredential(%+v) = %v, want valid: %t", test.credential, err, test.valid)
+		}
+	}
+}
@@ -16,49 +16,326 @@ package main
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"os"
+	"reflect"
+	"strings"
 
 	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
 )
 
-// A diffCommand returns a diff of the specified path between the from revision and to revision.
+type diffFormat int
+
+const (
+	jsonPatchFormat diffFormat = iota
+	unifiedFormat
+	summaryFormat
+)
+
+// errDiffFound is returned by the diffCommand with --exit-code option when there are differences.
+var errDiffFound = errors.New("found differences")
+
+func getDiffFormat(c *cli.Context) (diffFormat, error) {
+	switch format := c.String("format"); format {
+	case "", "jsonpatch":
+		return jsonPatchFormat, nil
+	case "unified":
+		return unifiedFormat, nil
+	case "summary":
+		return summaryFormat, nil
+	default:
+		return 0, fmt.Errorf("unknown diff format: %s (expected: unified, jsonpatch or summary)", format)
+	}
+}
+
+func getColorEnabled(c *cli.Context) (bool, error) {
+	switch color := c.String("color"); color {
+	case "", "auto":
+		return isTerminal(os.Stdout), nil
+	case "always":
+		return true, nil
+	case "never":
+		return false, nil
+	default:
+		return false, fmt.Errorf("unknown color mode: %s (expected: auto, always or never)", color)
+	}
+}
+
+// A diffCommand returns a diff of the specified path between the from revision and to revision, or between
+// the remote file and the local file.
 type diffCommand struct {
-	repo  repositoryRequestInfoWithFromTo
-	style PrintStyle
+	repo          repositoryRequestInfoWithFromTo
+	style         PrintStyle
+	format        diffFormat
+	localFilePath string
+	revision      string // The revision of the remote file which is compared with the local file.
+	color         bool
+	exitCode      bool
 }
 
 func (d *diffCommand) execute(c *cli.Context) error {
-	repo := d.repo
-	client, err := newDogmaClient(c, repo.remoteURL)
+	client, err := newDogmaClient(c, d.repo.remoteURL)
 	if err != nil {
 		return err
 	}
+	return d.executeWithDogmaClient(client)
+}
+
+// A fileDiff is the difference of a file.
+type fileDiff struct {
+	change  *centraldogma.Change
+	unified string // The unified diff, which is set only for unifiedFormat.
+}
 
+// summaryOutput and unifiedDiffOutput are the structured outputs of summaryFormat and unifiedFormat.
+type summaryOutput struct {
+	Status  string `json:"status"`
+	Path    string `json:"path"`
+	NewPath string `json:"newPath,omitempty"`
+}
+
+type unifiedDiffOutput struct {
+	Path string `json:"path"`
+	Diff string `json:"diff"`
+}
+
+func (d *diffCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	var diffs []*fileDiff
+	var err error
+	if len(d.localFilePath) != 0 {
+		diffs, err = d.diffLocalFile(client)
+	} else {
+		diffs, err = d.diffRevisions(client)
+	}
+	if err != nil {
+		return err
+	}
+
+	if err = d.print(diffs); err != nil {
+		return err
+	}
+	if d.exitCode && len(diffs) != 0 {
+		return errDiffFound
+	}
+	return nil
+}
+
+func (d *diffCommand) print(diffs []*fileDiff) error {
+	if isStructured(d.style) {
+		outputs := make([]interface{}, len(diffs))
+		for i, diff := range diffs {
+			switch d.format {
+			case summaryFormat:
+				output := &summaryOutput{Status: changeStatus(diff.change), Path: diff.change.Path}
+				if diff.change.Type == centraldogma.Rename {
+					output.NewPath = fmt.Sprint(diff.change.Content)
+				}
+				outputs[i] = output
+			case unifiedFormat:
+				outputs[i] = &unifiedDiffOutput{Path: diff.change.Path, Diff: diff.unified}
+			default:
+				outputs[i] = diff.change
+			}
+		}
+		return printWithStyle(outputs, d.style)
+	}
+
+	for _, diff := range diffs {
+		switch d.format {
+		case summaryFormat:
+			fmt.Print(d.summaryLine(diff.change))
+		case unifiedFormat:
+			fmt.Print(d.colorizeDiff(diff.unified))
+		default:
+			data, err := marshalIndentObject(diff.change)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%s\n", data)
+		}
+	}
+	return nil
+}
+
+func (d *diffCommand) diffRevisions(client *centraldogma.Client) ([]*fileDiff, error) {
+	repo := d.repo
 	changes, httpStatusCode, err := client.GetDiffs(
 		context.Background(), repo.projName, repo.repoName, repo.from, repo.to, repo.path)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if httpStatusCode != http.StatusOK {
-		return fmt.Errorf("failed to get the diff of /%s/%s%s from: %q, to: %q (status: %d)",
+		return nil, fmt.Errorf("failed to get the diff of /%s/%s%s from: %q, to: %q (status: %d)",
 			repo.projName, repo.repoName, repo.path, repo.from, repo.to, httpStatusCode)
 	}
 
-	for _, change := range changes {
-		data, err := marshalIndentObject(change)
+	diffs := make([]*fileDiff, len(changes))
+	for i, change := range changes {
+		diffs[i] = &fileDiff{change: change}
+		if d.format == unifiedFormat {
+			if diffs[i].unified, err = d.unifiedDiffOfChange(client, change); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return diffs, nil
+}
+
+// unifiedDiffOfChange returns the unified diff of the file changed by the change, which is made from
+// the contents of the file at the from and to revisions because the server gives a JSON file's diff
+// as a JSON patch.
+func (d *diffCommand) unifiedDiffOfChange(client *centraldogma.Client, change *centraldogma.Change) (string, error) {
+	repo := d.repo
+	fromName, toName := "a"+change.Path, "b"+change.Path
+	var from, to string
+	var err error
+	if change.Type == centraldogma.UpsertJSON || change.Type == centraldogma.UpsertText {
+		fromName = "/dev/null"
+	} else if from, err = getEntryText(client, repo.projName, repo.repoName, change.Path, repo.from); err != nil {
+		return "", err
+	}
+	if change.Type == centraldogma.Remove {
+		toName = "/dev/null"
+	} else if to, err = getEntryText(client, repo.projName, repo.repoName, change.Path, repo.to); err != nil {
+		return "", err
+	}
+	return unifiedDiff(fromName, toName, from, to), nil
+}
+
+// diffLocalFile returns the diff which turns the remote file into the local file. The JSON files are
+// compared by their values so that the differences of the formatting are ignored.
+func (d *diffCommand) diffLocalFile(client *centraldogma.Client) ([]*fileDiff, error) {
+	repo := d.repo
+	local, err := ioutil.ReadFile(d.localFilePath)
+	if err != nil {
+		return nil, err
+	}
+	entry, httpStatusCode, err := client.GetFile(context.Background(), repo.projName, repo.repoName, d.revision,
+		&centraldogma.Query{Path: repo.path, Type: centraldogma.Identity})
+	remoteExists := httpStatusCode != http.StatusNotFound
+	if remoteExists {
 		if err != nil {
-			return err
+			return nil, err
+		}
+		if httpStatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to get the file: /%s/%s%s revision: %q (status: %d)",
+				repo.projName, repo.repoName, repo.path, d.revision, httpStatusCode)
 		}
-		fmt.Printf("%s\n", data)
 	}
 
-	return nil
+	var change *centraldogma.Change
+	fromName, toName := "a"+repo.path, d.localFilePath
+	var from, to string
+	if strings.HasSuffix(strings.ToLower(repo.path), ".json") {
+		var localValue, remoteValue interface{}
+		if err = json.Unmarshal(local, &localValue); err != nil {
+			return nil, fmt.Errorf("not a valid JSON file: %s", d.localFilePath)
+		}
+		to = string(safeMarshalIndent(local)) + "\n"
+		if !remoteExists {
+			change = &centraldogma.Change{Path: repo.path, Type: centraldogma.UpsertJSON, Content: localValue}
+		} else {
+			if err = json.Unmarshal(entry.Content, &remoteValue); err != nil {
+				return nil, err
+			}
+			if reflect.DeepEqual(localValue, remoteValue) {
+				return nil, nil
+			}
+			from = string(safeMarshalIndent(entry.Content)) + "\n"
+			change = &centraldogma.Change{Path: repo.path, Type: centraldogma.ApplyJSONPatch,
+				Content: diffJSON("", remoteValue, localValue)}
+		}
+	} else {
+		to = string(local)
+		if !remoteExists {
+			change = &centraldogma.Change{Path: repo.path, Type: centraldogma.UpsertText, Content: to}
+		} else {
+			if from = string(entry.Content); from == to {
+				return nil, nil
+			}
+			change = &centraldogma.Change{Path: repo.path, Type: centraldogma.ApplyTextPatch,
+				Content: unifiedDiff(fromName, toName, from, to)}
+		}
+	}
+	if !remoteExists {
+		fromName = "/dev/null"
+	}
+
+	return []*fileDiff{{change: change, unified: unifiedDiff(fromName, toName, from, to)}}, nil
+}
+
+// changeStatus returns the status of the changed file as git status --short does: A for added, D for
+// removed, R for renamed and M for modified.
+func changeStatus(change *centraldogma.Change) string {
+	switch change.Type {
+	case centraldogma.UpsertJSON, centraldogma.UpsertText:
+		return "A"
+	case centraldogma.Remove:
+		return "D"
+	case centraldogma.Rename:
+		return "R"
+	default:
+		return "M"
+	}
+}
+
+func (d *diffCommand) summaryLine(change *centraldogma.Change) string {
+	switch status := changeStatus(change); status {
+	case "A":
+		return d.colorizeLine(status+" "+change.Path, colorGreen)
+	case "D":
+		return d.colorizeLine(status+" "+change.Path, colorRed)
+	case "R":
+		return d.colorizeLine(fmt.Sprintf("R %s -> %v", change.Path, change.Content), colorCyan)
+	default:
+		return d.colorizeLine(status+" "+change.Path, colorYellow)
+	}
+}
+
+func (d *diffCommand) colorizeLine(line, color string) string {
+	if d.color {
+		line = colorize(line, color)
+	}
+	return line + "\n"
+}
+
+func (d *diffCommand) colorizeDiff(diff string) string {
+	if d.color {
+		return colorizeUnifiedDiff(diff)
+	}
+	return diff
+}
+
+// getEntryText returns the content of the file at the revision, which is indented if it is a JSON file.
+// An empty string is returned if the file does not exist.
+func getEntryText(client *centraldogma.Client, projName, repoName, repoPath, revision string) (string, error) {
+	entry, httpStatusCode, err := client.GetFile(context.Background(), projName, repoName, revision,
+		&centraldogma.Query{Path: repoPath, Type: centraldogma.Identity})
+	if httpStatusCode == http.StatusNotFound {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if httpStatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get the file: /%s/%s%s revision: %q (status: %d)",
+			projName, repoName, repoPath, revision, httpStatusCode)
+	}
+	if entry.Type == centraldogma.JSON {
+		return string(safeMarshalIndent(entry.Content)) + "\n", nil
+	}
+	return string(entry.Content), nil
 }
 
 // newDiffCommand creates the diffCommand. If the from and to are not specified, from revision will be 1 and
-// to revision will be -1 respectively.
-func newDiffCommand(c *cli.Context, style PrintStyle) (Command, error) {
+// to revision will be -1 respectively. If the local file is specified, the remote file at the revision is
+// compared with it.
+func newDiffCommand(c *cli.Context, style PrintStyle, format diffFormat, color bool) (Command, error) {
 	repo, err := newRepositoryRequestInfo(c)
 	if err != nil {
 		return nil, err
@@ -77,5 +354,13 @@ func newDiffCommand(c *cli.Context, style PrintStyle) (Command, error) {
 	} else {
 		repoWithFromTo.to = "-1"
 	}
-	return &diffCommand{repo: repoWithFromTo, style: style}, nil
+	d := &diffCommand{repo: repoWithFromTo, style: style, format: format, revision: repo.revision, color: color,
+		exitCode: c.Bool("exit-code")}
+	if len(c.Args()) == 2 && len(c.Args().Get(1)) != 0 {
+		if repo.path == "/" || strings.HasSuffix(repo.path, "/") {
+			return nil, errors.New("the path of the file to compare with the local file is required")
+		}
+		d.localFilePath = c.Args().Get(1)
+	}
+	return d, nil
 }
@@ -0,0 +1,128 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want string
+	}{
+		{"a\nb\n", "a\nb\n", ""},
+		{"a\nb\nc\n", "a\nB\nc\n", "--- from\n+++ to\n@@ -1,3 +1,3 @@\n a\n-b\n+B\n c\n"},
+		{"", "a\n", "--- from\n+++ to\n@@ -0,0 +1 @@\n+a\n"},
+		{"a\n", "", "--- from\n+++ to\n@@ -1 +0,0 @@\n-a\n"},
+		{"a", "b", "--- from\n+++ to\n@@ -1 +1 @@\n-a\n\\ No newline at end of file\n+b\n" +
+			"\\ No newline at end of file\n"},
+		{
+			"1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			"0\n1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n",
+			"--- from\n+++ to\n@@ -1,3 +1,4 @@\n+0\n 1\n 2\n 3\n@@ -9,4 +10,3 @@\n 9\n 10\n 11\n-12\n",
+		},
+	}
+	for _, test := range tests {
+		if got := unifiedDiff("from", "to", test.from, test.to); got != test.want {
+			t.Errorf("unifiedDiff(%q, %q) = %q; want %q", test.from, test.to, got, test.want)
+		}
+	}
+}
+
+func TestDiffJSON(t *testing.T) {
+	source := map[string]interface{}{"a": "A", "b": []interface{}{1.0, 2.0}, "c": map[string]interface{}{"d": false}}
+	target := map[string]interface{}{"b": []interface{}{1.0, 3.0}, "c": map[string]interface{}{"d": true}, "e/f": 1.0}
+	got := fmt.Sprint(diffJSON("", source, target))
+	want := "[map[op:remove path:/a] map[op:replace path:/b/1 value:3] map[op:replace path:/c/d value:true] " +
+		"map[op:add path:/e~1f value:1]]"
+	if got != want {
+		t.Errorf("diffJSON() = %s; want %s", got, want)
+	}
+}
+
+func mockedFileServer(content string) *httptest.Server {
+	return httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if content == "" {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"exception": "com.linecorp.centraldogma.common.EntryNotFoundException"}`)
+				return
+			}
+			fmt.Fprintf(w, `{"path": "/foo.json", "type": "JSON", "content": %s, "revision": 2}`, content)
+		}))
+}
+
+func TestDiffLocalFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dogma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	localFilePath := filepath.Join(dir, "foo.json")
+	if err = ioutil.WriteFile(localFilePath, []byte(`{"foo": "BAR"}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		remote  string
+		format  diffFormat
+		want    string
+		wantErr error
+	}{
+		{`{"foo":"BAR"}`, unifiedFormat, "", nil},
+		{`{"foo":"FOO"}`, summaryFormat, "M /foo.json\n", errDiffFound},
+		{"", summaryFormat, "A /foo.json\n", errDiffFound},
+		{`{"foo":"FOO"}`, unifiedFormat, "--- a/foo.json\n+++ " + localFilePath + "\n@@ -1,3 +1,3 @@\n {\n" +
+			"-  \"foo\": \"FOO\"\n+  \"foo\": \"BAR\"\n }\n", errDiffFound},
+		{`{"foo":"FOO"}`, jsonPatchFormat, `"op": "replace"`, errDiffFound},
+	}
+	for _, test := range tests {
+		server := mockedFileServer(test.remote)
+		client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+		d := diffCommand{
+			repo: repositoryRequestInfoWithFromTo{remoteURL: server.URL, projName: "test", repoName: "test",
+				path: "/foo.json"},
+			format:        test.format,
+			localFilePath: localFilePath,
+			revision:      "-1",
+			exitCode:      true,
+		}
+
+		var err error
+		out := string(runCommandAndCaptureStdout(func() { err = d.executeWithDogmaClient(client) }))
+		server.Close()
+		if err != test.wantErr {
+			t.Errorf("Got error %v; want %v", err, test.wantErr)
+		}
+		if test.format == jsonPatchFormat {
+			if !strings.Contains(out, test.want) {
+				t.Errorf("Got output %q; want to contain %q", out, test.want)
+			}
+		} else if out != test.want {
+			t.Errorf("Got output %q; want %q", out, test.want)
+		}
+	}
+}
@@ -0,0 +1,262 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const diffContextLines = 3
+
+type diffLine struct {
+	op   byte // ' ', '-' or '+'
+	text string
+}
+
+// unifiedDiff returns the unified diff of the from and to texts, or an empty string if they are the same.
+func unifiedDiff(fromName, toName, from, to string) string {
+	lines := diffLines(splitLines(from), splitLines(to))
+
+	// The number of the old and new lines before each line.
+	oldNo := make([]int, len(lines)+1)
+	newNo := make([]int, len(lines)+1)
+	var changed []int
+	for i, line := range lines {
+		oldNo[i+1], newNo[i+1] = oldNo[i], newNo[i]
+		if line.op != '+' {
+			oldNo[i+1]++
+		}
+		if line.op != '-' {
+			newNo[i+1]++
+		}
+		if line.op != ' ' {
+			changed = append(changed, i)
+		}
+	}
+	if len(changed) == 0 {
+		return ""
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", fromName, toName)
+	for i := 0; i < len(changed); {
+		start := maxInt(changed[i]-diffContextLines, 0)
+		j := i
+		// Merge the changes whose contexts overlap into one hunk.
+		for j+1 < len(changed) && changed[j+1]-changed[j] <= 2*diffContextLines {
+			j++
+		}
+		end := minInt(changed[j]+diffContextLines+1, len(lines))
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n",
+			hunkRange(oldNo[start], oldNo[end]), hunkRange(newNo[start], newNo[end]))
+		for _, line := range lines[start:end] {
+			buf.WriteByte(line.op)
+			buf.WriteString(line.text)
+			if !strings.HasSuffix(line.text, "\n") {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = j + 1
+	}
+	return buf.String()
+}
+
+func hunkRange(before, after int) string {
+	count := after - before
+	if count == 0 {
+		return fmt.Sprintf("%d,0", before)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", before+1)
+	}
+	return fmt.Sprintf("%d,%d", before+1, count)
+}
+
+func splitLines(s string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit from a to b using the longest common subsequence of the lines between
+// the common prefix and suffix, which is fast enough for the files in a repository.
+func diffLines(a, b []string) []diffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+	midA, midB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of midA[i:] and midB[j:].
+	lcs := make([][]int, len(midA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(midB)+1)
+	}
+	for i := len(midA) - 1; i >= 0; i-- {
+		for j := len(midB) - 1; j >= 0; j-- {
+			if midA[i] == midB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = maxInt(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	lines := make([]diffLine, 0, len(a)+len(b)-prefix-suffix)
+	for _, line := range a[:prefix] {
+		lines = append(lines, diffLine{' ', line})
+	}
+	i, j := 0, 0
+	for i < len(midA) || j < len(midB) {
+		switch {
+		case i < len(midA) && j < len(midB) && midA[i] == midB[j]:
+			lines = append(lines, diffLine{' ', midA[i]})
+			i++
+			j++
+		case j == len(midB) || (i < len(midA) && lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', midA[i]})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', midB[j]})
+			j++
+		}
+	}
+	for _, line := range a[len(a)-suffix:] {
+		lines = append(lines, diffLine{' ', line})
+	}
+	return lines
+}
+
+// diffJSON returns the JSON patch operations which turn the source into the target. The arrays whose lengths
+// differ are replaced as a whole.
+func diffJSON(pointer string, source, target interface{}) []map[string]interface{} {
+	sourceObject, sourceIsObject := source.(map[string]interface{})
+	targetObject, targetIsObject := target.(map[string]interface{})
+	if sourceIsObject && targetIsObject {
+		keys := make([]string, 0, len(sourceObject)+len(targetObject))
+		for key := range sourceObject {
+			keys = append(keys, key)
+		}
+		for key := range targetObject {
+			if _, ok := sourceObject[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		var operations []map[string]interface{}
+		for _, key := range keys {
+			child := pointer + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(key)
+			sourceValue, inSource := sourceObject[key]
+			targetValue, inTarget := targetObject[key]
+			switch {
+			case !inTarget:
+				operations = append(operations, map[string]interface{}{"op": "remove", "path": child})
+			case !inSource:
+				operations = append(operations,
+					map[string]interface{}{"op": "add", "path": child, "value": targetValue})
+			default:
+				operations = append(operations, diffJSON(child, sourceValue, targetValue)...)
+			}
+		}
+		return operations
+	}
+
+	sourceArray, sourceIsArray := source.([]interface{})
+	targetArray, targetIsArray := target.([]interface{})
+	if sourceIsArray && targetIsArray && len(sourceArray) == len(targetArray) {
+		var operations []map[string]interface{}
+		for i := range sourceArray {
+			child := fmt.Sprintf("%s/%d", pointer, i)
+			operations = append(operations, diffJSON(child, sourceArray[i], targetArray[i])...)
+		}
+		return operations
+	}
+
+	if reflect.DeepEqual(source, target) {
+		return nil
+	}
+	return []map[string]interface{}{{"op": "replace", "path": pointer, "value": target}}
+}
+
+const (
+	colorReset  = "\x1b[0m"
+	colorBold   = "\x1b[1m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+	colorCyan   = "\x1b[36m"
+)
+
+// colorizeUnifiedDiff colors the lines of the unified diff as git does.
+func colorizeUnifiedDiff(diff string) string {
+	var buf strings.Builder
+	for _, line := range splitLines(diff) {
+		color := ""
+		switch {
+		case strings.HasPrefix(line, "---") || strings.HasPrefix(line, "+++"):
+			color = colorBold
+		case strings.HasPrefix(line, "@@"):
+			color = colorCyan
+		case strings.HasPrefix(line, "-"):
+			color = colorRed
+		case strings.HasPrefix(line, "+"):
+			color = colorGreen
+		}
+		buf.WriteString(colorize(strings.TrimSuffix(line, "\n"), color))
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+func colorize(s, color string) string {
+	if color == "" {
+		return s
+	}
+	return color + s + colorReset
+}
+
+// isTerminal returns true if the file is a terminal rather than a file or a pipe.
+func isTerminal(f *os.File) bool {
+	fileInfo, err := f.Stat()
+	return err == nil && fileInfo.Mode()&os.ModeCharDevice != 0
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
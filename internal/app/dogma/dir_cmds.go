@@ -0,0 +1,389 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
+)
+
+// A fileFilter selects the files whose relative paths match one of the include globs and none of
+// the exclude globs. A glob without "/" matches the file name, and "**" matches any number of directories.
+type fileFilter struct {
+	includes []*regexp.Regexp
+	excludes []*regexp.Regexp
+}
+
+func newFileFilter(includes, excludes []string) (*fileFilter, error) {
+	f := &fileFilter{}
+	var err error
+	if f.includes, err = compileGlobs(includes); err != nil {
+		return nil, err
+	}
+	if f.excludes, err = compileGlobs(excludes); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *fileFilter) matches(relPath string) bool {
+	if len(f.includes) != 0 && !matchesAny(f.includes, relPath) {
+		return false
+	}
+	return !matchesAny(f.excludes, relPath)
+}
+
+func matchesAny(globs []*regexp.Regexp, relPath string) bool {
+	for _, glob := range globs {
+		if glob.MatchString(relPath) {
+			return true
+		}
+	}
+	return false
+}
+
+func compileGlobs(globs []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(globs))
+	for _, glob := range globs {
+		regex, err := globToRegexp(glob)
+		if err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %v", glob, err)
+		}
+		compiled = append(compiled, regex)
+	}
+	return compiled, nil
+}
+
+func globToRegexp(glob string) (*regexp.Regexp, error) {
+	glob = strings.TrimPrefix(glob, "/")
+	var buf strings.Builder
+	if !strings.Contains(glob, "/") {
+		// Match the file name in any directory.
+		buf.WriteString("^(.*/)?")
+	} else {
+		buf.WriteString("^")
+	}
+	for i := 0; i < len(glob); i++ {
+		switch ch := glob[i]; {
+		case strings.HasPrefix(glob[i:], "**/"):
+			buf.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			buf.WriteString(".*")
+			i++
+		case ch == '*':
+			buf.WriteString("[^/]*")
+		case ch == '?':
+			buf.WriteString("[^/]")
+		default:
+			buf.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	buf.WriteString("$")
+	return regexp.Compile(buf.String())
+}
+
+// A putDirCommand puts the files under a local directory to the specified path on the remote Central Dogma
+// server as one commit.
+type putDirCommand struct {
+	repo         repositoryRequestInfo
+	localDirPath string
+	filter       *fileFilter
+	dryRun       bool
+	style        PrintStyle
+}
+
+func (pd *putDirCommand) execute(c *cli.Context) error {
+	changes, err := pd.changes()
+	if err != nil {
+		return err
+	}
+	if pd.dryRun {
+		return pd.printChanges(changes)
+	}
+
+	commitMessage, err := getCommitMessage(c, pd.localDirPath, addition)
+	if err != nil {
+		return err
+	}
+	client, err := newDogmaClient(c, pd.repo.remoteURL)
+	if err != nil {
+		return err
+	}
+	return pd.push(client, commitMessage, changes)
+}
+
+func (pd *putDirCommand) push(client *centraldogma.Client, commitMessage *centraldogma.CommitMessage,
+	changes []*centraldogma.Change) error {
+	repo := pd.repo
+	result, httpStatusCode, err := client.Push(context.Background(),
+		repo.projName, repo.repoName, repo.revision, commitMessage, changes)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to put %s to /%s/%s%s revision: %q (status: %d)",
+			pd.localDirPath, repo.projName, repo.repoName, repo.path, repo.revision, httpStatusCode)
+	}
+	paths := make([]string, len(changes))
+	for i, change := range changes {
+		paths[i] = change.Path
+	}
+	if !isStructured(pd.style) {
+		if err = pd.printChanges(changes); err != nil {
+			return err
+		}
+	}
+	return printPushResult(pd.style, fmt.Sprintf("Put %d files to /%s/%s%s (revision: %v)",
+		len(changes), repo.projName, repo.repoName, repo.path, result.Revision),
+		repo.projName, repo.repoName, paths, result)
+}
+
+// changes returns the upsert changes of the files under the local directory which pass the filter.
+func (pd *putDirCommand) changes() ([]*centraldogma.Change, error) {
+	if fileInfo, err := os.Stat(pd.localDirPath); err != nil || !fileInfo.IsDir() {
+		return nil, errors.New(pd.localDirPath + " is not a directory")
+	}
+	var changes []*centraldogma.Change
+	err := filepath.Walk(pd.localDirPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		relPath, err := filepath.Rel(pd.localDirPath, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if !pd.filter.matches(relPath) {
+			return nil
+		}
+		change, err := newUpsertChangeFromFile(filePath, path.Join(pd.repo.path, relPath))
+		if err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(changes) == 0 {
+		return nil, fmt.Errorf("no files to put in %s", pd.localDirPath)
+	}
+	return changes, nil
+}
+
+// changeOutput is the structured output of a change without its content.
+type changeOutput struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
+}
+
+func (pd *putDirCommand) printChanges(changes []*centraldogma.Change) error {
+	if isStructured(pd.style) {
+		outputs := make([]*changeOutput, len(changes))
+		for i, change := range changes {
+			outputs[i] = &changeOutput{Type: change.Type.String(), Path: change.Path}
+		}
+		return printWithStyle(outputs, pd.style)
+	}
+	for _, change := range changes {
+		fmt.Printf("%s: /%s/%s%s\n", change.Type.String(), pd.repo.projName, pd.repo.repoName, change.Path)
+	}
+	return nil
+}
+
+// A getDirCommand downloads the files matched by the path pattern to a local directory. The directories
+// under the non-glob prefix of the path pattern are created in the local directory.
+type getDirCommand struct {
+	repo         repositoryRequestInfo
+	localDirPath string
+	filter       *fileFilter
+	dryRun       bool
+	style        PrintStyle
+}
+
+// downloadOutput is the structured output of a downloaded file.
+type downloadOutput struct {
+	Path      string `json:"path"`
+	LocalPath string `json:"localPath"`
+}
+
+func (gd *getDirCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, gd.repo.remoteURL)
+	if err != nil {
+		return err
+	}
+	return gd.executeWithDogmaClient(client)
+}
+
+func (gd *getDirCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	repo := gd.repo
+	pathPattern := dirPathPattern(repo.path)
+	entries, httpStatusCode, err := client.GetFiles(
+		context.Background(), repo.projName, repo.repoName, repo.revision, pathPattern)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the files: /%s/%s%s revision: %q (status: %d)",
+			repo.projName, repo.repoName, pathPattern, repo.revision, httpStatusCode)
+	}
+
+	basePath := patternBasePath(pathPattern)
+	var downloads []*downloadOutput
+	for _, entry := range entries {
+		if entry.Type == centraldogma.Directory {
+			continue
+		}
+		relPath := strings.TrimPrefix(entry.Path, basePath)
+		if !gd.filter.matches(relPath) {
+			continue
+		}
+		localFilePath, err := gd.localFilePath(relPath)
+		if err != nil {
+			return err
+		}
+		downloads = append(downloads, &downloadOutput{Path: entry.Path, LocalPath: localFilePath})
+		if gd.dryRun {
+			if !isStructured(gd.style) {
+				fmt.Printf("Would download: /%s/%s%s -> %s\n",
+					repo.projName, repo.repoName, entry.Path, localFilePath)
+			}
+			continue
+		}
+		if err = writeEntry(entry, localFilePath); err != nil {
+			return err
+		}
+		if !isStructured(gd.style) {
+			fmt.Printf("Downloaded: %s\n", localFilePath)
+		}
+	}
+	if isStructured(gd.style) {
+		if downloads == nil {
+			downloads = []*downloadOutput{}
+		}
+		return printWithStyle(downloads, gd.style)
+	}
+	if !gd.dryRun {
+		fmt.Printf("Downloaded %d files to %s\n", len(downloads), gd.localDirPath)
+	}
+	return nil
+}
+
+// localFilePath returns the path of the local file which the relative path is downloaded to. It returns
+// an error if the file would be outside of the local directory.
+func (gd *getDirCommand) localFilePath(relPath string) (string, error) {
+	return joinLocalPath(gd.localDirPath, relPath)
+}
+
+// joinLocalPath returns the path of the relative path in the local directory. It returns an error if the
+// file would be outside of the local directory.
+func joinLocalPath(localDirPath, relPath string) (string, error) {
+	localFilePath := filepath.Join(localDirPath, filepath.FromSlash(relPath))
+	rel, err := filepath.Rel(localDirPath, localFilePath)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", fmt.Errorf("%s is outside of %s", relPath, localDirPath)
+	}
+	return localFilePath, nil
+}
+
+func writeEntry(entry *centraldogma.Entry, localFilePath string) error {
+	if err := os.MkdirAll(filepath.Dir(localFilePath), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(localFilePath, entryContent(entry), 0644)
+}
+
+// entryContent returns the content of the entry which is written to a local file. A JSON is indented.
+func entryContent(entry *centraldogma.Entry) []byte {
+	if entry.Type == centraldogma.JSON {
+		return safeMarshalIndent(entry.Content)
+	}
+	return entry.Content
+}
+
+// dirPathPattern returns the path pattern of the files under the directory, or the path itself if it is
+// already a path pattern.
+func dirPathPattern(repoPath string) string {
+	if strings.ContainsAny(repoPath, "*,") {
+		return repoPath
+	}
+	return strings.TrimSuffix(repoPath, "/") + "/**"
+}
+
+// patternBasePath returns the directory part of the path pattern before its first glob, which ends with "/".
+func patternBasePath(pathPattern string) string {
+	if strings.Contains(pathPattern, ",") {
+		return "/"
+	}
+	if i := strings.IndexAny(pathPattern, "*?"); i >= 0 {
+		pathPattern = pathPattern[:i]
+	}
+	return pathPattern[:strings.LastIndex(pathPattern, "/")+1]
+}
+
+func newPutDirCommand(c *cli.Context, style PrintStyle) (Command, error) {
+	if len(c.Args()) != 2 {
+		return nil, newCommandLineError(c)
+	}
+	repo, err := newRepositoryRequestInfo(c)
+	if err != nil {
+		return nil, err
+	}
+	localDirPath := c.Args().Get(1)
+	if len(localDirPath) == 0 {
+		return nil, newCommandLineError(c)
+	}
+	filter, err := newFileFilter(c.StringSlice("include"), c.StringSlice("exclude"))
+	if err != nil {
+		return nil, err
+	}
+	return &putDirCommand{repo: repo, localDirPath: localDirPath, filter: filter, dryRun: c.Bool("dry-run"),
+		style: style}, nil
+}
+
+// newGetDirCommand creates the getDirCommand. If the localDirPath is not specified, the current directory
+// will be set by default.
+func newGetDirCommand(c *cli.Context, style PrintStyle) (Command, error) {
+	repo, err := newRepositoryRequestInfo(c)
+	if err != nil {
+		return nil, err
+	}
+	localDirPath := "."
+	if len(c.Args()) == 2 && len(c.Args().Get(1)) != 0 {
+		localDirPath = c.Args().Get(1)
+	}
+	filter, err := newFileFilter(c.StringSlice("include"), c.StringSlice("exclude"))
+	if err != nil {
+		return nil, err
+	}
+	return &getDirCommand{repo: repo, localDirPath: localDirPath, filter: filter, dryRun: c.Bool("dry-run"),
+		style: style}, nil
+}
@@ -0,0 +1,154 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestFileFilter(t *testing.T) {
+	tests := []struct {
+		includes []string
+		excludes []string
+		relPath  string
+		want     bool
+	}{
+		{nil, nil, "a/b.json", true},
+		{[]string{"*.json"}, nil, "a/b.json", true},
+		{[]string{"*.json"}, nil, "a/b.txt", false},
+		{[]string{"a/*.json"}, nil, "a/b/c.json", false},
+		{[]string{"a/**/*.json"}, nil, "a/b/c.json", true},
+		{[]string{"a/**/*.json"}, nil, "a/c.json", true},
+		{nil, []string{"test/**"}, "test/a.json", false},
+		{nil, []string{"test/**"}, "a/test/a.json", true},
+		{nil, []string{"?.txt"}, "a/b.txt", false},
+		{[]string{"*.json"}, []string{"b.*"}, "a/b.json", false},
+	}
+	for _, test := range tests {
+		filter, err := newFileFilter(test.includes, test.excludes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := filter.matches(test.relPath); got != test.want {
+			t.Errorf("matches(%q) with includes: %v, excludes: %v = %t; want %t",
+				test.relPath, test.includes, test.excludes, got, test.want)
+		}
+	}
+}
+
+func TestPatternBasePath(t *testing.T) {
+	tests := map[string]string{
+		"/**":            "/",
+		"/a/**":          "/a/",
+		"/a/b*.json":     "/a/",
+		"/a/*/c.json":    "/a/",
+		"/a/**,/b/*.txt": "/",
+	}
+	for pathPattern, want := range tests {
+		if got := patternBasePath(pathPattern); got != want {
+			t.Errorf("patternBasePath(%q) = %q; want %q", pathPattern, got, want)
+		}
+	}
+}
+
+func TestPutDirChanges(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dogma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, content := range map[string]string{
+		"a.json": `{"a": 1}`, "b.txt": "b", "sub/c.json": `{"c": 3}`, "test/d.json": `{"d": 4}`} {
+		filePath := filepath.Join(dir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	filter, _ := newFileFilter([]string{"*.json"}, []string{"test/**"})
+	pd := putDirCommand{repo: repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/conf"},
+		localDirPath: dir, filter: filter}
+	changes, err := pd.changes()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, change := range changes {
+		got = append(got, change.Type.String()+" "+change.Path)
+	}
+	want := []string{"UPSERT_JSON /conf/a.json", "UPSERT_JSON /conf/sub/c.json"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("changes() = %v; want %v", got, want)
+	}
+}
+
+func TestGetDir(t *testing.T) {
+	var pathPattern string
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			pathPattern = r.URL.Path
+			fmt.Fprint(w, `[{"path": "/conf/a.json", "type": "JSON", "content": {"a":1}},
+				{"path": "/conf/sub", "type": "DIRECTORY"},
+				{"path": "/conf/sub/b.txt", "type": "TEXT", "content": "b"},
+				{"path": "/conf/test/c.txt", "type": "TEXT", "content": "c"}]`)
+		}))
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	dir, err := ioutil.TempDir("", "dogma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	filter, _ := newFileFilter(nil, []string{"test/**"})
+	gd := getDirCommand{
+		repo:         repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/conf", revision: "-1"},
+		localDirPath: dir,
+		filter:       filter,
+	}
+	runCommandAndCaptureStdout(func() { err = gd.executeWithDogmaClient(client) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "/api/v1/projects/foo/repos/bar/contents/conf/**"; pathPattern != want {
+		t.Errorf("Got path %s; want %s", pathPattern, want)
+	}
+
+	for name, want := range map[string]string{"a.json": "{\n  \"a\": 1\n}", "sub/b.txt": "b"} {
+		content, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != want {
+			t.Errorf("Got content %q of %s; want %q", content, name, want)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dir, "test")); !os.IsNotExist(err) {
+		t.Errorf("Excluded directory test exists: %v", err)
+	}
+}
@@ -15,21 +15,32 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
 	"path"
+	"reflect"
+	"strings"
 
 	"github.com/urfave/cli"
 	"go.linecorp.com/centraldogma"
+	"gopkg.in/yaml.v3"
 )
 
-// An editFileCommand modifies the file of the specified path with the revision.
+var errEditAborted = errors.New("aborted the edit")
+
+// An editFileCommand modifies the file of the specified path with the revision. The edited file is
+// validated, and the diff is shown for the confirmation before it is pushed.
 type editFileCommand struct {
-	repo repositoryRequestInfo
+	repo  repositoryRequestInfo
+	style PrintStyle
+	yes   bool // Whether to push without the confirmation.
 }
 
 func (ef *editFileCommand) execute(c *cli.Context) error {
@@ -39,10 +50,23 @@ func (ef *editFileCommand) execute(c *cli.Context) error {
 	if err != nil {
 		return err
 	}
-	change, err := editRemoteFileContent(remoteEntry)
+	change, err := editRemoteFileContent(remoteEntry, openEditor, askYesNo)
 	if err != nil {
 		return err
 	}
+	if change == nil {
+		fmt.Printf("No changes: /%s/%s%s\n", repo.projName, repo.repoName, repo.path)
+		return nil
+	}
+
+	preview := previewDiff(remoteEntry, change)
+	if isTerminal(os.Stdout) {
+		preview = colorizeUnifiedDiff(preview)
+	}
+	fmt.Print(preview)
+	if !ef.yes && !askYesNo("Push the changes? [y/N] ", false) {
+		return errEditAborted
+	}
 
 	commitMessage, err := getCommitMessage(c, change.Path, edition)
 	if err != nil {
@@ -54,7 +78,7 @@ func (ef *editFileCommand) execute(c *cli.Context) error {
 		return err
 	}
 
-	_, httpStatusCode, err := client.Push(context.Background(),
+	result, httpStatusCode, err := client.Push(context.Background(),
 		repo.projName, repo.repoName, repo.revision, commitMessage, []*centraldogma.Change{change})
 	if err != nil {
 		return err
@@ -64,46 +88,53 @@ func (ef *editFileCommand) execute(c *cli.Context) error {
 			repo.projName, repo.repoName, repo.path, repo.revision, httpStatusCode)
 	}
 
-	fmt.Printf("Edited: /%s/%s%s\n", repo.projName, repo.repoName, repo.path)
-	return nil
+	return printPushResult(ef.style, fmt.Sprintf("Edited: /%s/%s%s", repo.projName, repo.repoName, repo.path),
+		repo.projName, repo.repoName, []string{repo.path}, result)
 }
 
-func editRemoteFileContent(remote *centraldogma.Entry) (*centraldogma.Change, error) {
+// editRemoteFileContent lets the user edit the content of the remote file with the editor until it is
+// valid or the user gives up. It returns nil if the content is not changed.
+func editRemoteFileContent(remote *centraldogma.Entry, edit func(filePath string) error,
+	ask func(question string, defaultYes bool) bool) (*centraldogma.Change, error) {
 	tempFilePath, err := putIntoTempFile(remote)
 	if err != nil {
 		return nil, err
 	}
 	defer os.Remove(tempFilePath)
 
-	cmd := cmdToOpenEditor(tempFilePath)
-	if err = cmd.Start(); err != nil {
-		return nil, err
-	}
-	err = cmd.Wait()
-	if err != nil {
-		return nil, fmt.Errorf("failed to edit the file: %s", path.Base(remote.Path))
-	}
-
-	fd, err := os.Open(tempFilePath)
-	if err != nil {
-		return nil, err
-	}
-	defer fd.Close()
-
-	buf, err := ioutil.ReadAll(fd)
-	if err != nil {
-		return nil, fmt.Errorf("failed to edit the file: %s", path.Base(remote.Path))
+	var buf []byte
+	for {
+		if err = edit(tempFilePath); err != nil {
+			return nil, fmt.Errorf("failed to edit the file: %s", path.Base(remote.Path))
+		}
+		if buf, err = ioutil.ReadFile(tempFilePath); err != nil {
+			return nil, fmt.Errorf("failed to edit the file: %s", path.Base(remote.Path))
+		}
+		err = validateContent(remote, buf)
+		if err == nil {
+			break
+		}
+		fmt.Printf("Invalid %s: %v\n", path.Base(remote.Path), err)
+		if !ask("Edit the file again? [Y/n] ", true) {
+			return nil, errEditAborted
+		}
 	}
 
 	change := &centraldogma.Change{Path: remote.Path}
 	if remote.Type == centraldogma.JSON {
 		change.Type = centraldogma.UpsertJSON
-		var v interface{}
+		var v, remoteValue interface{}
 		if err := json.Unmarshal(buf, &v); err != nil {
 			return nil, err
 		}
+		if json.Unmarshal(remote.Content, &remoteValue) == nil && reflect.DeepEqual(v, remoteValue) {
+			return nil, nil
+		}
 		change.Content = v
 	} else if remote.Type == centraldogma.Text {
+		if bytes.Equal(buf, remote.Content) {
+			return nil, nil
+		}
 		change.Type = centraldogma.UpsertText
 		change.Content = string(buf)
 	}
@@ -111,11 +142,93 @@ func editRemoteFileContent(remote *centraldogma.Entry) (*centraldogma.Change, er
 	return change, nil
 }
 
+// validateContent returns an error if the content is not a valid JSON for a JSON file or not a valid YAML
+// for a YAML file.
+func validateContent(remote *centraldogma.Entry, content []byte) error {
+	if remote.Type == centraldogma.JSON {
+		var v interface{}
+		return json.Unmarshal(content, &v)
+	}
+	lowerPath := strings.ToLower(remote.Path)
+	if strings.HasSuffix(lowerPath, ".yaml") || strings.HasSuffix(lowerPath, ".yml") {
+		decoder := yaml.NewDecoder(bytes.NewReader(content))
+		for {
+			var v interface{}
+			if err := decoder.Decode(&v); err == io.EOF {
+				return nil
+			} else if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// previewDiff returns the unified diff of the change from the remote file. A JSON file is compared after
+// it is indented so that only the changes of the values are shown.
+func previewDiff(remote *centraldogma.Entry, change *centraldogma.Change) string {
+	from, to := string(remote.Content), ""
+	if remote.Type == centraldogma.JSON {
+		from = string(safeMarshalIndent(remote.Content)) + "\n"
+		b, _ := marshalIndentObject(change.Content)
+		to = string(b) + "\n"
+	} else {
+		to = change.Content.(string)
+	}
+	return unifiedDiff("a"+remote.Path, "b"+remote.Path, from, to)
+}
+
+func openEditor(filePath string) error {
+	cmd := cmdToOpenEditor(filePath)
+	if err := cmd.Start(); err != nil {
+		return err
+	}
+	return cmd.Wait()
+}
+
+// askYesNo asks the question and returns the answer, or defaultYes if the answer is empty. It returns false
+// if the standard input is closed.
+func askYesNo(question string, defaultYes bool) bool {
+	fmt.Print(question)
+	answer, err := readLine(os.Stdin)
+	if err != nil && len(answer) == 0 {
+		fmt.Println()
+		return false
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "":
+		return defaultYes
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
+
+// readLine reads a line byte by byte, so that the rest of the input is left for the next reader such as
+// the one which reads the commit message.
+func readLine(r io.Reader) (string, error) {
+	var line []byte
+	b := make([]byte, 1)
+	for {
+		n, err := r.Read(b)
+		if n == 1 {
+			if b[0] == '\n' {
+				return string(line), nil
+			}
+			line = append(line, b[0])
+		}
+		if err != nil {
+			return string(line), err
+		}
+	}
+}
+
 // newEditCommand creates the editCommand.
-func newEditCommand(c *cli.Context) (Command, error) {
+func newEditCommand(c *cli.Context, style PrintStyle) (Command, error) {
 	repo, err := newRepositoryRequestInfo(c)
 	if err != nil {
 		return nil, err
 	}
-	return &editFileCommand{repo: repo}, nil
+	return &editFileCommand{repo: repo, style: style, yes: c.Bool("yes")}, nil
 }
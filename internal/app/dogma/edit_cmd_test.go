@@ -15,8 +15,12 @@
 package main
 
 import (
+	"io/ioutil"
 	"reflect"
+	"strings"
 	"testing"
+
+	"go.linecorp.com/centraldogma"
 )
 
 func TestNewEditCommand(t *testing.T) {
@@ -41,15 +45,108 @@ func TestNewEditCommand(t *testing.T) {
 	for _, test := range tests {
 		c := newContext(test.arguments, defaultRemoteURL, test.revision)
 
-		got, _ := newEditCommand(c)
+		got, _ := newEditCommand(c, 0)
 		switch comType := got.(type) {
 		case *editFileCommand:
 			got2 := editFileCommand(*comType)
 			if !reflect.DeepEqual(got2, test.want) {
-				t.Errorf("newEditCommand(%q) = %q, want: %q", test.arguments, got2, test.want)
+				t.Errorf("newEditCommand(%q) = %+v, want: %+v", test.arguments, got2, test.want)
 			}
 		default:
-			t.Errorf("newEditCommand(%q) = %q, want: %q", test.arguments, got, test.want)
+			t.Errorf("newEditCommand(%q) = %+v, want: %+v", test.arguments, got, test.want)
 		}
 	}
 }
+
+func TestValidateContent(t *testing.T) {
+	var tests = []struct {
+		entry   centraldogma.Entry
+		content string
+		valid   bool
+	}{
+		{centraldogma.Entry{Path: "/a.json", Type: centraldogma.JSON}, `{"a": 1}`, true},
+		{centraldogma.Entry{Path: "/a.json", Type: centraldogma.JSON}, `{"a": 1,}`, false},
+		{centraldogma.Entry{Path: "/a.yaml", Type: centraldogma.Text}, "a: 1\n---\nb: 2\n", true},
+		{centraldogma.Entry{Path: "/a.yml", Type: centraldogma.Text}, "a: [1, 2\n", false},
+		{centraldogma.Entry{Path: "/a.txt", Type: centraldogma.Text}, "a: [1, 2\n", true},
+	}
+
+	for _, test := range tests {
+		err := validateContent(&test.entry, []byte(test.content))
+		if (err == nil) != test.valid {
+			t.Errorf("validateContent(%q, %q) = %v, want valid: %v", test.entry.Path, test.content, err, test.valid)
+		}
+	}
+}
+
+func TestEditRemoteFileContent(t *testing.T) {
+	remote := &centraldogma.Entry{Path: "/a.json", Type: centraldogma.JSON, Content: []byte(`{"a":1}`)}
+	contents := []string{`{"a": 2,}`, `{"a": 2}`}
+	var questions []string
+	edit := func(filePath string) error {
+		content := contents[0]
+		contents = contents[1:]
+		return ioutil.WriteFile(filePath, []byte(content), 0600)
+	}
+	ask := func(question string, defaultYes bool) bool {
+		questions = append(questions, question)
+		return true
+	}
+
+	var change *centraldogma.Change
+	var err error
+	runCommandAndCaptureStdout(func() { change, err = editRemoteFileContent(remote, edit, ask) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(questions) != 1 {
+		t.Errorf("asked %d times, want: 1", len(questions))
+	}
+	want := &centraldogma.Change{Path: "/a.json", Type: centraldogma.UpsertJSON,
+		Content: map[string]interface{}{"a": float64(2)}}
+	if !reflect.DeepEqual(change, want) {
+		t.Errorf("editRemoteFileContent() = %+v, want: %+v", change, want)
+	}
+
+	preview := previewDiff(remote, change)
+	if !strings.Contains(preview, "-  \"a\": 1\n+  \"a\": 2\n") {
+		t.Errorf("previewDiff() = %q, want the change of \"a\"", preview)
+	}
+}
+
+func TestEditRemoteFileContentAborted(t *testing.T) {
+	remote := &centraldogma.Entry{Path: "/a.json", Type: centraldogma.JSON, Content: []byte(`{"a":1}`)}
+	edit := func(filePath string) error {
+		return ioutil.WriteFile(filePath, []byte("{"), 0600)
+	}
+	ask := func(question string, defaultYes bool) bool { return false }
+
+	var err error
+	runCommandAndCaptureStdout(func() { _, err = editRemoteFileContent(remote, edit, ask) })
+	if err != errEditAborted {
+		t.Errorf("editRemoteFileContent() = %v, want: %v", err, errEditAborted)
+	}
+}
+
+func TestEditRemoteFileContentUnchanged(t *testing.T) {
+	remote := &centraldogma.Entry{Path: "/a.txt", Type: centraldogma.Text, Content: []byte("foo\n")}
+	edit := func(filePath string) error { return nil }
+	ask := func(question string, defaultYes bool) bool { return false }
+
+	change, err := editRemoteFileContent(remote, edit, ask)
+	if err != nil || change != nil {
+		t.Errorf("editRemoteFileContent() = (%+v, %v), want: (nil, nil)", change, err)
+	}
+}
+
+func TestReadLine(t *testing.T) {
+	r := strings.NewReader("yes\nmessage\n")
+	line, err := readLine(r)
+	if line != "yes" || err != nil {
+		t.Errorf("readLine() = (%q, %v), want: (\"yes\", nil)", line, err)
+	}
+	rest, _ := ioutil.ReadAll(r)
+	if string(rest) != "message\n" {
+		t.Errorf("rest = %q, want: %q", rest, "message\n")
+	}
+}
@@ -1,7 +1,33 @@
 module go.linecorp.com/centraldogma/internal/app/dogma
 
+go 1.17
+
 require (
 	github.com/fhs/go-netrc v1.0.0
 	github.com/urfave/cli v1.20.0
-	go.linecorp.com/centraldogma v0.0.0-20190521064158-a9367c94a008
+	go.linecorp.com/centraldogma v0.0.0
+	gopkg.in/yaml.v3 v3.0.1
 )
+
+require (
+	github.com/BurntSushi/toml v1.2.1 // indirect
+	github.com/armon/go-metrics v0.0.0-20190430140413-ec5e00d3c878 // indirect
+	github.com/beorn7/perks v1.0.1 // indirect
+	github.com/golang/protobuf v1.3.2 // indirect
+	github.com/hashicorp/go-immutable-radix v1.0.0 // indirect
+	github.com/hashicorp/golang-lru v0.5.0 // indirect
+	github.com/konsorten/go-windows-terminal-sequences v1.0.1 // indirect
+	github.com/matttproud/golang_protobuf_extensions v1.0.1 // indirect
+	github.com/prometheus/client_golang v1.1.0 // indirect
+	github.com/prometheus/client_model v0.0.0-20190129233127-fd36f4220a90 // indirect
+	github.com/prometheus/common v0.6.0 // indirect
+	github.com/prometheus/procfs v0.0.3 // indirect
+	github.com/sirupsen/logrus v1.4.2 // indirect
+	golang.org/x/net v0.0.0-20190813141303-74dc4d7220e7 // indirect
+	golang.org/x/oauth2 v0.0.0-20190604053449-0f29369cfe45 // indirect
+	golang.org/x/sys v0.0.0-20190801041406-cbf593c0f2f3 // indirect
+	golang.org/x/text v0.3.0 // indirect
+	google.golang.org/appengine v1.4.0 // indirect
+)
+
+replace go.linecorp.com/centraldogma => ../../..
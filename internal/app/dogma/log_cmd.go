@@ -18,39 +18,204 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
 )
 
+// defaultMaxCommits is the number of the commits which the server returns by default.
+const defaultMaxCommits = 100
+
 type logCommand struct {
 	repo       repositoryRequestInfoWithFromTo
 	maxCommits int
 	style      PrintStyle
+	filter     commitFilter
+	oneline    bool
 }
 
 func (l *logCommand) execute(c *cli.Context) error {
-	repo := l.repo
-	client, err := newDogmaClient(c, repo.remoteURL)
+	client, err := newDogmaClient(c, l.repo.remoteURL)
 	if err != nil {
 		return err
 	}
+	return l.executeWithDogmaClient(client)
+}
 
-	commits, httpStatusCode, err := client.GetHistory(
-		context.Background(), repo.projName, repo.repoName, repo.from, repo.to, repo.path, l.maxCommits)
-	if err != nil {
-		return err
-	}
-	if httpStatusCode != http.StatusOK {
-		return fmt.Errorf("failed to get the commit logs of /%s/%s%s from: %q, to: %q (status: %d)",
-			repo.projName, repo.repoName, repo.path, repo.from, repo.to, httpStatusCode)
+func (l *logCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	repo := l.repo
+	var commits []*centraldogma.Commit
+	if l.filter.isEmpty() {
+		var httpStatusCode int
+		var err error
+		commits, httpStatusCode, err = client.GetHistory(
+			context.Background(), repo.projName, repo.repoName, repo.from, repo.to, repo.path, l.maxCommits)
+		if err != nil {
+			return err
+		}
+		if httpStatusCode != http.StatusOK {
+			return fmt.Errorf("failed to get the commit logs of /%s/%s%s from: %q, to: %q (status: %d)",
+				repo.projName, repo.repoName, repo.path, repo.from, repo.to, httpStatusCode)
+		}
+	} else {
+		var err error
+		if commits, err = l.filteredHistory(client); err != nil {
+			return fmt.Errorf("failed to get the commit logs of /%s/%s%s from: %q, to: %q: %v",
+				repo.projName, repo.repoName, repo.path, repo.from, repo.to, err)
+		}
 	}
 
-	printWithStyle(commits, l.style)
+	if isStructured(l.style) {
+		return printWithStyle(commits, l.style)
+	}
+	for _, commit := range commits {
+		if l.oneline || l.style == Simple {
+			fmt.Printf("%v %s\n", commit.Revision, commit.CommitMessage.Summary)
+		} else {
+			printCommit(commit)
+		}
+	}
 	return nil
 }
 
+// filteredHistory iterates over the history because the server cannot filter the commits by the authors.
+func (l *logCommand) filteredHistory(client *centraldogma.Client) ([]*centraldogma.Commit, error) {
+	repo := l.repo
+	maxCommits := l.maxCommits
+	if maxCommits == 0 {
+		maxCommits = defaultMaxCommits
+	}
+
+	it := client.GetHistoryIterator(context.Background(), repo.projName, repo.repoName, repo.from, repo.to,
+		repo.path)
+	var commits []*centraldogma.Commit
+	var prev *centraldogma.Commit
+	for len(commits) < maxCommits && it.Next() {
+		commit := it.Commit()
+		pushedAt, err := commit.PushedTime()
+		if err != nil {
+			return nil, err
+		}
+		// The rest of the commits are all pushed before since if they are in the descending order.
+		if prev != nil && commit.Revision < prev.Revision &&
+			!l.filter.since.IsZero() && pushedAt.Before(l.filter.since) {
+			break
+		}
+		prev = commit
+		if l.filter.matches(commit, pushedAt) {
+			commits = append(commits, commit)
+		}
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return commits, nil
+}
+
+// printCommit prints the commit in the format of git log.
+func printCommit(commit *centraldogma.Commit) {
+	fmt.Printf("commit %v\n", commit.Revision)
+	if len(commit.Author.Email) != 0 {
+		fmt.Printf("Author: %s <%s>\n", commit.Author.Name, commit.Author.Email)
+	} else {
+		fmt.Printf("Author: %s\n", commit.Author.Name)
+	}
+	fmt.Printf("Date:   %s\n\n", commit.PushedAt)
+	fmt.Printf("    %s\n", commit.CommitMessage.Summary)
+	if detail := strings.TrimSpace(commit.CommitMessage.Detail); len(detail) != 0 {
+		fmt.Println()
+		for _, line := range strings.Split(detail, "\n") {
+			fmt.Printf("    %s\n", line)
+		}
+	}
+	fmt.Println()
+}
+
+// A commitFilter filters the commits by the time when they are pushed and by their authors.
+type commitFilter struct {
+	since  time.Time
+	until  time.Time
+	author string // The substring of the name or the email of the author, which is matched case-insensitively.
+}
+
+func (f *commitFilter) isEmpty() bool {
+	return f.since.IsZero() && f.until.IsZero() && len(f.author) == 0
+}
+
+func (f *commitFilter) matches(commit *centraldogma.Commit, pushedAt time.Time) bool {
+	if !f.since.IsZero() && pushedAt.Before(f.since) {
+		return false
+	}
+	if !f.until.IsZero() && pushedAt.After(f.until) {
+		return false
+	}
+	if len(f.author) != 0 {
+		author := strings.ToLower(f.author)
+		return strings.Contains(strings.ToLower(commit.Author.Name), author) ||
+			strings.Contains(strings.ToLower(commit.Author.Email), author)
+	}
+	return true
+}
+
+// getCommitFilter returns the commitFilter from the since, until and author flags.
+func getCommitFilter(c *cli.Context) (commitFilter, error) {
+	var filter commitFilter
+	now := time.Now()
+	var err error
+	if since := c.String("since"); len(since) != 0 {
+		if filter.since, err = parseTime(since, now); err != nil {
+			return filter, err
+		}
+	}
+	if until := c.String("until"); len(until) != 0 {
+		if filter.until, err = parseTime(until, now); err != nil {
+			return filter, err
+		}
+	}
+	if !filter.since.IsZero() && !filter.until.IsZero() && filter.since.After(filter.until) {
+		return filter, fmt.Errorf("since should not be after until (since: %s, until: %s)",
+			c.String("since"), c.String("until"))
+	}
+	filter.author = c.String("author")
+	return filter, nil
+}
+
+var relativeTimeRegex = regexp.MustCompile(`^(\d+)\s*([dw])(?:\s+ago)?$`)
+
+var timeLayouts = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02"}
+
+// parseTime parses the time such as "2006-01-02", "2006-01-02T15:04:05Z07:00" and the relative one such as
+// "2h", "3d" or "1w ago". The time without the time zone is in the local time zone.
+func parseTime(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if d, err := time.ParseDuration(strings.TrimSuffix(value, " ago")); err == nil {
+		return now.Add(-d), nil
+	}
+	if matches := relativeTimeRegex.FindStringSubmatch(value); matches != nil {
+		n, err := strconv.Atoi(matches[1])
+		if err != nil {
+			return time.Time{}, err
+		}
+		if matches[2] == "w" {
+			n *= 7
+		}
+		return now.AddDate(0, 0, -n), nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, value, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time: %s (expected: 2006-01-02, 2006-01-02T15:04:05Z07:00, "+
+		"2h, 3d or 1w)", value)
+}
+
 // newLogCommand creates the logCommand.
-func newLogCommand(c *cli.Context, style PrintStyle) (Command, error) {
+func newLogCommand(c *cli.Context, style PrintStyle, filter commitFilter) (Command, error) {
 	repo, err := newRepositoryRequestInfo(c)
 	if err != nil {
 		return nil, err
@@ -58,6 +223,12 @@ func newLogCommand(c *cli.Context, style PrintStyle) (Command, error) {
 
 	repoWithFromTo := repositoryRequestInfoWithFromTo{remoteURL: repo.remoteURL, projName: repo.projName,
 		repoName: repo.repoName, path: repo.path}
+	if follow := c.String("follow"); len(follow) != 0 {
+		if !strings.HasPrefix(follow, "/") {
+			follow = "/" + follow
+		}
+		repoWithFromTo.path = follow
+	}
 
 	from := c.String("from")
 	to := c.String("to")
@@ -70,7 +241,7 @@ func newLogCommand(c *cli.Context, style PrintStyle) (Command, error) {
 	repoWithFromTo.from = from
 	repoWithFromTo.to = to
 
-	log := &logCommand{repo: repoWithFromTo, style: style}
+	log := &logCommand{repo: repoWithFromTo, style: style, filter: filter, oneline: c.Bool("oneline")}
 	maxCommits := c.Int("max-commits")
 	if maxCommits != 0 {
 		log.maxCommits = maxCommits
@@ -0,0 +1,120 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestParseTime(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	var tests = []struct {
+		value string
+		want  time.Time
+	}{
+		{"2h", now.Add(-2 * time.Hour)},
+		{"90m ago", now.Add(-90 * time.Minute)},
+		{"3d", now.AddDate(0, 0, -3)},
+		{"1w ago", now.AddDate(0, 0, -7)},
+		{"2026-10-01T09:00:00Z", time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)},
+		{"2026-10-01", time.Date(2026, 10, 1, 0, 0, 0, 0, time.Local)},
+	}
+
+	for _, test := range tests {
+		got, err := parseTime(test.value, now)
+		if err != nil {
+			t.Errorf("parseTime(%q) returned an error: %v", test.value, err)
+			continue
+		}
+		if !got.Equal(test.want) {
+			t.Errorf("parseTime(%q) = %v, want: %v", test.value, got, test.want)
+		}
+	}
+
+	if _, err := parseTime("yesterday", now); err == nil {
+		t.Errorf("parseTime(%q) should return an error", "yesterday")
+	}
+}
+
+func TestCommitFilter(t *testing.T) {
+	pushedAt := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	commit := &dogma.Commit{Author: dogma.Author{Name: "Alice", Email: "alice@example.com"}}
+	var tests = []struct {
+		filter commitFilter
+		want   bool
+	}{
+		{commitFilter{author: "alice"}, true},
+		{commitFilter{author: "EXAMPLE.COM"}, true},
+		{commitFilter{author: "bob"}, false},
+		{commitFilter{since: pushedAt.Add(-time.Hour), until: pushedAt.Add(time.Hour)}, true},
+		{commitFilter{since: pushedAt.Add(time.Hour)}, false},
+		{commitFilter{until: pushedAt.Add(-time.Hour)}, false},
+	}
+
+	for _, test := range tests {
+		if got := test.filter.matches(commit, pushedAt); got != test.want {
+			t.Errorf("%+v.matches() = %t, want: %t", test.filter, got, test.want)
+		}
+	}
+}
+
+func TestLogWithFilter(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/", func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/projects/foo/repos/bar/revision/-1":
+			fmt.Fprint(w, `{"revision":4}`)
+		default:
+			fmt.Fprint(w, `{"revision":1}`)
+		}
+	})
+	var path string
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/commits/4", func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Query().Get("path")
+		fmt.Fprint(w, `[
+{"revision":4, "author":{"name":"Alice"}, "commitMessage":{"summary":"Fourth"}, "pushedAt":"2026-10-04T00:00:00Z"},
+{"revision":3, "author":{"name":"Bob"}, "commitMessage":{"summary":"Third"}, "pushedAt":"2026-10-03T00:00:00Z"},
+{"revision":2, "author":{"name":"Alice"}, "commitMessage":{"summary":"Second"}, "pushedAt":"2026-10-02T00:00:00Z"},
+{"revision":1, "author":{"name":"Alice"}, "commitMessage":{"summary":"First"}, "pushedAt":"2026-10-01T00:00:00Z"}]`)
+	})
+	server := httptest.NewTLSServer(mux)
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	l := logCommand{
+		repo: repositoryRequestInfoWithFromTo{projName: "foo", repoName: "bar", path: "/a.json",
+			from: "-1", to: "1"},
+		style:   Pretty,
+		filter:  commitFilter{since: time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC), author: "alice"},
+		oneline: true,
+	}
+	var err error
+	out := runCommandAndCaptureStdout(func() { err = l.executeWithDogmaClient(client) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/a.json" {
+		t.Errorf("Got path pattern %q; want %q", path, "/a.json")
+	}
+	if want := "4 Fourth\n2 Second\n"; string(out) != want {
+		t.Errorf("Got output %q; want %q", out, want)
+	}
+}
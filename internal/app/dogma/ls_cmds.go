@@ -41,8 +41,7 @@ func (lsp *lsProjectCommand) execute(c *cli.Context) error {
 	if httpStatusCode != http.StatusOK {
 		return fmt.Errorf("failed to get the list of projects. (status: %d)", httpStatusCode)
 	}
-	printWithStyle(projects, lsp.style)
-	return nil
+	return printWithStyle(projects, lsp.style)
 }
 
 // A lsRepositoryCommand lists all the repositories under the specified projName
@@ -69,8 +68,7 @@ func (lsr *lsRepositoryCommand) execute(c *cli.Context) error {
 			lsr.projName, httpStatusCode)
 	}
 
-	printWithStyle(repos, lsr.style)
-	return nil
+	return printWithStyle(repos, lsr.style)
 }
 
 // A lsPathCommand lists the specified path which is {repo.projName}/{repo.repoName}/{repo.path}
@@ -97,14 +95,13 @@ func (lsp *lsPathCommand) execute(c *cli.Context) error {
 			lsp.repo.projName, lsp.repo.repoName, lsp.repo.path, lsp.repo.revision, httpStatusCode)
 	}
 
-	printWithStyle(repos, lsp.style)
-	return nil
+	return printWithStyle(repos, lsp.style)
 }
 
 // newLSCommand creates one of the ls project, repository, and path commands according to the
 // command arguments from the CLI. If the revision is not specified, -1 will be set by default.
 func newLSCommand(c *cli.Context, style PrintStyle) (Command, error) {
-	remoteURL, err := getRemoteURL(c.Parent().String("connect"))
+	remoteURL, err := getRemoteURLFromContext(c)
 	if err != nil {
 		return nil, err
 	}
@@ -42,9 +42,16 @@ func main() {
 			Name:  "token, t",
 			Usage: "Specifies an authorization token to access resources on the server",
 		},
+		cli.StringFlag{
+			Name:   "profile",
+			EnvVar: "DOGMA_PROFILE",
+			Usage: "Specifies the `name` of the profile in ~/.config/dogma/config to use. " +
+				"The profile named default is used if not specified",
+		},
 	}
 
 	app.Commands = CLICommands()
+	app.EnableBashCompletion = true
 	cli.HelpFlag = cli.BoolFlag{
 		Name:  "help, h",
 		Usage: "Shows help",
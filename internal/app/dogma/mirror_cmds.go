@@ -0,0 +1,516 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+	"go.linecorp.com/centraldogma"
+)
+
+// defaultMirrorSchedule is the schedule of a mirror which is created without --schedule. It mirrors every minute.
+const defaultMirrorSchedule = "0 * * * * ?"
+
+// A mirrorListCommand lists the mirrors of the project.
+type mirrorListCommand struct {
+	remoteURL string
+	projName  string
+	style     PrintStyle
+}
+
+func (ml *mirrorListCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, ml.remoteURL)
+	if err != nil {
+		return err
+	}
+
+	mirrors, httpStatusCode, err := client.ListMirrors(context.Background(), ml.projName)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the list of mirrors in %s. (status: %d)", ml.projName, httpStatusCode)
+	}
+	return printWithStyle(mirrors, ml.style)
+}
+
+// A mirrorCommand creates or updates a mirror. The flags are applied to a new mirror when it is created, or to
+// the current mirror when it is updated. If test is true, the mirror is only validated.
+type mirrorCommand struct {
+	remoteURL string
+	projName  string
+	id        string
+	update    bool
+	apply     func(mirror *centraldogma.Mirror) error
+	test      bool
+	style     PrintStyle
+}
+
+func (m *mirrorCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, m.remoteURL)
+	if err != nil {
+		return err
+	}
+	return m.executeWithDogmaClient(client)
+}
+
+func (m *mirrorCommand) executeWithDogmaClient(client *centraldogma.Client) error {
+	ctx := context.Background()
+	action := "create"
+	mirror := &centraldogma.Mirror{ID: m.id, Enabled: true, Schedule: defaultMirrorSchedule,
+		Direction: centraldogma.RemoteToLocal, LocalPath: "/", RemotePath: "/"}
+	if m.update {
+		action = "update"
+		current, httpStatusCode, err := client.GetMirror(ctx, m.projName, m.id)
+		if err != nil {
+			return err
+		}
+		if httpStatusCode != http.StatusOK {
+			return fmt.Errorf("failed to get the mirror %s of %s (status: %d)", m.id, m.projName, httpStatusCode)
+		}
+		mirror = current
+	}
+	if err := m.apply(mirror); err != nil {
+		return err
+	}
+	if err := validateMirror(mirror); err != nil {
+		return err
+	}
+
+	if m.test {
+		if err := testMirror(client, m.projName, mirror); err != nil {
+			return err
+		}
+		if isStructured(m.style) {
+			return printWithStyle(mirror, m.style)
+		}
+		fmt.Printf("The mirror %s is valid: %s %s://%s%s#%s -> /%s/%s%s\n", mirror.ID, mirror.Direction,
+			mirror.RemoteScheme, mirror.RemoteURL, mirror.RemotePath, mirror.RemoteBranch,
+			m.projName, mirror.LocalRepo, mirror.LocalPath)
+		return nil
+	}
+
+	var httpStatusCode int
+	var err error
+	if m.update {
+		httpStatusCode, err = client.UpdateMirror(ctx, m.projName, mirror)
+	} else {
+		httpStatusCode, err = client.CreateMirror(ctx, m.projName, mirror)
+	}
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK && httpStatusCode != http.StatusCreated {
+		return fmt.Errorf("failed to %s the mirror %s of %s (status: %d)", action, m.id, m.projName,
+			httpStatusCode)
+	}
+	if isStructured(m.style) {
+		return printWithStyle(mirror, m.style)
+	}
+	fmt.Printf("%sd the mirror: %s\n", strings.ToUpper(action[:1])+action[1:], m.id)
+	return nil
+}
+
+// A mirrorRemoveCommand removes a mirror.
+type mirrorRemoveCommand struct {
+	remoteURL string
+	projName  string
+	id        string
+}
+
+func (mr *mirrorRemoveCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, mr.remoteURL)
+	if err != nil {
+		return err
+	}
+
+	httpStatusCode, err := client.RemoveMirror(context.Background(), mr.projName, mr.id)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK && httpStatusCode != http.StatusNoContent {
+		return fmt.Errorf("failed to remove the mirror %s of %s (status: %d)", mr.id, mr.projName,
+			httpStatusCode)
+	}
+	fmt.Printf("Removed the mirror: %s\n", mr.id)
+	return nil
+}
+
+// validateMirror returns an error if the mirror misses a required field or its schedule is invalid.
+func validateMirror(mirror *centraldogma.Mirror) error {
+	if len(mirror.LocalRepo) == 0 {
+		return errors.New("the local repository should be specified with --repo")
+	}
+	if len(mirror.RemoteURL) == 0 {
+		return errors.New("the remote repository should be specified with --remote")
+	}
+	if err := validateSchedule(mirror.Schedule); err != nil {
+		return fmt.Errorf("invalid schedule: %q: %v", mirror.Schedule, err)
+	}
+	return nil
+}
+
+// testMirror checks the mirror against the server, i.e. whether the local repository exists and whether the
+// credential exists and can be used to access the remote repository.
+func testMirror(client *centraldogma.Client, projName string, mirror *centraldogma.Mirror) error {
+	ctx := context.Background()
+	repos, httpStatusCode, err := client.ListRepositories(ctx, projName)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the list of repositories in %s. (status: %d)", projName, httpStatusCode)
+	}
+	found := false
+	for _, repo := range repos {
+		if repo.Name == mirror.LocalRepo {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("the local repository does not exist: /%s/%s", projName, mirror.LocalRepo)
+	}
+
+	if len(mirror.CredentialID) == 0 {
+		return nil
+	}
+	credentials, httpStatusCode, err := client.ListCredentials(ctx, projName)
+	if err != nil {
+		return err
+	}
+	if httpStatusCode != http.StatusOK {
+		return fmt.Errorf("failed to get the list of credentials in %s. (status: %d)", projName, httpStatusCode)
+	}
+	for _, credential := range credentials {
+		if credential.ID == mirror.CredentialID {
+			return checkCredential(credential, mirror)
+		}
+	}
+	return fmt.Errorf("the credential does not exist: %s", mirror.CredentialID)
+}
+
+// checkCredential returns an error if the credential cannot be used to access the remote repository of the
+// mirror.
+func checkCredential(credential *centraldogma.Credential, mirror *centraldogma.Mirror) error {
+	if !credential.Enabled {
+		return fmt.Errorf("the credential is disabled: %s", credential.ID)
+	}
+	switch credential.Type {
+	case "public_key":
+		if mirror.RemoteScheme != "git+ssh" {
+			return fmt.Errorf("the public key credential %s cannot be used with %s", credential.ID,
+				mirror.RemoteScheme)
+		}
+	case "password", "access_token":
+		if mirror.RemoteScheme != "git+https" && mirror.RemoteScheme != "git+http" {
+			return fmt.Errorf("the %s credential %s cannot be used with %s", credential.Type, credential.ID,
+				mirror.RemoteScheme)
+		}
+	}
+
+	if len(credential.HostnamePatterns) == 0 {
+		return nil
+	}
+	host := mirror.RemoteURL
+	if i := strings.IndexAny(host, "/:"); i >= 0 {
+		host = host[:i]
+	}
+	for _, pattern := range credential.HostnamePatterns {
+		if matched, err := regexp.MatchString("^(?:"+pattern+")$", host); err == nil && matched {
+			return nil
+		}
+	}
+	return fmt.Errorf("the hostname patterns of the credential %s do not match %s: %v", credential.ID, host,
+		credential.HostnamePatterns)
+}
+
+var remoteSchemes = map[string]string{
+	"git":       "git",
+	"http":      "git+http",
+	"https":     "git+https",
+	"ssh":       "git+ssh",
+	"git+http":  "git+http",
+	"git+https": "git+https",
+	"git+ssh":   "git+ssh",
+}
+
+var scpLikeURLRegex = regexp.MustCompile(`^(?:[^@/]+@)?([^:/]+):(.+)$`)
+
+// parseRemoteURL splits the URL of the remote Git repository into the scheme and the rest, e.g.
+// "https://github.com/foo/bar.git" into "git+https" and "github.com/foo/bar.git". The scp-like URL such as
+// "git@github.com:foo/bar.git" is an SSH URL. The user is dropped because it is specified in the credential.
+func parseRemoteURL(value string) (scheme, remoteURL string, err error) {
+	i := strings.Index(value, "://")
+	if i < 0 {
+		matches := scpLikeURLRegex.FindStringSubmatch(value)
+		if matches == nil {
+			return "", "", fmt.Errorf("invalid remote URL: %s", value)
+		}
+		return "git+ssh", matches[1] + "/" + strings.TrimPrefix(matches[2], "/"), nil
+	}
+
+	scheme, ok := remoteSchemes[strings.ToLower(value[:i])]
+	if !ok {
+		return "", "", fmt.Errorf("unsupported scheme of the remote URL: %s (expected: https, http, ssh or git)",
+			value)
+	}
+	remoteURL = value[i+3:]
+	if at := strings.Index(remoteURL, "@"); at >= 0 && at < strings.Index(remoteURL+"/", "/") {
+		remoteURL = remoteURL[at+1:]
+	}
+	if len(remoteURL) == 0 || strings.HasPrefix(remoteURL, "/") {
+		return "", "", fmt.Errorf("invalid remote URL: %s", value)
+	}
+	return scheme, remoteURL, nil
+}
+
+// parseMirrorDirection parses the direction which is "remote-to-local" or "local-to-remote".
+func parseMirrorDirection(value string) (centraldogma.MirrorDirection, error) {
+	switch strings.ToUpper(strings.Replace(value, "-", "_", -1)) {
+	case "REMOTE_TO_LOCAL":
+		return centraldogma.RemoteToLocal, nil
+	case "LOCAL_TO_REMOTE":
+		return centraldogma.LocalToRemote, nil
+	default:
+		return 0, fmt.Errorf("unknown direction: %s (expected: remote-to-local or local-to-remote)", value)
+	}
+}
+
+// A cronField is a field of a Quartz cron expression.
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names []string // The names of the values from min, e.g. JAN for 1.
+}
+
+var cronFields = []cronField{
+	{name: "second", max: 59},
+	{name: "minute", max: 59},
+	{name: "hour", max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12,
+		names: []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}},
+	{name: "day of week", min: 1, max: 7, names: []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}},
+	{name: "year", min: 1970, max: 2099},
+}
+
+var (
+	dayOfMonthSpecialRegex = regexp.MustCompile(`^(?:L(?:-\d{1,2})?|LW|\d{1,2}W)$`)
+	dayOfWeekSpecialRegex  = regexp.MustCompile(`^(\w{1,3})(?:L|#[1-5])$`)
+)
+
+// validateSchedule returns an error if the schedule is not a valid Quartz cron expression, which has the
+// second, minute, hour, day of month, month, day of week and optional year fields, e.g. "0 */5 * * * ?".
+func validateSchedule(schedule string) error {
+	fields := strings.Fields(schedule)
+	if len(fields) != 6 && len(fields) != 7 {
+		return fmt.Errorf("expected 6 or 7 fields but %d", len(fields))
+	}
+	for i, value := range fields {
+		if err := cronFields[i].validate(value); err != nil {
+			return err
+		}
+	}
+	if (fields[3] == "?") == (fields[5] == "?") {
+		return errors.New("one of the day of month and the day of week should be ?")
+	}
+	return nil
+}
+
+func (f *cronField) validate(value string) error {
+	if value == "?" {
+		if f.name != "day of month" && f.name != "day of week" {
+			return fmt.Errorf("? is not allowed in the %s field", f.name)
+		}
+		return nil
+	}
+	for _, element := range strings.Split(strings.ToUpper(value), ",") {
+		if err := f.validateElement(element); err != nil {
+			return fmt.Errorf("invalid %s: %s", f.name, err)
+		}
+	}
+	return nil
+}
+
+func (f *cronField) validateElement(element string) error {
+	switch f.name {
+	case "day of month":
+		if dayOfMonthSpecialRegex.MatchString(element) {
+			return f.validateDay(strings.TrimRight(strings.TrimPrefix(element, "L-"), "LW"), element)
+		}
+	case "day of week":
+		if matches := dayOfWeekSpecialRegex.FindStringSubmatch(element); matches != nil {
+			_, err := f.parseValue(matches[1])
+			return err
+		}
+		if element == "L" {
+			return nil
+		}
+	}
+
+	rangeValue := element
+	if i := strings.Index(element, "/"); i >= 0 {
+		rangeValue = element[:i]
+		if step, err := strconv.Atoi(element[i+1:]); err != nil || step <= 0 {
+			return fmt.Errorf("%s (invalid step)", element)
+		}
+	}
+	if rangeValue == "*" {
+		return nil
+	}
+	bounds := strings.SplitN(rangeValue, "-", 2)
+	for _, bound := range bounds {
+		if _, err := f.parseValue(bound); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *cronField) validateDay(day, element string) error {
+	if len(day) == 0 {
+		return nil
+	}
+	if _, err := f.parseValue(day); err != nil {
+		return fmt.Errorf("%s (out of range)", element)
+	}
+	return nil
+}
+
+func (f *cronField) parseValue(value string) (int, error) {
+	for i, name := range f.names {
+		if value == name {
+			return f.min + i, nil
+		}
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < f.min || n > f.max {
+		return 0, fmt.Errorf("%s (expected: %d-%d)", value, f.min, f.max)
+	}
+	return n, nil
+}
+
+// applyMirrorFlags sets the fields of the mirror which are specified with the flags.
+func applyMirrorFlags(c *cli.Context, mirror *centraldogma.Mirror) error {
+	if repo := c.String("repo"); len(repo) != 0 {
+		mirror.LocalRepo = repo
+	}
+	if localPath := c.String("local-path"); len(localPath) != 0 {
+		mirror.LocalPath = localPath
+	}
+	if remote := c.String("remote"); len(remote) != 0 {
+		scheme, remoteURL, err := parseRemoteURL(remote)
+		if err != nil {
+			return err
+		}
+		mirror.RemoteScheme = scheme
+		mirror.RemoteURL = remoteURL
+	}
+	if remotePath := c.String("remote-path"); len(remotePath) != 0 {
+		mirror.RemotePath = remotePath
+	}
+	if branch := c.String("branch"); len(branch) != 0 {
+		mirror.RemoteBranch = branch
+	}
+	if direction := c.String("direction"); len(direction) != 0 {
+		d, err := parseMirrorDirection(direction)
+		if err != nil {
+			return err
+		}
+		mirror.Direction = d
+	}
+	if schedule := c.String("schedule"); len(schedule) != 0 {
+		mirror.Schedule = schedule
+	}
+	if credential := c.String("credential"); len(credential) != 0 {
+		mirror.CredentialID = credential
+	}
+	if c.Bool("enable") {
+		mirror.Enabled = true
+	}
+	if c.Bool("disable") {
+		mirror.Enabled = false
+	}
+	return nil
+}
+
+// newMirrorListCommand creates the mirrorListCommand.
+func newMirrorListCommand(c *cli.Context, style PrintStyle) (Command, error) {
+	if len(c.Args()) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	split := splitPath(c.Args().First())
+	if len(split) != 1 {
+		return nil, newCommandLineError(c)
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &mirrorListCommand{remoteURL: remoteURL, projName: split[0], style: style}, nil
+}
+
+// newMirrorCommand creates the mirrorCommand which creates the mirror, or updates it if update is true.
+func newMirrorCommand(c *cli.Context, style PrintStyle, update bool) (Command, error) {
+	projName, id, err := mirrorArgs(c)
+	if err != nil {
+		return nil, err
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	if c.Bool("enable") && c.Bool("disable") {
+		return nil, newCommandLineError(c)
+	}
+	return &mirrorCommand{remoteURL: remoteURL, projName: projName, id: id, update: update,
+		apply: func(mirror *centraldogma.Mirror) error {
+			return applyMirrorFlags(c, mirror)
+		}, test: c.Bool("test"), style: style}, nil
+}
+
+// newMirrorRemoveCommand creates the mirrorRemoveCommand.
+func newMirrorRemoveCommand(c *cli.Context) (Command, error) {
+	projName, id, err := mirrorArgs(c)
+	if err != nil {
+		return nil, err
+	}
+	remoteURL, err := getRemoteURLFromContext(c)
+	if err != nil {
+		return nil, err
+	}
+	return &mirrorRemoveCommand{remoteURL: remoteURL, projName: projName, id: id}, nil
+}
+
+// mirrorArgs returns the project name and the ID of the <project_name> <id> arguments.
+func mirrorArgs(c *cli.Context) (string, string, error) {
+	if len(c.Args()) != 2 {
+		return "", "", newCommandLineError(c)
+	}
+	split := splitPath(c.Args().First())
+	if len(split) != 1 || len(c.Args().Get(1)) == 0 {
+		return "", "", newCommandLineError(c)
+	}
+	return split[0], c.Args().Get(1), nil
+}
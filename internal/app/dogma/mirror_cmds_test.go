@@ -0,0 +1,201 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestValidateSchedule(t *testing.T) {
+	valid := []string{
+		"0 * * * * ?",
+		"0 */5 * * * ?",
+		"30 0/15 9-17 ? * MON-FRI",
+		"0 0 12 L * ?",
+		"0 0 12 15W * ?",
+		"0 0 12 ? JAN,JUL 6L",
+		"0 0 12 ? * 2#1 2026",
+	}
+	for _, schedule := range valid {
+		if err := validateSchedule(schedule); err != nil {
+			t.Errorf("validateSchedule(%q) = %v, want: nil", schedule, err)
+		}
+	}
+
+	invalid := []string{
+		"* * * * *",
+		"0 * * * * *",
+		"0 * * ? * ?",
+		"60 * * * * ?",
+		"0 * 24 * * ?",
+		"0 * * 32 * ?",
+		"0 * * * FOO ?",
+		"0 */0 * * * ?",
+		"? * * * * ?",
+	}
+	for _, schedule := range invalid {
+		if err := validateSchedule(schedule); err == nil {
+			t.Errorf("validateSchedule(%q) should return an error", schedule)
+		}
+	}
+}
+
+func TestParseRemoteURL(t *testing.T) {
+	var tests = []struct {
+		value     string
+		scheme    string
+		remoteURL string
+	}{
+		{"https://github.com/foo/bar.git", "git+https", "github.com/foo/bar.git"},
+		{"git+ssh://git@github.com/foo/bar.git", "git+ssh", "github.com/foo/bar.git"},
+		{"git@github.com:foo/bar.git", "git+ssh", "github.com/foo/bar.git"},
+		{"ssh://github.com:2222/foo/bar.git", "git+ssh", "github.com:2222/foo/bar.git"},
+	}
+
+	for _, test := range tests {
+		scheme, remoteURL, err := parseRemoteURL(test.value)
+		if err != nil || scheme != test.scheme || remoteURL != test.remoteURL {
+			t.Errorf("parseRemoteURL(%q) = (%q, %q, %v), want: (%q, %q, nil)", test.value, scheme, remoteURL,
+				err, test.scheme, test.remoteURL)
+		}
+	}
+	for _, value := range []string{"ftp://github.com/foo/bar.git", "github.com/foo/bar.git", "https:///foo"} {
+		if _, _, err := parseRemoteURL(value); err == nil {
+			t.Errorf("parseRemoteURL(%q) should return an error", value)
+		}
+	}
+}
+
+func TestCheckCredential(t *testing.T) {
+	mirror := &dogma.Mirror{RemoteScheme: "git+https", RemoteURL: "github.com/foo/bar.git"}
+	var tests = []struct {
+		credential dogma.Credential
+		valid      bool
+	}{
+		{dogma.Credential{ID: "a", Type: "access_token", Enabled: true}, true},
+		{dogma.Credential{ID: "a", Type: "access_token", Enabled: true, HostnamePatterns: []string{".*\\.com"}},
+			true},
+		{dogma.Credential{ID: "a", Type: "access_token", Enabled: true, HostnamePatterns: []string{"gitlab.com"}},
+			false},
+		{dogma.Credential{ID: "a", Type: "public_key", Enabled: true}, false},
+		{dogma.Credential{ID: "a", Type: "access_token"}, false},
+	}
+
+	for _, test := range tests {
+		if err := checkCredential(&test.credential, mirror); (err == nil) != test.valid {
+			t.Errorf("checkCredential(%+v) = %v, want valid: %t", test.credential, err, test.valid)
+		}
+	}
+}
+
+func newMirrorTestServer(t *testing.T, created *dogma.Mirror) *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"bar"}]`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/credentials", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":"github", "type":"public_key", "enabled":true}]`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/mirrors", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(b, created); err != nil {
+			t.Error(err)
+		}
+		w.WriteHeader(http.StatusCreated)
+	})
+	return httptest.NewTLSServer(mux)
+}
+
+func TestMirrorCreate(t *testing.T) {
+	var created dogma.Mirror
+	server := newMirrorTestServer(t, &created)
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	m := mirrorCommand{projName: "foo", id: "my-mirror", apply: func(mirror *dogma.Mirror) error {
+		mirror.LocalRepo = "bar"
+		mirror.RemoteScheme, mirror.RemoteURL = "git+ssh", "github.com/foo/bar.git"
+		mirror.CredentialID = "github"
+		return nil
+	}}
+	var err error
+	out := runCommandAndCaptureStdout(func() { err = m.executeWithDogmaClient(client) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "Created the mirror: my-mirror\n"; string(out) != want {
+		t.Errorf("Got output %q; want %q", out, want)
+	}
+	want := dogma.Mirror{ID: "my-mirror", Enabled: true, Schedule: defaultMirrorSchedule,
+		Direction: dogma.RemoteToLocal, LocalRepo: "bar", LocalPath: "/", RemoteScheme: "git+ssh",
+		RemoteURL: "github.com/foo/bar.git", RemotePath: "/", CredentialID: "github"}
+	if created != want {
+		t.Errorf("Got mirror %+v; want %+v", created, want)
+	}
+}
+
+func TestMirrorTest(t *testing.T) {
+	var created dogma.Mirror
+	server := newMirrorTestServer(t, &created)
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	var tests = []struct {
+		remoteScheme string
+		localRepo    string
+		schedule     string
+		wantErr      string
+	}{
+		{"git+ssh", "bar", "", ""},
+		{"git+https", "bar", "", "cannot be used with git+https"},
+		{"git+ssh", "baz", "", "the local repository does not exist"},
+		{"git+ssh", "bar", "0 * * * *", "invalid schedule"},
+	}
+
+	for _, test := range tests {
+		m := mirrorCommand{projName: "foo", id: "my-mirror", test: true, apply: func(mirror *dogma.Mirror) error {
+			mirror.LocalRepo = test.localRepo
+			mirror.RemoteScheme, mirror.RemoteURL = test.remoteScheme, "github.com/foo/bar.git"
+			mirror.CredentialID = "github"
+			if len(test.schedule) != 0 {
+				mirror.Schedule = test.schedule
+			}
+			return nil
+		}}
+		var err error
+		runCommandAndCaptureStdout(func() { err = m.executeWithDogmaClient(client) })
+		if len(test.wantErr) == 0 && err != nil {
+			t.Errorf("Got error %v; want nil", err)
+		}
+		if len(test.wantErr) != 0 && (err == nil || !strings.Contains(err.Error(), test.wantErr)) {
+			t.Errorf("Got error %v; want %q", err, test.wantErr)
+		}
+	}
+	if created.ID != "" {
+		t.Errorf("The mirror is created in the test mode: %+v", created)
+	}
+}
@@ -29,6 +29,7 @@ import (
 type newProjectCommand struct {
 	remoteURL string
 	name      string
+	style     PrintStyle
 }
 
 func (np *newProjectCommand) execute(c *cli.Context) error {
@@ -37,7 +38,7 @@ func (np *newProjectCommand) execute(c *cli.Context) error {
 		return err
 	}
 
-	_, httpStatusCode, err := client.CreateProject(context.Background(), np.name)
+	project, httpStatusCode, err := client.CreateProject(context.Background(), np.name)
 	if err != nil {
 		return err
 	}
@@ -45,6 +46,9 @@ func (np *newProjectCommand) execute(c *cli.Context) error {
 		return fmt.Errorf("failed to create %s (status: %d)", np.name, httpStatusCode)
 	}
 
+	if isStructured(np.style) {
+		return printWithStyle(project, np.style)
+	}
 	fmt.Printf("Created: /%s\n", np.name)
 	return nil
 }
@@ -55,6 +59,7 @@ type newRepositoryCommand struct {
 	remoteURL string
 	projName  string
 	repoName  string
+	style     PrintStyle
 }
 
 func (nr *newRepositoryCommand) execute(c *cli.Context) error {
@@ -63,22 +68,25 @@ func (nr *newRepositoryCommand) execute(c *cli.Context) error {
 		return err
 	}
 
-	_, httpStatusCode, err := client.CreateRepository(context.Background(), nr.projName, nr.repoName)
+	repo, httpStatusCode, err := client.CreateRepository(context.Background(), nr.projName, nr.repoName)
 	if err != nil {
 		return err
 	}
 	if httpStatusCode != http.StatusCreated {
 		return fmt.Errorf("failed to create %s (status: %d)", nr.repoName, httpStatusCode)
 	}
+	if isStructured(nr.style) {
+		return printWithStyle(repo, nr.style)
+	}
 	fmt.Printf("Created: /%s/%s\n", nr.projName, nr.repoName)
 	return nil
 }
 
-func newNewCommand(c *cli.Context) (Command, error) {
+func newNewCommand(c *cli.Context, style PrintStyle) (Command, error) {
 	if len(c.Args()) != 1 {
 		return nil, newCommandLineError(c)
 	}
-	remoteURL, err := getRemoteURL(c.Parent().String("connect"))
+	remoteURL, err := getRemoteURLFromContext(c)
 	if err != nil {
 		return nil, err
 	}
@@ -89,10 +97,10 @@ func newNewCommand(c *cli.Context) (Command, error) {
 	}
 
 	if len(split) == 1 {
-		return &newProjectCommand{remoteURL: remoteURL, name: split[0]}, nil
+		return &newProjectCommand{remoteURL: remoteURL, name: split[0], style: style}, nil
 	}
 
-	return &newRepositoryCommand{remoteURL: remoteURL, projName: split[0], repoName: split[1]}, nil
+	return &newRepositoryCommand{remoteURL: remoteURL, projName: split[0], repoName: split[1], style: style}, nil
 }
 
 // A putFileCommand puts a local file to the specified path which is
@@ -103,6 +111,7 @@ func newNewCommand(c *cli.Context) (Command, error) {
 type putFileCommand struct {
 	repo          repositoryRequestInfo
 	localFilePath string
+	style         PrintStyle
 }
 
 func (pf *putFileCommand) execute(c *cli.Context) error {
@@ -122,7 +131,7 @@ func (pf *putFileCommand) execute(c *cli.Context) error {
 		return err
 	}
 
-	_, httpStatusCode, err := client.Push(context.Background(),
+	result, httpStatusCode, err := client.Push(context.Background(),
 		repo.projName, repo.repoName, repo.revision, commitMessage, []*centraldogma.Change{change})
 	if err != nil {
 		return err
@@ -132,11 +141,11 @@ func (pf *putFileCommand) execute(c *cli.Context) error {
 			pf.localFilePath, repo.projName, repo.repoName,
 			repo.path, repo.revision, httpStatusCode)
 	}
-	fmt.Printf("Put: /%s/%s%s\n", repo.projName, repo.repoName, repo.path)
-	return nil
+	return printPushResult(pf.style, fmt.Sprintf("Put: /%s/%s%s", repo.projName, repo.repoName, repo.path),
+		repo.projName, repo.repoName, []string{repo.path}, result)
 }
 
-func newPutCommand(c *cli.Context) (Command, error) {
+func newPutCommand(c *cli.Context, style PrintStyle) (Command, error) {
 	if len(c.Args()) != 2 {
 		return nil, newCommandLineError(c)
 	}
@@ -157,5 +166,5 @@ func newPutCommand(c *cli.Context) (Command, error) {
 			repo.path = repo.path + baseFileName
 		}
 	}
-	return &putFileCommand{repo: repo, localFilePath: fileName}, nil
+	return &putFileCommand{repo: repo, localFilePath: fileName, style: style}, nil
 }
@@ -42,7 +42,7 @@ func TestNewNewCommand(t *testing.T) {
 
 	for _, test := range tests {
 		c := newContext(test.arguments, defaultRemoteURL, "")
-		got, _ := newNewCommand(c)
+		got, _ := newNewCommand(c, 0)
 
 		switch comType := got.(type) {
 		case *newProjectCommand:
@@ -162,7 +162,7 @@ func TestNewAddCommand(t *testing.T) {
 	for _, test := range tests {
 		c := newContext(test.arguments, defaultRemoteURL, test.revision)
 
-		got, _ := newPutCommand(c)
+		got, _ := newPutCommand(c, 0)
 		switch comType := got.(type) {
 		case *putFileCommand:
 			got2 := putFileCommand(*comType)
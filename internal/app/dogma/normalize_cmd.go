@@ -23,7 +23,8 @@ import (
 )
 
 type normalizeRevisionCommand struct {
-	repo repositoryRequestInfo
+	repo  repositoryRequestInfo
+	style PrintStyle
 }
 
 func (nr *normalizeRevisionCommand) execute(c *cli.Context) error {
@@ -42,14 +43,17 @@ func (nr *normalizeRevisionCommand) execute(c *cli.Context) error {
 			repo.projName, repo.repoName, repo.revision, httpStatusCode)
 	}
 
+	if isStructured(nr.style) {
+		return printWithStyle(map[string]interface{}{"revision": normalized}, nr.style)
+	}
 	fmt.Printf("normalized revision: %v\n", normalized)
 	return nil
 }
 
-func newNormalizeCommand(c *cli.Context) (Command, error) {
+func newNormalizeCommand(c *cli.Context, style PrintStyle) (Command, error) {
 	repo, err := newRepositoryRequestInfo(c)
 	if err != nil {
 		return nil, err
 	}
-	return &normalizeRevisionCommand{repo: repo}, nil
+	return &normalizeRevisionCommand{repo: repo, style: style}, nil
 }
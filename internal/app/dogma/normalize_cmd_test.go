@@ -48,7 +48,7 @@ func TestNewNormalizeCommand(t *testing.T) {
 
 	for _, test := range tests {
 		c := newContext(test.arguments, defaultRemoteURL, test.revision)
-		got, _ := newNormalizeCommand(c)
+		got, _ := newNormalizeCommand(c, 0)
 		switch comType := got.(type) {
 		case *normalizeRevisionCommand:
 			got2 := normalizeRevisionCommand(*comType)
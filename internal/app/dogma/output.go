@@ -0,0 +1,313 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"go.linecorp.com/centraldogma"
+)
+
+// isStructured returns true if the style prints the data for the machines rather than a message.
+func isStructured(style PrintStyle) bool {
+	return style == JSON || style == YAML || style == Table
+}
+
+// printWithStyle prints the data in the style. The field names are the JSON field names of the data, so
+// they are the same in all the styles.
+func printWithStyle(data interface{}, style PrintStyle) error {
+	var buf bytes.Buffer
+	if err := writeWithStyle(&buf, data, style); err != nil {
+		return err
+	}
+	_, err := os.Stdout.Write(buf.Bytes())
+	return err
+}
+
+func writeWithStyle(w io.Writer, data interface{}, style PrintStyle) error {
+	b, err := marshalIndentObject(data)
+	if err != nil {
+		return err
+	}
+	if style != YAML && style != Table {
+		_, err = fmt.Fprintf(w, "%s\n", b)
+		return err
+	}
+
+	// Decode the JSON again keeping the order of the fields, which is the order of the struct fields.
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+	value, err := decodeOrdered(decoder)
+	if err != nil {
+		return err
+	}
+	if style == YAML {
+		var buf bytes.Buffer
+		writeYAML(&buf, value, 0)
+		_, err = w.Write(buf.Bytes())
+		return err
+	}
+	return writeTable(w, value)
+}
+
+// pushOutput is the structured output of the commands which push changes.
+type pushOutput struct {
+	Project    string   `json:"project"`
+	Repository string   `json:"repository"`
+	Paths      []string `json:"paths"`
+	*centraldogma.PushResult
+}
+
+// printPushResult prints the result of the push if the style is structured, or the message otherwise.
+func printPushResult(style PrintStyle, message string, projName, repoName string, paths []string,
+	result *centraldogma.PushResult) error {
+	if !isStructured(style) {
+		fmt.Println(message)
+		return nil
+	}
+	return printWithStyle(&pushOutput{Project: projName, Repository: repoName, Paths: paths, PushResult: result},
+		style)
+}
+
+type orderedField struct {
+	key   string
+	value interface{}
+}
+
+// An orderedObject is a JSON object which keeps the order of its fields.
+type orderedObject []orderedField
+
+func (o orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, field := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(field.key)
+		value, err := json.Marshal(field.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+func decodeOrdered(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch token {
+	case json.Delim('{'):
+		object := orderedObject{}
+		for decoder.More() {
+			key, err := decoder.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, err
+			}
+			object = append(object, orderedField{key: key.(string), value: value})
+		}
+		_, err = decoder.Token() // '}'
+		return object, err
+	case json.Delim('['):
+		array := []interface{}{}
+		for decoder.More() {
+			value, err := decodeOrdered(decoder)
+			if err != nil {
+				return nil, err
+			}
+			array = append(array, value)
+		}
+		_, err = decoder.Token() // ']'
+		return array, err
+	default:
+		return token, nil
+	}
+}
+
+var plainYAMLString = regexp.MustCompile(`^[A-Za-z_/.][A-Za-z0-9_./@:+-]*$`)
+
+func yamlScalar(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		switch strings.ToLower(v) {
+		case "true", "false", "yes", "no", "on", "off", "null", "~":
+			return strconv.Quote(v)
+		}
+		if plainYAMLString.MatchString(v) && !strings.HasSuffix(v, ":") {
+			return v
+		}
+		return strconv.Quote(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func isEmptyCollection(value interface{}) (string, bool) {
+	switch v := value.(type) {
+	case orderedObject:
+		return "{}", len(v) == 0
+	case []interface{}:
+		return "[]", len(v) == 0
+	}
+	return "", false
+}
+
+// writeYAML writes the value decoded by decodeOrdered as a YAML document.
+func writeYAML(buf *bytes.Buffer, value interface{}, indent int) {
+	prefix := strings.Repeat(" ", indent)
+	switch v := value.(type) {
+	case orderedObject:
+		if len(v) == 0 {
+			buf.WriteString(prefix + "{}\n")
+		}
+		for _, field := range v {
+			buf.WriteString(prefix + yamlScalar(field.key) + ":")
+			writeYAMLValue(buf, field.value, indent+2)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			buf.WriteString(prefix + "[]\n")
+		}
+		for _, element := range v {
+			buf.WriteString(prefix + "-")
+			if object, ok := element.(orderedObject); ok && len(object) != 0 {
+				// The first field is on the same line as "-".
+				var nested bytes.Buffer
+				writeYAML(&nested, object, indent+2)
+				buf.WriteString(" ")
+				buf.Write(nested.Bytes()[indent+2:])
+				continue
+			}
+			writeYAMLValue(buf, element, indent+2)
+		}
+	default:
+		buf.WriteString(prefix + yamlScalar(v) + "\n")
+	}
+}
+
+func writeYAMLValue(buf *bytes.Buffer, value interface{}, indent int) {
+	if empty, ok := isEmptyCollection(value); ok {
+		buf.WriteString(" " + empty + "\n")
+		return
+	}
+	switch value.(type) {
+	case orderedObject, []interface{}:
+		buf.WriteString("\n")
+		writeYAML(buf, value, indent)
+	default:
+		buf.WriteString(" " + yamlScalar(value) + "\n")
+	}
+}
+
+// writeTable writes the value decoded by decodeOrdered as a table whose columns are the fields of the objects.
+// The nested objects and arrays are written in JSON.
+func writeTable(w io.Writer, value interface{}) error {
+	var rows []interface{}
+	switch v := value.(type) {
+	case []interface{}:
+		rows = v
+	case orderedObject:
+		rows = []interface{}{v}
+	default:
+		_, err := fmt.Fprintln(w, tableCell(v))
+		return err
+	}
+
+	var columns []string
+	seen := make(map[string]bool)
+	for _, row := range rows {
+		object, ok := row.(orderedObject)
+		if !ok {
+			if !seen[""] {
+				columns = append(columns, "")
+				seen[""] = true
+			}
+			continue
+		}
+		for _, field := range object {
+			if !seen[field.key] {
+				columns = append(columns, field.key)
+				seen[field.key] = true
+			}
+		}
+	}
+	if len(columns) == 0 {
+		return nil
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		if column == "" {
+			column = "value"
+		}
+		header[i] = strings.ToUpper(column)
+	}
+	fmt.Fprintln(tw, strings.Join(header, "\t"))
+	for _, row := range rows {
+		cells := make([]string, len(columns))
+		object, ok := row.(orderedObject)
+		for i, column := range columns {
+			if !ok {
+				if column == "" {
+					cells[i] = tableCell(row)
+				}
+				continue
+			}
+			for _, field := range object {
+				if field.key == column {
+					cells[i] = tableCell(field.value)
+					break
+				}
+			}
+		}
+		fmt.Fprintln(tw, strings.Join(cells, "\t"))
+	}
+	return tw.Flush()
+}
+
+func tableCell(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.NewReplacer("\t", " ", "\n", " ").Replace(v)
+	case orderedObject, []interface{}:
+		b, _ := json.Marshal(v)
+		return string(b)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+)
+
+type testOutput struct {
+	Name    string            `json:"name"`
+	Enabled bool              `json:"enabled"`
+	Count   int               `json:"count,omitempty"`
+	Labels  map[string]string `json:"labels,omitempty"`
+	Paths   []string          `json:"paths"`
+}
+
+func TestWriteWithStyle(t *testing.T) {
+	data := []*testOutput{
+		{Name: "foo", Enabled: true, Count: 2, Labels: map[string]string{"env": "prod"}, Paths: []string{"/a.json"}},
+		{Name: "true", Paths: []string{}},
+	}
+	tests := []struct {
+		style PrintStyle
+		want  string
+	}{
+		{JSON, `[
+  {
+    "name": "foo",
+    "enabled": true,
+    "count": 2,
+    "labels": {
+      "env": "prod"
+    },
+    "paths": [
+      "/a.json"
+    ]
+  },
+  {
+    "name": "true",
+    "enabled": false,
+    "paths": []
+  }
+]
+`},
+		{YAML, `- name: foo
+  enabled: true
+  count: 2
+  labels:
+    env: prod
+  paths:
+    - /a.json
+- name: "true"
+  enabled: false
+  paths: []
+`},
+		{Table, `NAME  ENABLED  COUNT  LABELS          PATHS
+foo   true     2      {"env":"prod"}  ["/a.json"]
+true  false                           []
+`},
+	}
+	for _, test := range tests {
+		var buf bytes.Buffer
+		if err := writeWithStyle(&buf, data, test.style); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("writeWithStyle(%v) = %q; want %q", test.style, got, test.want)
+		}
+	}
+}
+
+func TestYAMLScalar(t *testing.T) {
+	tests := map[interface{}]string{
+		nil:            "null",
+		"":             `""`,
+		"foo":          "foo",
+		"/a/b.json":    "/a/b.json",
+		"no":           `"no"`,
+		"123":          `"123"`,
+		"a: b":         `"a: b"`,
+		"line\nline":   `"line\nline"`,
+		"2020-01-01":   `"2020-01-01"`,
+		"appToken-foo": "appToken-foo",
+	}
+	for value, want := range tests {
+		if got := yamlScalar(value); got != want {
+			t.Errorf("yamlScalar(%q) = %s; want %s", value, got, want)
+		}
+	}
+}
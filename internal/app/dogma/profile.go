@@ -0,0 +1,198 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bufio"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/urfave/cli"
+)
+
+const defaultProfileName = "default"
+
+// A profile is a named set of the connection settings in the config file, which looks like:
+//
+//	[default]
+//	host = https://dogma-staging.example.com:36462
+//	token = appToken-xxx
+//
+//	[prod]
+//	host = https://dogma.example.com:36462
+//	token = appToken-yyy
+//	ca-cert = /etc/dogma/ca.pem
+type profile struct {
+	host               string
+	token              string
+	caCert             string
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+}
+
+// configFilePath returns the path of the config file, which is $XDG_CONFIG_HOME/dogma/config or
+// ~/.config/dogma/config.
+func configFilePath() string {
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if len(configHome) == 0 {
+		homeDir, err := os.UserHomeDir()
+		if err != nil {
+			return ""
+		}
+		configHome = filepath.Join(homeDir, ".config")
+	}
+	return filepath.Join(configHome, "dogma", "config")
+}
+
+// currentProfile returns the profile selected with --profile or DOGMA_PROFILE, or the default profile.
+// An empty profile is returned if no profile is selected and there is no default profile.
+func currentProfile(c *cli.Context) (*profile, error) {
+	name := c.GlobalString("profile")
+	profiles, err := readProfiles(configFilePath())
+	if err != nil {
+		return nil, err
+	}
+	if len(name) == 0 {
+		if p, ok := profiles[defaultProfileName]; ok {
+			return p, nil
+		}
+		return &profile{}, nil
+	}
+	p, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("profile %q does not exist in %s", name, configFilePath())
+	}
+	return p, nil
+}
+
+func readProfiles(configFilePath string) (map[string]*profile, error) {
+	if len(configFilePath) == 0 {
+		return nil, nil
+	}
+	fd, err := os.Open(configFilePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer fd.Close()
+
+	profiles, err := parseProfiles(fd)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", configFilePath, err)
+	}
+	if fileInfo, err := fd.Stat(); err == nil && fileInfo.Mode().Perm()&0077 != 0 {
+		for _, p := range profiles {
+			if len(p.token) != 0 {
+				fmt.Fprintf(os.Stderr, "Warning: %s which has tokens is accessible by others\n", configFilePath)
+				break
+			}
+		}
+	}
+	return profiles, nil
+}
+
+func parseProfiles(r io.Reader) (map[string]*profile, error) {
+	profiles := make(map[string]*profile)
+	var current *profile
+	scanner := bufio.NewScanner(r)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if len(name) == 0 {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNo)
+			}
+			current = &profile{}
+			profiles[name] = current
+			continue
+		}
+
+		i := strings.Index(line, "=")
+		if i < 0 {
+			return nil, fmt.Errorf("line %d: not a key = value pair", lineNo)
+		}
+		if current == nil {
+			return nil, fmt.Errorf("line %d: %s is not in a profile", lineNo, line)
+		}
+		key, value := strings.TrimSpace(line[:i]), strings.TrimSpace(line[i+1:])
+		switch key {
+		case "host":
+			current.host = value
+		case "token":
+			current.token = value
+		case "ca-cert":
+			current.caCert = value
+		case "client-cert":
+			current.clientCert = value
+		case "client-key":
+			current.clientKey = value
+		case "insecure-skip-verify":
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid %s: %s", lineNo, key, value)
+			}
+			current.insecureSkipVerify = b
+		default:
+			return nil, fmt.Errorf("line %d: unknown key: %s", lineNo, key)
+		}
+	}
+	return profiles, scanner.Err()
+}
+
+// transport returns the transport configured with the TLS settings of the profile, or nil if there are
+// no TLS settings.
+func (p *profile) transport() (http.RoundTripper, error) {
+	if len(p.caCert) == 0 && len(p.clientCert) == 0 && !p.insecureSkipVerify {
+		return nil, nil
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: p.insecureSkipVerify}
+	if len(p.caCert) != 0 {
+		pem, err := ioutil.ReadFile(p.caCert)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		if !tlsConfig.RootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates in %s", p.caCert)
+		}
+	}
+	if len(p.clientCert) != 0 || len(p.clientKey) != 0 {
+		if len(p.clientCert) == 0 || len(p.clientKey) == 0 {
+			return nil, errors.New("both client-cert and client-key must be specified")
+		}
+		cert, err := tls.LoadX509KeyPair(p.clientCert, p.clientKey)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: tlsConfig}, nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli"
+)
+
+const testConfig = `
+# The staging cluster
+[default]
+host = https://dogma-staging.example.com:36462
+token = appToken-staging
+
+[prod]
+host  =  https://dogma.example.com:36462
+token = appToken-prod
+insecure-skip-verify = true
+`
+
+func TestParseProfiles(t *testing.T) {
+	profiles, err := parseProfiles(strings.NewReader(testConfig))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]*profile{
+		"default": {host: "https://dogma-staging.example.com:36462", token: "appToken-staging"},
+		"prod":    {host: "https://dogma.example.com:36462", token: "appToken-prod", insecureSkipVerify: true},
+	}
+	if !reflect.DeepEqual(profiles, want) {
+		t.Errorf("parseProfiles() = %v; want %v", profiles, want)
+	}
+
+	for _, config := range []string{
+		"host = https://dogma.example.com",
+		"[prod]\nhost",
+		"[prod]\nport = 36462",
+		"[prod]\ninsecure-skip-verify = maybe",
+		"[]",
+	} {
+		if _, err = parseProfiles(strings.NewReader(config)); err == nil {
+			t.Errorf("parseProfiles(%q) succeeded; want an error", config)
+		}
+	}
+}
+
+func newProfileContext(connectURL, profileName string) *cli.Context {
+	parentFlags := flag.NewFlagSet("test", 0)
+	parentFlags.String("connect", connectURL, "")
+	parentFlags.String("profile", profileName, "")
+	parent := cli.NewContext(nil, parentFlags, nil)
+	return cli.NewContext(nil, flag.NewFlagSet("test", 0), parent)
+}
+
+func TestCurrentProfile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "dogma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	defer os.Setenv("XDG_CONFIG_HOME", configHome)
+	os.Setenv("XDG_CONFIG_HOME", dir)
+
+	// There is no config file.
+	remoteURL, err := getRemoteURLFromContext(newProfileContext("http://localhost:36462", ""))
+	if err != nil || remoteURL != "http://localhost:36462" {
+		t.Errorf("getRemoteURLFromContext() = (%q, %v); want http://localhost:36462", remoteURL, err)
+	}
+
+	if err = os.MkdirAll(filepath.Join(dir, "dogma"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(dir, "dogma", "config"), []byte(testConfig), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		connectURL  string
+		profileName string
+		want        string
+	}{
+		{"", "", "https://dogma-staging.example.com:36462"},
+		{"", "prod", "https://dogma.example.com:36462"},
+		{"http://localhost:36462", "prod", "http://localhost:36462"},
+	}
+	for _, test := range tests {
+		remoteURL, err = getRemoteURLFromContext(newProfileContext(test.connectURL, test.profileName))
+		if err != nil || remoteURL != test.want {
+			t.Errorf("getRemoteURLFromContext() with connect: %q, profile: %q = (%q, %v); want %q",
+				test.connectURL, test.profileName, remoteURL, err, test.want)
+		}
+	}
+
+	if _, err = currentProfile(newProfileContext("", "dev")); err == nil {
+		t.Errorf("currentProfile() with a missing profile succeeded; want an error")
+	}
+}
@@ -24,7 +24,8 @@ import (
 )
 
 type rmFileCommand struct {
-	repo repositoryRequestInfo
+	repo  repositoryRequestInfo
+	style PrintStyle
 }
 
 func (rf *rmFileCommand) execute(c *cli.Context) error {
@@ -41,7 +42,7 @@ func (rf *rmFileCommand) execute(c *cli.Context) error {
 		return err
 	}
 
-	_, httpStatusCode, err := client.Push(context.Background(),
+	result, httpStatusCode, err := client.Push(context.Background(),
 		repo.projName, repo.repoName, repo.revision, commitMessage, []*centraldogma.Change{change})
 	if err != nil {
 		return err
@@ -51,15 +52,15 @@ func (rf *rmFileCommand) execute(c *cli.Context) error {
 			repo.projName, repo.repoName, repo.path, repo.revision, httpStatusCode)
 	}
 
-	fmt.Printf("Deleted: /%s/%s%s\n", repo.projName, repo.repoName, repo.path)
-	return nil
+	return printPushResult(rf.style, fmt.Sprintf("Deleted: /%s/%s%s", repo.projName, repo.repoName, repo.path),
+		repo.projName, repo.repoName, []string{repo.path}, result)
 }
 
-func newRMCommand(c *cli.Context) (Command, error) {
+func newRMCommand(c *cli.Context, style PrintStyle) (Command, error) {
 	repo, err := newRepositoryRequestInfo(c)
 	if err != nil {
 		return nil, err
 	}
 
-	return &rmFileCommand{repo: repo}, nil
+	return &rmFileCommand{repo: repo, style: style}, nil
 }
@@ -0,0 +1,292 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"syscall"
+
+	dogma "go.linecorp.com/centraldogma"
+
+	"github.com/urfave/cli"
+)
+
+// syncManifestName is the name of the file in the local directory which records the synced files, so that
+// only the files which are removed from the repository are removed from the local directory.
+const syncManifestName = ".dogma-sync"
+
+// A syncCommand mirrors the files matched by the path pattern into a local directory, and keeps them up to
+// date until it is interrupted unless once is true.
+type syncCommand struct {
+	repo         repositoryRequestInfo
+	localDirPath string
+	filter       *fileFilter
+	onChange     string // The shell command which is executed after the files are changed.
+	once         bool
+}
+
+// syncManifest is the content of the manifest file.
+type syncManifest struct {
+	Revision string   `json:"revision"`
+	Files    []string `json:"files"`
+}
+
+func (sc *syncCommand) execute(c *cli.Context) error {
+	client, err := newDogmaClient(c, sc.repo.remoteURL)
+	if err != nil {
+		return err
+	}
+	return sc.executeWithDogmaClient(client)
+}
+
+func (sc *syncCommand) executeWithDogmaClient(client *dogma.Client) error {
+	repo := sc.repo
+	normalizedRevision, _, err := client.NormalizeRevision(
+		context.Background(), repo.projName, repo.repoName, repo.revision)
+	if err != nil {
+		return err
+	}
+	if err = sc.syncAndNotify(client, fmt.Sprint(normalizedRevision)); err != nil {
+		return err
+	}
+	if sc.once {
+		return nil
+	}
+
+	fw, err := client.RepoWatcher(repo.projName, repo.repoName, dirPathPattern(repo.path))
+	if err != nil {
+		return err
+	}
+	defer fw.Close()
+
+	// Keep only the latest revision if the files are still being synced when the next revision is pushed.
+	updates := make(chan dogma.WatchResult, 1)
+	err = fw.Watch(func(watchResult dogma.WatchResult) {
+		select {
+		case <-updates:
+		default:
+		}
+		updates <- watchResult
+	})
+	if err != nil {
+		return err
+	}
+
+	signalChan := make(chan os.Signal, 1)
+	signal.Notify(signalChan, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(signalChan)
+	lastRevision := normalizedRevision
+	for {
+		select {
+		case <-signalChan:
+			fmt.Println("\nReceived a signal, stopping sync...")
+			return nil
+		case watchResult := <-updates:
+			if watchResult.Revision <= lastRevision {
+				continue
+			}
+			// Keep running even if it fails to sync, because it will be synced with the next revision.
+			if err := sc.syncAndNotify(client, fmt.Sprint(watchResult.Revision)); err != nil {
+				fmt.Fprintf(os.Stderr, "Failed to sync the revision %v: %v\n", watchResult.Revision, err)
+				continue
+			}
+			lastRevision = watchResult.Revision
+		}
+	}
+}
+
+// syncAndNotify syncs the files at the revision and executes the command if any file is changed.
+func (sc *syncCommand) syncAndNotify(client *dogma.Client, revision string) error {
+	updated, removed, err := sc.sync(client, revision)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Synced /%s/%s%s revision %s to %s (updated: %d, removed: %d)\n", sc.repo.projName,
+		sc.repo.repoName, sc.repo.path, revision, sc.localDirPath, updated, removed)
+	if updated+removed == 0 || len(sc.onChange) == 0 {
+		return nil
+	}
+
+	var command *exec.Cmd
+	if runtime.GOOS == "windows" {
+		command = exec.Command("cmd", "/C", sc.onChange)
+	} else {
+		command = exec.Command("sh", "-c", sc.onChange)
+	}
+	command.Env = append(os.Environ(), "DOGMA_SYNC_REV="+revision, "DOGMA_SYNC_DIR="+sc.localDirPath)
+	command.Stdout = os.Stdout
+	command.Stderr = os.Stderr
+	if err := command.Run(); err != nil {
+		// The files are synced anyway, so the failure of the command does not stop syncing.
+		fmt.Fprintf(os.Stderr, "Failed to execute %q: %v\n", sc.onChange, err)
+	}
+	return nil
+}
+
+// sync writes the files at the revision which are different from the local files, and removes the local
+// files which were synced before but are removed from the repository. It returns the number of the updated
+// files and the removed files.
+func (sc *syncCommand) sync(client *dogma.Client, revision string) (updated, removed int, err error) {
+	repo := sc.repo
+	pathPattern := dirPathPattern(repo.path)
+	entries, httpStatusCode, err := client.GetFiles(
+		context.Background(), repo.projName, repo.repoName, revision, pathPattern)
+	if err != nil {
+		return 0, 0, err
+	}
+	if httpStatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("failed to get the files: /%s/%s%s revision: %q (status: %d)",
+			repo.projName, repo.repoName, pathPattern, revision, httpStatusCode)
+	}
+	if err = os.MkdirAll(sc.localDirPath, 0755); err != nil {
+		return 0, 0, err
+	}
+	previous, err := sc.readManifest()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	basePath := patternBasePath(pathPattern)
+	manifest := &syncManifest{Revision: revision, Files: []string{}}
+	synced := make(map[string]bool)
+	for _, entry := range entries {
+		if entry.Type == dogma.Directory {
+			continue
+		}
+		relPath := strings.TrimPrefix(entry.Path, basePath)
+		if relPath == syncManifestName || !sc.filter.matches(relPath) {
+			continue
+		}
+		localFilePath, err := joinLocalPath(sc.localDirPath, relPath)
+		if err != nil {
+			return updated, removed, err
+		}
+		manifest.Files = append(manifest.Files, relPath)
+		synced[relPath] = true
+
+		content := entryContent(entry)
+		if current, err := ioutil.ReadFile(localFilePath); err == nil && bytes.Equal(current, content) {
+			continue
+		}
+		if err = writeFileAtomically(localFilePath, content); err != nil {
+			return updated, removed, err
+		}
+		updated++
+	}
+
+	for _, relPath := range previous.Files {
+		if synced[relPath] {
+			continue
+		}
+		localFilePath, err := joinLocalPath(sc.localDirPath, relPath)
+		if err != nil {
+			continue
+		}
+		if err = os.Remove(localFilePath); err != nil && !os.IsNotExist(err) {
+			return updated, removed, err
+		}
+		removeEmptyDirs(filepath.Dir(localFilePath), sc.localDirPath)
+		removed++
+	}
+
+	sort.Strings(manifest.Files)
+	data, err := marshalIndentObject(manifest)
+	if err != nil {
+		return updated, removed, err
+	}
+	return updated, removed, writeFileAtomically(filepath.Join(sc.localDirPath, syncManifestName), data)
+}
+
+func (sc *syncCommand) readManifest() (*syncManifest, error) {
+	manifest := &syncManifest{}
+	data, err := ioutil.ReadFile(filepath.Join(sc.localDirPath, syncManifestName))
+	if os.IsNotExist(err) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("invalid %s in %s: %v", syncManifestName, sc.localDirPath, err)
+	}
+	return manifest, nil
+}
+
+// writeFileAtomically writes the content to a temporary file in the same directory and renames it, so that
+// the readers never see a partially written file.
+func writeFileAtomically(filePath string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+		return err
+	}
+	fd, err := ioutil.TempFile(filepath.Dir(filePath), "."+filepath.Base(filePath)+".tmp")
+	if err != nil {
+		return err
+	}
+	tempFilePath := fd.Name()
+	_, err = fd.Write(content)
+	if closeErr := fd.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tempFilePath, 0644)
+	}
+	if err == nil {
+		err = os.Rename(tempFilePath, filePath)
+	}
+	if err != nil {
+		os.Remove(tempFilePath)
+	}
+	return err
+}
+
+// removeEmptyDirs removes the directory and its parents while they are empty, up to the root directory
+// which is not removed.
+func removeEmptyDirs(dir, root string) {
+	for dir != root && strings.HasPrefix(dir, root) {
+		if os.Remove(dir) != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// newSyncCommand creates the syncCommand.
+func newSyncCommand(c *cli.Context) (Command, error) {
+	if len(c.Args()) != 2 || len(c.Args().Get(1)) == 0 {
+		return nil, newCommandLineError(c)
+	}
+	repo, err := newRepositoryRequestInfo(c)
+	if err != nil {
+		return nil, err
+	}
+	filter, err := newFileFilter(c.StringSlice("include"), c.StringSlice("exclude"))
+	if err != nil {
+		return nil, err
+	}
+	return &syncCommand{repo: repo, localDirPath: filepath.Clean(c.Args().Get(1)), filter: filter,
+		onChange: c.String("on-change"), once: c.Bool("once")}, nil
+}
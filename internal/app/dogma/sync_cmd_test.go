@@ -0,0 +1,92 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dogma "go.linecorp.com/centraldogma"
+)
+
+func TestSync(t *testing.T) {
+	entries := map[string]string{
+		"1": `[{"path": "/conf/a.json", "type": "JSON", "content": {"a":1}},
+			{"path": "/conf/sub", "type": "DIRECTORY"},
+			{"path": "/conf/sub/b.txt", "type": "TEXT", "content": "b"}]`,
+		"2": `[{"path": "/conf/a.json", "type": "JSON", "content": {"a":1}},
+			{"path": "/conf/c.txt", "type": "TEXT", "content": "c"}]`,
+	}
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprint(w, entries[r.URL.Query().Get("revision")])
+		}))
+	defer server.Close()
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	dir, err := ioutil.TempDir("", "dogma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	// A local file which is not synced is never removed.
+	if err = ioutil.WriteFile(filepath.Join(dir, "local.txt"), []byte("local"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	filter, _ := newFileFilter(nil, nil)
+	sc := syncCommand{
+		repo:         repositoryRequestInfo{projName: "foo", repoName: "bar", path: "/conf/", revision: "-1"},
+		localDirPath: dir,
+		filter:       filter,
+	}
+	var tests = []struct {
+		revision string
+		updated  int
+		removed  int
+		files    map[string]string
+	}{
+		{"1", 2, 0, map[string]string{"a.json": "{\n  \"a\": 1\n}", "sub/b.txt": "b", "local.txt": "local"}},
+		{"2", 1, 1, map[string]string{"a.json": "{\n  \"a\": 1\n}", "c.txt": "c", "local.txt": "local"}},
+	}
+
+	for _, test := range tests {
+		updated, removed, err := sc.sync(client, test.revision)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if updated != test.updated || removed != test.removed {
+			t.Errorf("sync(%s) = (%d, %d); want (%d, %d)", test.revision, updated, removed,
+				test.updated, test.removed)
+		}
+		for name, want := range test.files {
+			content, err := ioutil.ReadFile(filepath.Join(dir, filepath.FromSlash(name)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(content) != want {
+				t.Errorf("Got content %q of %s; want %q", content, name, want)
+			}
+		}
+	}
+	if _, err = os.Stat(filepath.Join(dir, "sub")); !os.IsNotExist(err) {
+		t.Errorf("The empty directory sub exists: %v", err)
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"os/exec"
 	"os/signal"
@@ -34,6 +35,7 @@ type watchCommand struct {
 	jsonPaths    []string
 	streaming    bool
 	listenerFile string
+	printDiff    bool
 }
 
 type listenerExecError struct {
@@ -59,14 +61,44 @@ func (wc *watchCommand) defaultListener(watchResult dogma.WatchResult) error {
 	return nil
 }
 
+func (wc *watchCommand) diffListener(changes []*dogma.Change, watchResult dogma.WatchResult) error {
+	repo := wc.repo
+	fmt.Printf("Watcher noticed updated files: %s/%s%s, rev=%v\n",
+		repo.projName, repo.repoName, repo.path, watchResult.Revision)
+	for _, change := range changes {
+		data, err := marshalIndentObject(change)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("%s\n", data)
+	}
+	return nil
+}
+
 func (wc *watchCommand) commandExecutionListener(watchResult dogma.WatchResult) error {
-	command := exec.Command(wc.listenerFile)
-	command.Env = append(os.Environ(),
+	return wc.executeListener(watchResult.Entry.Content,
 		"DOGMA_WATCH_EVENT_PATH="+watchResult.Entry.Path,
 		"DOGMA_WATCH_EVENT_CONTENT_TYPE="+watchResult.Entry.Type.String(),
-		"DOGMA_WATCH_EVENT_REV="+strconv.Itoa(watchResult.Revision),
+		"DOGMA_WATCH_EVENT_REV="+strconv.FormatInt(watchResult.Revision, 10),
 		"DOGMA_WATCH_EVENT_URL="+watchResult.Entry.URL)
-	command.Stdin = bytes.NewReader(watchResult.Entry.Content)
+}
+
+// diffExecutionListener executes the listener with the JSON array of the changes as its STDIN.
+func (wc *watchCommand) diffExecutionListener(changes []*dogma.Change, watchResult dogma.WatchResult) error {
+	data, err := marshalIndentObject(changes)
+	if err != nil {
+		return err
+	}
+	return wc.executeListener(data,
+		"DOGMA_WATCH_EVENT_PATH="+wc.repo.path,
+		"DOGMA_WATCH_EVENT_CONTENT_TYPE=DIFF",
+		"DOGMA_WATCH_EVENT_REV="+fmt.Sprint(watchResult.Revision))
+}
+
+func (wc *watchCommand) executeListener(stdin []byte, env ...string) error {
+	command := exec.Command(wc.listenerFile)
+	command.Env = append(os.Environ(), env...)
+	command.Stdin = bytes.NewReader(stdin)
 	command.Stdout = os.Stdout
 	command.Stderr = os.Stderr
 	err := command.Run()
@@ -93,8 +125,13 @@ func (wc *watchCommand) executeWithDogmaClient(c *cli.Context, client *dogma.Cli
 		return err
 	}
 
-	query := createQuery(repo.path, wc.jsonPaths)
-	fw, err := client.FileWatcher(repo.projName, repo.repoName, query)
+	pathPattern, isPattern := watchPathPattern(repo.path)
+	var fw *dogma.Watcher
+	if isPattern {
+		fw, err = client.RepoWatcher(repo.projName, repo.repoName, pathPattern)
+	} else {
+		fw, err = client.FileWatcher(repo.projName, repo.repoName, createQuery(repo.path, wc.jsonPaths))
+	}
 	if err != nil {
 		return err
 	}
@@ -112,10 +149,26 @@ func (wc *watchCommand) executeWithDogmaClient(c *cli.Context, client *dogma.Cli
 	}
 
 	listener := wc.defaultListener
-
 	if wc.listenerFile != "" {
 		listener = wc.commandExecutionListener
 	}
+	if isPattern || wc.printDiff {
+		// A path pattern has no content to print, so the changes since the previous revision are printed.
+		diffListener := wc.diffListener
+		if wc.listenerFile != "" {
+			diffListener = wc.diffExecutionListener
+		}
+		lastRevision := normalizedRevision
+		listener = func(watchResult dogma.WatchResult) error {
+			changes, err := getChanges(client, repo, pathPattern, isPattern,
+				fmt.Sprint(lastRevision), fmt.Sprint(watchResult.Revision))
+			if err != nil {
+				return err
+			}
+			lastRevision = watchResult.Revision
+			return diffListener(changes, watchResult)
+		}
+	}
 
 	// start watching
 	err = fw.Watch(func(watchResult dogma.WatchResult) {
@@ -150,6 +203,45 @@ func (wc *watchCommand) executeWithDogmaClient(c *cli.Context, client *dogma.Cli
 	return <-done
 }
 
+// getChanges returns the changes of the watched file or the files matched by the path pattern between
+// the from and to revisions.
+func getChanges(client *dogma.Client, repo repositoryRequestInfo, pathPattern string, isPattern bool,
+	from, to string) ([]*dogma.Change, error) {
+	if isPattern {
+		changes, httpStatusCode, err := client.GetDiffs(
+			context.Background(), repo.projName, repo.repoName, from, to, pathPattern)
+		if err != nil {
+			return nil, err
+		}
+		if httpStatusCode != http.StatusOK {
+			return nil, fmt.Errorf("failed to get the diff of /%s/%s%s from: %q, to: %q (status: %d)",
+				repo.projName, repo.repoName, pathPattern, from, to, httpStatusCode)
+		}
+		return changes, nil
+	}
+
+	query := &dogma.Query{Path: repo.path, Type: dogma.Identity}
+	change, httpStatusCode, err := client.GetDiff(
+		context.Background(), repo.projName, repo.repoName, from, to, query)
+	if err != nil {
+		return nil, err
+	}
+	if httpStatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the diff of /%s/%s%s from: %q, to: %q (status: %d)",
+			repo.projName, repo.repoName, repo.path, from, to, httpStatusCode)
+	}
+	return []*dogma.Change{change}, nil
+}
+
+// watchPathPattern returns the path pattern to watch and true if the path is a path pattern rather than
+// a file. A path which ends with "/" watches all the files under the directory.
+func watchPathPattern(repoPath string) (string, bool) {
+	if strings.HasSuffix(repoPath, "/") {
+		return repoPath + "**", true
+	}
+	return repoPath, strings.ContainsAny(repoPath, "*,")
+}
+
 // newWatchCommand creates the watchCommand.
 func newWatchCommand(c *cli.Context) (Command, error) {
 	repo, err := newRepositoryRequestInfo(c)
@@ -157,5 +249,6 @@ func newWatchCommand(c *cli.Context) (Command, error) {
 		return nil, err
 	}
 
-	return &watchCommand{repo: repo, jsonPaths: c.StringSlice("jsonpath"), streaming: c.Bool("streaming"), listenerFile: c.String("listener")}, nil
+	return &watchCommand{repo: repo, jsonPaths: c.StringSlice("jsonpath"), streaming: c.Bool("streaming"),
+		listenerFile: c.String("listener"), printDiff: c.Bool("diff")}, nil
 }
@@ -158,3 +158,70 @@ func TestInvalidListenerOption(t *testing.T) {
 	}
 
 }
+
+func TestWatchPathPattern(t *testing.T) {
+	tests := []struct {
+		path        string
+		wantPattern string
+		wantIsGlob  bool
+	}{
+		{"/foo.json", "/foo.json", false},
+		{"/", "/**", true},
+		{"/a/", "/a/**", true},
+		{"/a/*.json", "/a/*.json", true},
+		{"/a.json,/b.json", "/a.json,/b.json", true},
+	}
+	for _, test := range tests {
+		pattern, isGlob := watchPathPattern(test.path)
+		if pattern != test.wantPattern || isGlob != test.wantIsGlob {
+			t.Errorf("watchPathPattern(%q) = (%q, %t); want (%q, %t)",
+				test.path, pattern, isGlob, test.wantPattern, test.wantIsGlob)
+		}
+	}
+}
+
+func TestWatchPathPatternPrintsDiff(t *testing.T) {
+	var compareQuery string
+	server := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch {
+			case strings.HasSuffix(r.URL.Path, "/revision/-1"):
+				fmt.Fprint(w, `{"revision": 2}`)
+			case strings.HasSuffix(r.URL.Path, "/compare"):
+				compareQuery = r.URL.RawQuery
+				fmt.Fprint(w, `[{"path": "/a/foo.json", "type": "APPLY_JSON_PATCH",
+					"content": [{"op": "replace", "path": "/foo", "value": "BAR"}]}]`)
+			default:
+				fmt.Fprint(w, `{"revision": 3}`)
+			}
+		}))
+	defer server.Close()
+
+	client, _ := dogma.NewClientWithToken(server.URL, "anonymous", server.Client().Transport)
+
+	wc := watchCommand{
+		repo: repositoryRequestInfo{
+			remoteURL: server.URL,
+			projName:  "test",
+			repoName:  "test",
+			path:      "/a/",
+			revision:  "-1",
+		},
+	}
+
+	var err error
+	out := string(runCommandAndCaptureStdout(func() { err = wc.executeWithDogmaClient(nil, client) }))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(compareQuery, "from=2") || !strings.Contains(compareQuery, "to=3") {
+		t.Errorf("Got compare query %s; want from=2 and to=3", compareQuery)
+	}
+	if !strings.Contains(out, "test/test/a/, rev=3") {
+		t.Errorf("No revision information found in output %s", out)
+	}
+	if !strings.Contains(out, `"path": "/a/foo.json"`) || !strings.Contains(out, `"value": "BAR"`) {
+		t.Errorf("No diff found in output %s", out)
+	}
+}
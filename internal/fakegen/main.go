@@ -0,0 +1,309 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// fakegen generates the fake which implements the interfaces declared in a file of the centraldogma package.
+// The fake records the calls of its methods and returns the results of the funcs set to its fields.
+// It is run by go generate in the centraldogmatest package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const modulePath = "go.linecorp.com/centraldogma"
+
+type options struct {
+	source      string
+	packageName string
+	typeName    string
+	interfaces  []string
+}
+
+func main() {
+	var opts options
+	var output, interfaces string
+	flag.StringVar(&opts.source, "source", "api.go", "the file which declares the interfaces")
+	flag.StringVar(&output, "output", "fake_client.go", "the file to generate")
+	flag.StringVar(&opts.packageName, "package", "centraldogmatest", "the package of the generated file")
+	flag.StringVar(&opts.typeName, "type", "FakeClient", "the name of the fake")
+	flag.StringVar(&interfaces, "interfaces", "ProjectAPI,RepoAPI,ContentAPI,WatchAPI",
+		"the comma-separated interfaces which the fake implements")
+	flag.Parse()
+	opts.interfaces = strings.Split(interfaces, ",")
+
+	generated, err := generate(&opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(output, generated, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type method struct {
+	name    string
+	params  []param
+	results []string
+}
+
+type param struct {
+	name     string
+	typ      string
+	variadic bool
+}
+
+// generator converts the type expressions of the centraldogma package into the ones of the other package.
+type generator struct {
+	imports     map[string]string // the import paths of the packages in the source file by their names
+	usedImports map[string]bool
+}
+
+func generate(opts *options) ([]byte, error) {
+	file, err := parser.ParseFile(token.NewFileSet(), opts.source, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	g := &generator{imports: make(map[string]string), usedImports: map[string]bool{"sync": true}}
+	for _, spec := range file.Imports {
+		importPath, _ := strconv.Unquote(spec.Path.Value)
+		g.imports[path.Base(importPath)] = importPath
+	}
+
+	interfaceTypes := make(map[string]*ast.InterfaceType)
+	ast.Inspect(file, func(node ast.Node) bool {
+		if spec, ok := node.(*ast.TypeSpec); ok {
+			if interfaceType, ok := spec.Type.(*ast.InterfaceType); ok {
+				interfaceTypes[spec.Name.Name] = interfaceType
+			}
+		}
+		return true
+	})
+
+	var methods []*method
+	for _, name := range opts.interfaces {
+		interfaceType, ok := interfaceTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("interface %s is not declared in %s", name, opts.source)
+		}
+		for _, field := range interfaceType.Methods.List {
+			funcType, ok := field.Type.(*ast.FuncType)
+			if !ok {
+				return nil, fmt.Errorf("embedded interfaces are not supported: %s", name)
+			}
+			methods = append(methods, g.method(field.Names[0].Name, funcType))
+		}
+	}
+
+	var body bytes.Buffer
+	g.writeFake(&body, opts, methods)
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by fakegen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", opts.packageName)
+	var imports []string
+	for name := range g.usedImports {
+		if importPath, ok := g.imports[name]; ok {
+			imports = append(imports, importPath)
+		} else {
+			imports = append(imports, name)
+		}
+	}
+	sort.Strings(imports)
+	for _, importPath := range imports {
+		fmt.Fprintf(&out, "\t%q\n", importPath)
+	}
+	fmt.Fprintf(&out, "\n\t%q\n)\n\n", modulePath)
+	out.Write(body.Bytes())
+	return format.Source(out.Bytes())
+}
+
+func (g *generator) method(name string, funcType *ast.FuncType) *method {
+	m := &method{name: name}
+	for _, field := range funcType.Params.List {
+		typ := g.typeString(field.Type)
+		_, variadic := field.Type.(*ast.Ellipsis)
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{name: fmt.Sprintf("p%d", len(m.params)), typ: typ, variadic: variadic})
+		}
+		for _, n := range field.Names {
+			m.params = append(m.params, param{name: n.Name, typ: typ, variadic: variadic})
+		}
+	}
+	if funcType.Results != nil {
+		for _, field := range funcType.Results.List {
+			typ := g.typeString(field.Type)
+			for i := 0; i < len(field.Names) || (i == 0 && len(field.Names) == 0); i++ {
+				m.results = append(m.results, typ)
+			}
+		}
+	}
+	return m
+}
+
+func (g *generator) typeString(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		if ast.IsExported(t.Name) {
+			return "centraldogma." + t.Name
+		}
+		return t.Name
+	case *ast.SelectorExpr:
+		name := t.X.(*ast.Ident).Name
+		g.usedImports[name] = true
+		return name + "." + t.Sel.Name
+	case *ast.StarExpr:
+		return "*" + g.typeString(t.X)
+	case *ast.ArrayType:
+		return "[]" + g.typeString(t.Elt)
+	case *ast.Ellipsis:
+		return "..." + g.typeString(t.Elt)
+	case *ast.MapType:
+		return "map[" + g.typeString(t.Key) + "]" + g.typeString(t.Value)
+	case *ast.ChanType:
+		switch t.Dir {
+		case ast.RECV:
+			return "<-chan " + g.typeString(t.Value)
+		case ast.SEND:
+			return "chan<- " + g.typeString(t.Value)
+		default:
+			return "chan " + g.typeString(t.Value)
+		}
+	case *ast.FuncType:
+		m := g.method("", t)
+		return "func" + m.signature(false)
+	case *ast.InterfaceType:
+		return "interface{}"
+	default:
+		panic(fmt.Sprintf("unsupported type: %T", expr))
+	}
+}
+
+// signature returns the parameters and the results of the method. The parameters are unnamed unless named
+// is true.
+func (m *method) signature(named bool) string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		if named {
+			params[i] = p.name + " " + p.typ
+		} else {
+			params[i] = p.typ
+		}
+	}
+	s := "(" + strings.Join(params, ", ") + ")"
+	switch len(m.results) {
+	case 0:
+		return s
+	case 1:
+		return s + " " + m.results[0]
+	default:
+		return s + " (" + strings.Join(m.results, ", ") + ")"
+	}
+}
+
+func (m *method) arguments() []string {
+	args := make([]string, len(m.params))
+	for i, p := range m.params {
+		args[i] = p.name
+		if p.variadic {
+			args[i] += "..."
+		}
+	}
+	return args
+}
+
+// zeroResults returns the results which are returned when the func of the method is not set.
+func (m *method) zeroResults() []string {
+	results := make([]string, len(m.results))
+	for i, typ := range m.results {
+		switch {
+		case typ == "error":
+			results[i] = "ErrNotScripted"
+		case typ == "string":
+			results[i] = `""`
+		case typ == "bool":
+			results[i] = "false"
+		case strings.HasPrefix(typ, "int") || strings.HasPrefix(typ, "uint") || strings.HasPrefix(typ, "float"):
+			results[i] = "0"
+		default:
+			results[i] = "nil"
+		}
+	}
+	return results
+}
+
+func (g *generator) writeFake(w *bytes.Buffer, opts *options, methods []*method) {
+	qualified := make([]string, len(opts.interfaces))
+	for i, name := range opts.interfaces {
+		qualified[i] = "centraldogma." + name
+	}
+	list := strings.Join(qualified[:len(qualified)-1], ", ") + " and " + qualified[len(qualified)-1]
+	if len(qualified) == 1 {
+		list = qualified[0]
+	}
+
+	writeComment(w, fmt.Sprintf("%s is a fake of %s. It records the calls of its methods and returns the "+
+		"results of the funcs set to the fields named after the methods. ErrNotScripted is returned if the func "+
+		"is not set. The funcs should be set before the methods are called.", opts.typeName, list))
+	fmt.Fprintf(w, "type %s struct {\n\tlock  sync.Mutex\n\tcalls []Call\n\n", opts.typeName)
+	for _, m := range methods {
+		fmt.Fprintf(w, "\t%sFunc func%s\n", m.name, m.signature(true))
+	}
+	fmt.Fprintf(w, "}\n\nvar (\n")
+	for _, name := range qualified {
+		fmt.Fprintf(w, "\t_ %s = (*%s)(nil)\n", name, opts.typeName)
+	}
+	fmt.Fprintf(w, ")\n")
+
+	for _, m := range methods {
+		args := m.arguments()
+		recorded := make([]string, len(m.params))
+		for i, p := range m.params {
+			recorded[i] = p.name
+		}
+		fmt.Fprintf(w, "\n// %s records the call and calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(w, "func (f *%s) %s%s {\n", opts.typeName, m.name, m.signature(true))
+		fmt.Fprintf(w, "\tf.record(%q, %s)\n", m.name, strings.Join(recorded, ", "))
+		if len(m.results) == 0 {
+			fmt.Fprintf(w, "\tif f.%sFunc != nil {\n\t\tf.%sFunc(%s)\n\t}\n}\n", m.name, m.name, strings.Join(args, ", "))
+			continue
+		}
+		fmt.Fprintf(w, "\tif f.%sFunc == nil {\n\t\treturn %s\n\t}\n", m.name, strings.Join(m.zeroResults(), ", "))
+		fmt.Fprintf(w, "\treturn f.%sFunc(%s)\n}\n", m.name, strings.Join(args, ", "))
+	}
+}
+
+// writeComment writes the text as a comment whose lines are wrapped at 110 columns.
+func writeComment(w *bytes.Buffer, text string) {
+	line := "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 110 {
+			fmt.Fprintln(w, line)
+			line = "//"
+		}
+		line += " " + word
+	}
+	fmt.Fprintln(w, line)
+}
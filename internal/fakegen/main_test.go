@@ -0,0 +1,49 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	generated, err := generate(&options{
+		source:      "../../api.go",
+		packageName: "centraldogmatest",
+		typeName:    "FakeClient",
+		interfaces:  []string{"ProjectAPI", "RepoAPI", "ContentAPI", "WatchAPI"},
+	})
+	if err != nil {
+		t.Fatalf("generate returned error: %v", err)
+	}
+	existing, err := ioutil.ReadFile("../../centraldogmatest/fake_client.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, existing) {
+		t.Errorf("fake_client.go is out of date. Run go generate in the centraldogmatest package.")
+	}
+}
+
+func TestGenerate_UnknownInterface(t *testing.T) {
+	_, err := generate(&options{source: "../../api.go", packageName: "p", typeName: "Fake",
+		interfaces: []string{"UnknownAPI"}})
+	if err == nil || !strings.Contains(err.Error(), "UnknownAPI") {
+		t.Errorf("generate returned %v, want the error of the unknown interface", err)
+	}
+}
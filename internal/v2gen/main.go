@@ -0,0 +1,303 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+// v2gen generates the methods of ClientV2 which call the methods of Client returning the HTTP status code and
+// return the other results only. It is run by go generate in the centraldogma package.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// statusCodeResult is the name of the result of the Client methods which is dropped.
+const statusCodeResult = "httpStatusCode"
+
+const maxLineLength = 120
+
+type options struct {
+	dir      string
+	output   string
+	typeName string
+}
+
+func main() {
+	var opts options
+	flag.StringVar(&opts.dir, "dir", ".", "the directory of the centraldogma package")
+	flag.StringVar(&opts.output, "output", "v2_methods.go", "the file to generate")
+	flag.StringVar(&opts.typeName, "type", "ClientV2", "the name of the type which has the generated methods")
+	flag.Parse()
+
+	generated, err := generate(&opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = ioutil.WriteFile(filepath.Join(opts.dir, opts.output), generated, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type method struct {
+	name    string
+	params  []field
+	results []field // without the HTTP status code
+}
+
+// field is a group of the parameters or the results which share the same type, e.g. projectName, repoName string.
+type field struct {
+	names    []string
+	typ      string
+	variadic bool
+}
+
+func (f field) String() string {
+	return strings.Join(f.names, ", ") + " " + f.typ
+}
+
+// generator collects the packages imported by the types in the generated methods.
+type generator struct {
+	fset        *token.FileSet
+	imports     map[string]string // the import paths of the packages in the current file by their names
+	usedImports map[string]string
+}
+
+func generate(opts *options) ([]byte, error) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, opts.dir, func(info os.FileInfo) bool {
+		return !strings.HasSuffix(info.Name(), "_test.go") && info.Name() != opts.output
+	}, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	pkg, ok := pkgs["centraldogma"]
+	if !ok {
+		return nil, fmt.Errorf("package centraldogma is not found in %s", opts.dir)
+	}
+
+	g := &generator{fset: fset, usedImports: make(map[string]string)}
+	var methods []*method
+	for _, file := range pkg.Files {
+		if hasBuildConstraint(file) {
+			continue // The generated methods must be available regardless of the build tags.
+		}
+		g.imports = make(map[string]string)
+		for _, spec := range file.Imports {
+			importPath, _ := strconv.Unquote(spec.Path.Value)
+			name := path.Base(importPath)
+			if spec.Name != nil {
+				name = spec.Name.Name
+			}
+			g.imports[name] = importPath
+		}
+		for _, decl := range file.Decls {
+			if funcDecl, ok := decl.(*ast.FuncDecl); ok && isClientMethod(funcDecl) {
+				if m := g.method(funcDecl); m != nil {
+					methods = append(methods, m)
+				}
+			}
+		}
+	}
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("no method of Client returns %s in %s", statusCodeResult, opts.dir)
+	}
+	sort.Slice(methods, func(i, j int) bool { return methods[i].name < methods[j].name })
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by v2gen. DO NOT EDIT.\n\npackage centraldogma\n\nimport (\n")
+	var imports []string
+	for name, importPath := range g.usedImports {
+		if path.Base(importPath) != name {
+			imports = append(imports, name+" "+strconv.Quote(importPath))
+		} else {
+			imports = append(imports, strconv.Quote(importPath))
+		}
+	}
+	sort.Slice(imports, func(i, j int) bool { return unquotedPath(imports[i]) < unquotedPath(imports[j]) })
+	for _, spec := range imports {
+		fmt.Fprintf(&out, "\t%s\n", spec)
+	}
+	fmt.Fprintf(&out, ")\n")
+	for _, m := range methods {
+		writeMethod(&out, opts.typeName, m)
+	}
+	return format.Source(out.Bytes())
+}
+
+func unquotedPath(spec string) string {
+	return spec[strings.Index(spec, `"`):]
+}
+
+func hasBuildConstraint(file *ast.File) bool {
+	for _, group := range file.Comments {
+		if group.Pos() >= file.Package {
+			break
+		}
+		for _, comment := range group.List {
+			if strings.HasPrefix(comment.Text, "//go:build") || strings.HasPrefix(comment.Text, "// +build") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isClientMethod(funcDecl *ast.FuncDecl) bool {
+	if funcDecl.Recv == nil || !funcDecl.Name.IsExported() {
+		return false
+	}
+	star, ok := funcDecl.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "Client"
+}
+
+// method returns the method to generate or nil if the Client method does not return the HTTP status code.
+func (g *generator) method(funcDecl *ast.FuncDecl) *method {
+	if funcDecl.Type.Results == nil {
+		return nil
+	}
+	m := &method{name: funcDecl.Name.Name}
+	hasStatusCode := false
+	for _, result := range funcDecl.Type.Results.List {
+		var names []string
+		for _, name := range result.Names {
+			if name.Name == statusCodeResult {
+				hasStatusCode = true
+			} else {
+				names = append(names, name.Name)
+			}
+		}
+		if len(names) > 0 {
+			m.results = append(m.results, field{names: names, typ: g.typeString(result.Type)})
+		}
+	}
+	if !hasStatusCode {
+		return nil
+	}
+	for _, param := range funcDecl.Type.Params.List {
+		_, variadic := param.Type.(*ast.Ellipsis)
+		names := make([]string, len(param.Names))
+		for i, name := range param.Names {
+			names[i] = name.Name
+		}
+		m.params = append(m.params, field{names: names, typ: g.typeString(param.Type), variadic: variadic})
+	}
+	return m
+}
+
+func (g *generator) typeString(expr ast.Expr) string {
+	ast.Inspect(expr, func(node ast.Node) bool {
+		if selector, ok := node.(*ast.SelectorExpr); ok {
+			if ident, ok := selector.X.(*ast.Ident); ok {
+				g.usedImports[ident.Name] = g.imports[ident.Name]
+			}
+		}
+		return true
+	})
+	var buf bytes.Buffer
+	if err := printer.Fprint(&buf, g.fset, expr); err != nil {
+		panic(err)
+	}
+	return buf.String()
+}
+
+func writeMethod(w *bytes.Buffer, typeName string, m *method) {
+	params := make([]string, len(m.params))
+	var args []string
+	for i, p := range m.params {
+		params[i] = p.String()
+		args = append(args, p.names...)
+		if p.variadic {
+			args[len(args)-1] += "..."
+		}
+	}
+	results := make([]string, len(m.results))
+	var assigned []string
+	for i, r := range m.results {
+		results[i] = r.String()
+		assigned = append(assigned, r.names...)
+	}
+	// The HTTP status code is the second to last result.
+	assigned = append(assigned[:len(assigned)-1], "_", assigned[len(assigned)-1])
+
+	writeComment(w, fmt.Sprintf("%s is the same as Client.%s except that it does not return the HTTP status code.",
+		m.name, m.name))
+	fmt.Fprintln(w, wrap(fmt.Sprintf("func (c *%s) %s(", typeName, m.name), params,
+		fmt.Sprintf(") (%s) {", strings.Join(results, ", ")), "\t"))
+	fmt.Fprintln(w, wrap(fmt.Sprintf("\t%s = c.client.%s(", strings.Join(assigned, ", "), m.name), args, ")",
+		"\t\t"))
+	fmt.Fprintf(w, "\treturn\n}\n")
+}
+
+// wrap joins the items with commas between the prefix and the suffix, breaking the lines which would be longer
+// than maxLineLength. The continuation lines are indented with the indent.
+func wrap(prefix string, items []string, suffix, indent string) string {
+	var b strings.Builder
+	line := prefix
+	for i, item := range items {
+		if i < len(items)-1 {
+			item += ","
+		} else {
+			item += suffix
+		}
+		if i > 0 {
+			if lineLength(line)+1+len(item) > maxLineLength {
+				b.WriteString(line + "\n")
+				line = indent + item
+				continue
+			}
+			line += " "
+		}
+		line += item
+	}
+	if len(items) == 0 {
+		line += suffix
+	}
+	b.WriteString(line)
+	return b.String()
+}
+
+// lineLength returns the length of the line counting a tab as 4 columns.
+func lineLength(line string) int {
+	return len(line) + 3*strings.Count(line, "\t")
+}
+
+// writeComment writes the text as a comment whose lines are wrapped at 110 columns.
+func writeComment(w *bytes.Buffer, text string) {
+	line := "\n//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 110 {
+			fmt.Fprintln(w, line)
+			line = "//"
+		}
+		line += " " + word
+	}
+	fmt.Fprintln(w, line)
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestGenerate_UpToDate(t *testing.T) {
+	generated, err := generate(&options{dir: "../..", output: "v2_methods.go", typeName: "ClientV2"})
+	if err != nil {
+		t.Fatalf("generate returned error: %v", err)
+	}
+	existing, err := ioutil.ReadFile("../../v2_methods.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(generated, existing) {
+		t.Errorf("v2_methods.go is out of date. Run go generate in the centraldogma package.")
+	}
+}
+
+func TestGenerate_NoMethods(t *testing.T) {
+	_, err := generate(&options{dir: ".", output: "v2_methods.go", typeName: "ClientV2"})
+	if err == nil || !strings.Contains(err.Error(), "centraldogma") {
+		t.Errorf("generate returned %v, want the error of the missing package", err)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	items := []string{strings.Repeat("a", 50), strings.Repeat("b", 50), strings.Repeat("c", 50)}
+	got := wrap("f(", items, ")", "\t")
+	want := "f(" + items[0] + ", " + items[1] + ",\n\t" + items[2] + ")"
+	if got != want {
+		t.Errorf("wrap returned %q, want %q", got, want)
+	}
+	if got = wrap("f(", nil, ")", "\t"); got != "f()" {
+		t.Errorf("wrap returned %q, want %q", got, "f()")
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// diffJSON computes the JSON patch operations which transform the source into the target.
+// Both the source and target must be the values decoded by json.Unmarshal into an interface{}.
+func diffJSON(source, target interface{}) []JSONPatchOperation {
+	var operations []JSONPatchOperation
+	return appendJSONDiff(operations, "", source, target)
+}
+
+func appendJSONDiff(operations []JSONPatchOperation, pointer string, source, target interface{}) []JSONPatchOperation {
+	if reflect.DeepEqual(source, target) {
+		return operations
+	}
+
+	switch sourceValue := source.(type) {
+	case map[string]interface{}:
+		targetValue, ok := target.(map[string]interface{})
+		if !ok {
+			break
+		}
+		return appendObjectDiff(operations, pointer, sourceValue, targetValue)
+	case []interface{}:
+		targetValue, ok := target.([]interface{})
+		if !ok {
+			break
+		}
+		return appendArrayDiff(operations, pointer, sourceValue, targetValue)
+	}
+	return append(operations, JSONPatchOperation{Op: "replace", Path: pointer, Value: target})
+}
+
+func appendObjectDiff(operations []JSONPatchOperation, pointer string,
+	source, target map[string]interface{}) []JSONPatchOperation {
+	// Iterate over the sorted keys so that the same patch is computed for the same input.
+	for _, key := range sortedKeys(source) {
+		if _, ok := target[key]; !ok {
+			operations = append(operations, JSONPatchOperation{Op: "remove", Path: pointer + "/" + escapeJSONPointer(key)})
+		}
+	}
+	for _, key := range sortedKeys(target) {
+		child := pointer + "/" + escapeJSONPointer(key)
+		if sourceValue, ok := source[key]; ok {
+			operations = appendJSONDiff(operations, child, sourceValue, target[key])
+		} else {
+			operations = append(operations, JSONPatchOperation{Op: "add", Path: child, Value: target[key]})
+		}
+	}
+	return operations
+}
+
+func appendArrayDiff(operations []JSONPatchOperation, pointer string,
+	source, target []interface{}) []JSONPatchOperation {
+	common := len(source)
+	if len(target) < common {
+		common = len(target)
+	}
+	for i := 0; i < common; i++ {
+		operations = appendJSONDiff(operations, pointer+"/"+strconv.Itoa(i), source[i], target[i])
+	}
+	// Remove from the end so that the indexes of the remaining elements are not shifted.
+	for i := len(source) - 1; i >= common; i-- {
+		operations = append(operations, JSONPatchOperation{Op: "remove", Path: pointer + "/" + strconv.Itoa(i)})
+	}
+	for i := common; i < len(target); i++ {
+		operations = append(operations, JSONPatchOperation{Op: "add", Path: pointer + "/-", Value: target[i]})
+	}
+	return operations
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func escapeJSONPointer(token string) string {
+	return strings.Replace(strings.Replace(token, "~", "~0", -1), "/", "~1", -1)
+}
+
+func toJSONValue(value interface{}) (interface{}, error) {
+	content, err := marshalJSONContent(value)
+	if err != nil {
+		return nil, err
+	}
+	var decoded interface{}
+	if err = json.Unmarshal(content, &decoded); err != nil {
+		return nil, err
+	}
+	return decoded, nil
+}
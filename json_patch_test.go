@@ -0,0 +1,46 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestDiffJSON(t *testing.T) {
+	tests := []struct {
+		source string
+		target string
+		want   string
+	}{
+		{`{"a":1}`, `{"a":1}`, `null`},
+		{`{"a":1}`, `{"a":2}`, `[{"op":"replace","path":"/a","value":2}]`},
+		{`{"a":1,"b":2}`, `{"b":2,"c":null}`,
+			`[{"op":"remove","path":"/a"},{"op":"add","path":"/c","value":null}]`},
+		{`{"a/b":{"c~":1}}`, `{"a/b":{"c~":true}}`, `[{"op":"replace","path":"/a~1b/c~0","value":true}]`},
+		{`[1,2,3]`, `[1,4]`, `[{"op":"replace","path":"/1","value":4},{"op":"remove","path":"/2"}]`},
+		{`[1]`, `[1,{"a":1}]`, `[{"op":"add","path":"/-","value":{"a":1}}]`},
+		{`{"a":[1]}`, `{"a":"b"}`, `[{"op":"replace","path":"/a","value":"b"}]`},
+		{`1`, `"a"`, `[{"op":"replace","path":"","value":"a"}]`},
+	}
+
+	for _, test := range tests {
+		var source, target interface{}
+		json.Unmarshal([]byte(test.source), &source)
+		json.Unmarshal([]byte(test.target), &target)
+		b, _ := json.Marshal(diffJSON(source, target))
+		testString(t, string(b), test.want, test.source+" -> "+test.target)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+// decodeJSON decodes the JSON response body into the resContent. When the resContent is a pointer to a slice,
+// the elements of the JSON array are decoded one at a time, so that the whole array is not buffered in
+// addition to the decoded elements. Otherwise, the body is read into a pooled buffer and decoded at once.
+// An empty body leaves the resContent as it is.
+func decodeJSON(body io.Reader, resContent interface{}) error {
+	ptr := reflect.ValueOf(resContent)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() || ptr.Elem().Kind() != reflect.Slice {
+		buf := getBuffer()
+		defer putBuffer(buf)
+		if _, err := buf.ReadFrom(body); err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(buf.Bytes())) == 0 { // empty response body
+			return nil
+		}
+		// The decoded values do not retain the buffer because json.Unmarshal copies the bytes it keeps.
+		return json.Unmarshal(buf.Bytes(), resContent)
+	}
+
+	dec := json.NewDecoder(body)
+
+	token, err := dec.Token()
+	if err == io.EOF { // empty response body
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	sliceType := ptr.Elem().Type()
+	if token == nil { // null
+		ptr.Elem().Set(reflect.Zero(sliceType))
+		return nil
+	}
+	if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("unexpected token: %v, want [", token)
+	}
+
+	elems := reflect.MakeSlice(sliceType, 0, 0)
+	for dec.More() {
+		elem := reflect.New(sliceType.Elem())
+		if err = dec.Decode(elem.Interface()); err != nil {
+			return err
+		}
+		elems = reflect.Append(elems, elem.Elem())
+	}
+	if _, err = dec.Token(); err != nil { // ]
+		return err
+	}
+	ptr.Elem().Set(elems)
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJSON(t *testing.T) {
+	var commits []*Commit
+	err := decodeJSON(strings.NewReader(`[{"revision":2, "pushedAt":"b"}, {"revision":1, "pushedAt":"a"}]`),
+		&commits)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []*Commit{{Revision: 2, PushedAt: "b"}, {Revision: 1, PushedAt: "a"}}
+	if !reflect.DeepEqual(commits, want) {
+		t.Errorf("decodeJSON returned %+v, want %+v", commits, want)
+	}
+
+	// An empty body and null leave the slice empty.
+	for _, body := range []string{"", "null", "[]"} {
+		var entries []*Entry
+		if err = decodeJSON(strings.NewReader(body), &entries); err != nil {
+			t.Errorf("decodeJSON(%q) returned error: %v", body, err)
+		}
+		if len(entries) != 0 {
+			t.Errorf("decodeJSON(%q) returned %+v, want empty", body, entries)
+		}
+	}
+
+	// Not an array.
+	var entries []*Entry
+	if err = decodeJSON(strings.NewReader(`{"path":"/a.json"}`), &entries); err == nil {
+		t.Errorf("decodeJSON should fail with an object")
+	}
+	// Truncated array.
+	if err = decodeJSON(strings.NewReader(`[{"path":"/a.json"}`), &entries); err == nil {
+		t.Errorf("decodeJSON should fail with a truncated array")
+	}
+
+	// Not a slice.
+	var project Project
+	if err = decodeJSON(strings.NewReader(`{"name":"foo"}`), &project); err != nil {
+		t.Fatal(err)
+	}
+	testString(t, project.Name, "foo", "name")
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+)
+
+// UnmarshalLenientJSON decodes the JSON data into the value pointed to by v as json.Unmarshal does, except that
+// the comments and the trailing commas in the data are allowed. For example:
+//
+//	{
+//	    // the port of the server
+//	    "port": 8080, /* the default */
+//	}
+func UnmarshalLenientJSON(data []byte, v interface{}) error {
+	normalized, err := normalizeLenientJSON(data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(normalized, v)
+}
+
+// normalizeLenientJSON removes the comments and the trailing commas from the data.
+func normalizeLenientJSON(data []byte) ([]byte, error) {
+	out := make([]byte, 0, len(data))
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case c == '"':
+			// Copy the string as is.
+			start := i
+			for i++; i < len(data) && data[i] != '"'; i++ {
+				if data[i] == '\\' {
+					i++
+				}
+			}
+			if i >= len(data) {
+				return nil, errors.New("unterminated string in the JSON")
+			}
+			out = append(out, data[start:i+1]...)
+
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for i < len(data) && data[i] != '\n' {
+				i++
+			}
+			if i < len(data) {
+				out = append(out, '\n')
+			}
+
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			end := bytes.Index(data[i+2:], []byte("*/"))
+			if end < 0 {
+				return nil, errors.New("unterminated comment in the JSON")
+			}
+			i += end + 3
+			out = append(out, ' ')
+
+		case c == '}' || c == ']':
+			// Remove the trailing comma before the closing bracket.
+			trimmed := bytes.TrimRight(out, " \t\r\n")
+			if len(trimmed) > 0 && trimmed[len(trimmed)-1] == ',' {
+				out = append(trimmed[:len(trimmed)-1], out[len(trimmed):]...)
+			}
+			out = append(out, c)
+
+		default:
+			out = append(out, c)
+		}
+	}
+	return out, nil
+}
+
+// normalizeEntry replaces the content of the JSON entry which has comments or trailing commas with the standard
+// JSON if the lenient JSON is enabled, so that the content can be decoded using Entry.UnmarshalTo.
+func (c *Client) normalizeEntry(entry *Entry) {
+	if !c.lenientJSON || entry == nil || entry.Type != JSON || json.Valid(entry.Content) {
+		return
+	}
+	normalized, err := normalizeLenientJSON(entry.Content)
+	if err != nil || !json.Valid(normalized) {
+		c.logger.Debugf("Failed to normalize the lenient JSON: %s, err=%v", entry.Path, err)
+		return
+	}
+	entry.Content = normalized
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestNormalizeLenientJSON(t *testing.T) {
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`{"a": 1}`, `{"a": 1}`},
+		{"{\n  // comment\n  \"a\": 1, // trailing\n}", "{\n  \n  \"a\": 1 \n}"},
+		{`{"a": [1, 2, /* c */ ], }`, `{"a": [1, 2   ] }`},
+		{`{"url": "http://a/*b*/", "s": "\"//,}"}`, `{"url": "http://a/*b*/", "s": "\"//,}"}`},
+	}
+	for _, tc := range tests {
+		normalized, err := normalizeLenientJSON([]byte(tc.data))
+		if err != nil {
+			t.Errorf("normalizeLenientJSON(%q) failed: %v", tc.data, err)
+			continue
+		}
+		if string(normalized) != tc.want {
+			t.Errorf("normalizeLenientJSON(%q) returned %q, want %q", tc.data, normalized, tc.want)
+		}
+	}
+
+	for _, data := range []string{`{"a": "b`, `{"a": 1 /* comment`} {
+		if _, err := normalizeLenientJSON([]byte(data)); err == nil {
+			t.Errorf("normalizeLenientJSON(%q) should fail", data)
+		}
+	}
+}
+
+func TestUnmarshalLenientJSON(t *testing.T) {
+	var v struct {
+		Port  int      `json:"port"`
+		Hosts []string `json:"hosts"`
+	}
+	data := `{
+  // the port of the server
+  "port": 8080,
+  "hosts": ["a", "b",], /* the hosts */
+}`
+	if err := UnmarshalLenientJSON([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.Port != 8080 || !reflect.DeepEqual(v.Hosts, []string{"a", "b"}) {
+		t.Errorf("UnmarshalLenientJSON returned %+v", v)
+	}
+}
+
+func TestWithLenientJSON(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json5", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"path":"/a.json5", "type":"JSON", "content":"{\n  // comment\n  \"a\": 1,\n}"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	for _, lenient := range []bool{true, false} {
+		c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithLenientJSON(lenient))
+		if err != nil {
+			t.Fatal(err)
+		}
+		entry, _, err := c.GetFile(context.Background(), "foo", "bar", "-1", &Query{Path: "/a.json5", Type: Identity})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var v map[string]int
+		err = entry.UnmarshalTo(&v)
+		if lenient && (err != nil || v["a"] != 1) {
+			t.Errorf("UnmarshalTo returned %v, %v, want a=1", v, err)
+		}
+		if !lenient && err == nil {
+			t.Error("UnmarshalTo should fail without WithLenientJSON")
+		}
+	}
+}
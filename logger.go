@@ -0,0 +1,60 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"net/url"
+	"strings"
+)
+
+// Logger logs the messages of a Client. *logrus.Logger and *logrus.Entry implement Logger, so they can be
+// used as they are:
+//
+//	logger := logrus.New()
+//	logger.SetLevel(logrus.DebugLevel)
+//	client, err := centraldogma.NewClient("https://localhost:443", centraldogma.WithLogger(logger))
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Warnf(format string, args ...interface{})
+}
+
+// sensitiveQueryParams are the query parameters whose values are redacted from the logs.
+var sensitiveQueryParams = []string{"token", "secret", "password", "passphrase", "access_token"}
+
+const redacted = "xxxxx"
+
+// redactURL returns the string form of the URL whose password and sensitive query parameters are redacted.
+func redactURL(u *url.URL) string {
+	if u == nil {
+		return ""
+	}
+
+	redactedURL := *u
+	if _, ok := u.User.Password(); ok {
+		redactedURL.User = url.UserPassword(u.User.Username(), redacted)
+	}
+	if len(u.RawQuery) != 0 {
+		query := u.Query()
+		for key := range query {
+			for _, sensitive := range sensitiveQueryParams {
+				if strings.EqualFold(key, sensitive) {
+					query.Set(key, redacted)
+				}
+			}
+		}
+		redactedURL.RawQuery = query.Encode()
+	}
+	return redactedURL.String()
+}
@@ -0,0 +1,160 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Conflict represents a part of the entries which both sides changed differently from the base.
+type Conflict struct {
+	// Path is the JSON pointer of the conflicting value, or an empty string for the whole content.
+	Path string
+	// Base, Ours and Theirs are the conflicting values. A value which does not exist is nil, and a number is
+	// a json.Number.
+	Base   interface{}
+	Ours   interface{}
+	Theirs interface{}
+}
+
+// Merge merges the changes made from the base to ours and theirs, e.g. to resolve the conflict of a push with
+// PushIfUnchanged by merging the entry at the new head. The JSON entries are merged member by member, so the
+// changes to the different members do not conflict with each other. The other entries and the arrays are
+// merged as a whole. The merged entry takes our value for the conflicting parts, which are returned as
+// the Conflicts. The base can be nil if the file was added on both sides. For example:
+//
+//	merged, conflicts, err := centraldogma.Merge(base, ours, theirs)
+//	if err != nil {
+//	    ...
+//	}
+//	if len(conflicts) == 0 {
+//	    change, err := centraldogma.NewUpsertJSON(merged.Path, json.RawMessage(merged.Content))
+//	    ...
+//	}
+func Merge(base, ours, theirs *Entry) (*Entry, []Conflict, error) {
+	if ours == nil || theirs == nil {
+		return nil, nil, fmt.Errorf("ours and theirs should not be nil")
+	}
+	if ours.Type != theirs.Type || (base != nil && base.Type != ours.Type) {
+		return nil, nil, fmt.Errorf("the entries should be of the same type (path: %v)", ours.Path)
+	}
+
+	switch ours.Type {
+	case JSON:
+		return mergeJSONEntries(base, ours, theirs)
+	case Text:
+		merged := &Entry{Path: ours.Path, Type: Text}
+		var baseContent interface{}
+		if base != nil {
+			baseContent = string(base.Content)
+		}
+		content, _, conflicts := mergeJSONValue("", baseContent, string(ours.Content), string(theirs.Content),
+			base != nil, true, true, nil)
+		merged.Content = EntryContent(content.(string))
+		return merged, conflicts, nil
+	default:
+		return nil, nil, fmt.Errorf("the entries cannot be merged (path: %v, type: %v)", ours.Path, ours.Type)
+	}
+}
+
+func mergeJSONEntries(base, ours, theirs *Entry) (*Entry, []Conflict, error) {
+	var baseValue, oursValue, theirsValue interface{}
+	if base != nil {
+		if err := unmarshalJSONNumber(base.Content, &baseValue); err != nil {
+			return nil, nil, err
+		}
+	}
+	if err := unmarshalJSONNumber(ours.Content, &oursValue); err != nil {
+		return nil, nil, err
+	}
+	if err := unmarshalJSONNumber(theirs.Content, &theirsValue); err != nil {
+		return nil, nil, err
+	}
+
+	mergedValue, _, conflicts := mergeJSONValue("", baseValue, oursValue, theirsValue, base != nil, true, true, nil)
+	content, err := json.Marshal(mergedValue)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &Entry{Path: ours.Path, Type: JSON, Content: EntryContent(content)}, conflicts, nil
+}
+
+// unmarshalJSONNumber unmarshals the content keeping the numbers as json.Number, so that the integers which
+// a float64 cannot represent exactly are not changed by a merge.
+func unmarshalJSONNumber(content []byte, value interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+	return dec.Decode(value)
+}
+
+// mergeJSONValue merges the values at the pointer. The flags denote whether the values exist, and the merged
+// value does not exist if the returned exists is false.
+func mergeJSONValue(pointer string, base, ours, theirs interface{}, baseOK, oursOK, theirsOK bool,
+	conflicts []Conflict) (merged interface{}, exists bool, _ []Conflict) {
+	same := func(a interface{}, aOK bool, b interface{}, bOK bool) bool {
+		return aOK == bOK && (!aOK || reflect.DeepEqual(a, b))
+	}
+	switch {
+	case same(ours, oursOK, theirs, theirsOK):
+		return ours, oursOK, conflicts
+	case same(base, baseOK, ours, oursOK):
+		return theirs, theirsOK, conflicts
+	case same(base, baseOK, theirs, theirsOK):
+		return ours, oursOK, conflicts
+	}
+
+	oursObject, isOursObject := ours.(map[string]interface{})
+	theirsObject, isTheirsObject := theirs.(map[string]interface{})
+	baseObject, isBaseObject := base.(map[string]interface{})
+	if !baseOK {
+		baseObject, isBaseObject = map[string]interface{}{}, true
+	}
+	if !isOursObject || !isTheirsObject || !isBaseObject {
+		conflicts = append(conflicts, Conflict{Path: pointer, Base: base, Ours: ours, Theirs: theirs})
+		return ours, oursOK, conflicts
+	}
+
+	keys := make(map[string]struct{})
+	for _, object := range []map[string]interface{}{baseObject, oursObject, theirsObject} {
+		for key := range object {
+			keys[key] = struct{}{}
+		}
+	}
+	sorted := make([]string, 0, len(keys))
+	for key := range keys {
+		sorted = append(sorted, key)
+	}
+	// Iterate over the sorted keys so that the conflicts are reported in the same order for the same input.
+	sort.Strings(sorted)
+
+	mergedObject := make(map[string]interface{}, len(sorted))
+	for _, key := range sorted {
+		baseMember, baseMemberOK := baseObject[key]
+		oursMember, oursMemberOK := oursObject[key]
+		theirsMember, theirsMemberOK := theirsObject[key]
+		var member interface{}
+		var memberOK bool
+		member, memberOK, conflicts = mergeJSONValue(pointer+"/"+escapeJSONPointer(key),
+			baseMember, oursMember, theirsMember, baseMemberOK, oursMemberOK, theirsMemberOK, conflicts)
+		if memberOK {
+			mergedObject[key] = member
+		}
+	}
+	return mergedObject, true, conflicts
+}
@@ -0,0 +1,129 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestMerge(t *testing.T) {
+	jsonEntry := func(content string) *Entry {
+		return &Entry{Path: "/a.json", Type: JSON, Content: EntryContent(content)}
+	}
+
+	tests := []struct {
+		name          string
+		base          *Entry
+		ours, theirs  *Entry
+		want          string
+		wantConflicts []Conflict
+	}{
+		{
+			name:   "different members",
+			base:   jsonEntry(`{"a":1,"b":{"c":1,"d":1},"e":1}`),
+			ours:   jsonEntry(`{"a":2,"b":{"c":2,"d":1},"e":1}`),
+			theirs: jsonEntry(`{"a":1,"b":{"c":1,"d":2},"f":1}`),
+			want:   `{"a":2,"b":{"c":2,"d":2},"f":1}`,
+		},
+		{
+			name:   "same change",
+			base:   jsonEntry(`{"a":1}`),
+			ours:   jsonEntry(`{"a":2}`),
+			theirs: jsonEntry(`{"a":2}`),
+			want:   `{"a":2}`,
+		},
+		{
+			name:   "conflict",
+			base:   jsonEntry(`{"a":1,"b":[1],"c":1}`),
+			ours:   jsonEntry(`{"a":2,"b":[1,2],"c":1}`),
+			theirs: jsonEntry(`{"a":3,"b":[1,3]}`),
+			want:   `{"a":2,"b":[1,2]}`,
+			wantConflicts: []Conflict{
+				{Path: "/a", Base: json.Number("1"), Ours: json.Number("2"), Theirs: json.Number("3")},
+				{Path: "/b", Base: []interface{}{json.Number("1")},
+					Ours:   []interface{}{json.Number("1"), json.Number("2")},
+					Theirs: []interface{}{json.Number("1"), json.Number("3")}},
+			},
+		},
+		{
+			name:   "removed and modified",
+			base:   jsonEntry(`{"a":1}`),
+			ours:   jsonEntry(`{}`),
+			theirs: jsonEntry(`{"a":2}`),
+			want:   `{}`,
+			wantConflicts: []Conflict{
+				{Path: "/a", Base: json.Number("1"), Ours: nil, Theirs: json.Number("2")},
+			},
+		},
+		{
+			name:   "large integers",
+			base:   jsonEntry(`{"id":9007199254740993,"at":1700000000123456789,"a":1}`),
+			ours:   jsonEntry(`{"id":9007199254740993,"at":1700000000123456789,"a":2}`),
+			theirs: jsonEntry(`{"id":9007199254740993,"at":1700000000123456790,"a":1}`),
+			want:   `{"a":2,"at":1700000000123456790,"id":9007199254740993}`,
+		},
+		{
+			name:   "added on both sides",
+			base:   nil,
+			ours:   jsonEntry(`{"a":1}`),
+			theirs: jsonEntry(`{"b":1}`),
+			want:   `{"a":1,"b":1}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			merged, conflicts, err := Merge(test.base, test.ours, test.theirs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(merged.Content) != test.want {
+				t.Errorf("Merge returned %s, want %s", merged.Content, test.want)
+			}
+			if !reflect.DeepEqual(conflicts, test.wantConflicts) {
+				t.Errorf("Merge returned the conflicts %+v, want %+v", conflicts, test.wantConflicts)
+			}
+		})
+	}
+}
+
+func TestMerge_Text(t *testing.T) {
+	textEntry := func(content string) *Entry {
+		return &Entry{Path: "/a.txt", Type: Text, Content: EntryContent(content)}
+	}
+
+	merged, conflicts, err := Merge(textEntry("a"), textEntry("a"), textEntry("b"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(merged.Content) != "b" || len(conflicts) != 0 {
+		t.Errorf("Merge returned (%s, %+v), want (b, [])", merged.Content, conflicts)
+	}
+
+	merged, conflicts, err = Merge(textEntry("a"), textEntry("b"), textEntry("c"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Conflict{{Base: "a", Ours: "b", Theirs: "c"}}
+	if string(merged.Content) != "b" || !reflect.DeepEqual(conflicts, want) {
+		t.Errorf("Merge returned (%s, %+v), want (b, %+v)", merged.Content, conflicts, want)
+	}
+
+	if _, _, err = Merge(nil, textEntry("a"), &Entry{Path: "/a.txt", Type: JSON}); err == nil {
+		t.Errorf("Merge should fail for the entries of different types")
+	}
+}
@@ -0,0 +1,176 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+type metadataService service
+
+// ProjectMetadata represents the metadata of a project, such as its members, tokens and the permissions of
+// its repositories.
+type ProjectMetadata struct {
+	Name     string                         `json:"name"`
+	Repos    map[string]*RepositoryMetadata `json:"repos,omitempty"`
+	Members  map[string]*Member             `json:"members,omitempty"`
+	Tokens   map[string]*TokenRegistration  `json:"tokens,omitempty"`
+	Creation *UserAndTimestamp              `json:"creation,omitempty"`
+	Removal  *UserAndTimestamp              `json:"removal,omitempty"`
+}
+
+// RepositoryMetadata represents the permissions of a repository.
+type RepositoryMetadata struct {
+	Name                string                  `json:"name"`
+	PerRolePermissions  *PerRolePermissions     `json:"perRolePermissions,omitempty"`
+	PerUserPermissions  map[string][]Permission `json:"perUserPermissions,omitempty"`
+	PerTokenPermissions map[string][]Permission `json:"perTokenPermissions,omitempty"`
+	Creation            *UserAndTimestamp       `json:"creation,omitempty"`
+	Removal             *UserAndTimestamp       `json:"removal,omitempty"`
+}
+
+// PerRolePermissions represents the permissions of a repository for each ProjectRole.
+type PerRolePermissions struct {
+	Owner  []Permission `json:"owner"`
+	Member []Permission `json:"member"`
+	Guest  []Permission `json:"guest"`
+}
+
+// Member represents a member of a project.
+type Member struct {
+	Login    string            `json:"login"`
+	Role     ProjectRole       `json:"role"`
+	Creation *UserAndTimestamp `json:"creation,omitempty"`
+}
+
+// TokenRegistration represents an application token registered to a project.
+type TokenRegistration struct {
+	AppID    string            `json:"appId"`
+	Role     ProjectRole       `json:"role"`
+	Creation *UserAndTimestamp `json:"creation,omitempty"`
+}
+
+type identifierWithRole struct {
+	ID   string      `json:"id"`
+	Role ProjectRole `json:"role"`
+}
+
+type identifierWithPermissions struct {
+	ID          string       `json:"id"`
+	Permissions []Permission `json:"permissions"`
+}
+
+func (m *metadataService) get(ctx context.Context, projectName string) (*ProjectMetadata, int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		projects, projectName,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	req, err := m.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	projectMetadata := new(ProjectMetadata)
+	httpStatusCode, err := m.client.do(ctx, req, projectMetadata, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return projectMetadata, httpStatusCode, nil
+}
+
+func (m *metadataService) send(ctx context.Context, method string, body interface{},
+	elem ...string) (int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(append([]string{defaultPathPrefix, metadata}, elem...)...))
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+
+	req, err := m.client.newRequest(method, u, body)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+
+	httpStatusCode, err := m.client.do(ctx, req, nil, false)
+	if err != nil {
+		return httpStatusCode, err
+	}
+	return httpStatusCode, nil
+}
+
+func (m *metadataService) addMember(ctx context.Context, projectName, login string, role ProjectRole) (int, error) {
+	return m.send(ctx, http.MethodPost, &identifierWithRole{ID: login, Role: role},
+		projectName, members)
+}
+
+func (m *metadataService) updateMemberRole(ctx context.Context,
+	projectName, login string, role ProjectRole) (int, error) {
+	return m.send(ctx, http.MethodPatch, `[{"op":"replace", "path":"/role", "value":"`+role.String()+`"}]`,
+		projectName, members, login)
+}
+
+func (m *metadataService) removeMember(ctx context.Context, projectName, login string) (int, error) {
+	return m.send(ctx, http.MethodDelete, nil, projectName, members, login)
+}
+
+func (m *metadataService) addToken(ctx context.Context, projectName, appID string, role ProjectRole) (int, error) {
+	return m.send(ctx, http.MethodPost, &identifierWithRole{ID: appID, Role: role},
+		projectName, tokens)
+}
+
+func (m *metadataService) removeToken(ctx context.Context, projectName, appID string) (int, error) {
+	return m.send(ctx, http.MethodDelete, nil, projectName, tokens, appID)
+}
+
+func (m *metadataService) setRolePermissions(ctx context.Context,
+	projectName, repoName string, perRolePermissions *PerRolePermissions) (int, error) {
+	return m.send(ctx, http.MethodPost, perRolePermissions,
+		projectName, repos, repoName, "perm", "role")
+}
+
+func (m *metadataService) setUserPermissions(ctx context.Context,
+	projectName, repoName, login string, permissions []Permission) (int, error) {
+	return m.setPermissions(ctx, projectName, repoName, "users", login, permissions)
+}
+
+func (m *metadataService) setTokenPermissions(ctx context.Context,
+	projectName, repoName, appID string, permissions []Permission) (int, error) {
+	return m.setPermissions(ctx, projectName, repoName, "tokens", appID, permissions)
+}
+
+// setPermissions adds the permissions of the member or the token, or replaces them if the server responds
+// with 409 Conflict because it already has the permissions.
+func (m *metadataService) setPermissions(ctx context.Context,
+	projectName, repoName, kind, id string, permissions []Permission) (int, error) {
+	if permissions == nil {
+		permissions = []Permission{}
+	}
+	httpStatusCode, err := m.send(ctx, http.MethodPost, &identifierWithPermissions{ID: id, Permissions: permissions},
+		projectName, repos, repoName, "perm", kind)
+	if httpStatusCode != http.StatusConflict {
+		return httpStatusCode, err
+	}
+	return m.send(ctx, http.MethodPatch,
+		[]JSONPatchOperation{{Op: "replace", Path: "/permissions", Value: permissions}},
+		projectName, repos, repoName, "perm", kind, id)
+}
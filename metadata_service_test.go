@@ -0,0 +1,169 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestGetProjectMetadata(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"name":"foo",
+"repos":{"bar":{"name":"bar",
+"perRolePermissions":{"owner":["READ","WRITE"], "member":["READ"], "guest":[]},
+"perUserPermissions":{}, "perTokenPermissions":{"ci-bot":["READ"]}}},
+"members":{"minux@m.x":{"login":"minux@m.x", "role":"OWNER"}},
+"tokens":{"ci-bot":{"appId":"ci-bot", "role":"MEMBER"}}}`)
+	})
+
+	projectMetadata, _, _ := c.GetProjectMetadata(context.Background(), "foo")
+	want := &ProjectMetadata{
+		Name: "foo",
+		Repos: map[string]*RepositoryMetadata{"bar": {
+			Name: "bar",
+			PerRolePermissions: &PerRolePermissions{
+				Owner:  []Permission{PermissionRead, PermissionWrite},
+				Member: []Permission{PermissionRead},
+				Guest:  []Permission{},
+			},
+			PerUserPermissions:  map[string][]Permission{},
+			PerTokenPermissions: map[string][]Permission{"ci-bot": {PermissionRead}},
+		}},
+		Members: map[string]*Member{"minux@m.x": {Login: "minux@m.x", Role: RoleOwner}},
+		Tokens:  map[string]*TokenRegistration{"ci-bot": {AppID: "ci-bot", Role: RoleMember}},
+	}
+	if !reflect.DeepEqual(projectMetadata, want) {
+		t.Errorf("GetProjectMetadata returned %+v, want %+v", projectMetadata, want)
+	}
+}
+
+func TestAddMember(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/metadata/foo/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"id":"minux@m.x","role":"MEMBER"}`+"\n")
+		fmt.Fprint(w, `{"revision":2}`)
+	})
+
+	httpStatusCode, _ := c.AddMember(context.Background(), "foo", "minux@m.x", RoleMember)
+	testStatusCode(t, httpStatusCode, 200)
+}
+
+func TestUpdateMemberRole(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/metadata/foo/members/minux@m.x", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testBody(t, r, `[{"op":"replace", "path":"/role", "value":"OWNER"}]`)
+		fmt.Fprint(w, `{"revision":3}`)
+	})
+
+	httpStatusCode, _ := c.UpdateMemberRole(context.Background(), "foo", "minux@m.x", RoleOwner)
+	testStatusCode(t, httpStatusCode, 200)
+}
+
+func TestRemoveTokenFromProject(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/metadata/foo/tokens/ci-bot", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		fmt.Fprint(w, `{"revision":4}`)
+	})
+
+	httpStatusCode, _ := c.RemoveTokenFromProject(context.Background(), "foo", "ci-bot")
+	testStatusCode(t, httpStatusCode, 200)
+}
+
+func TestSetRepoPermissions(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/metadata/foo/repos/bar/perm/role", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"owner":["READ","WRITE"],"member":["READ"],"guest":[]}`+"\n")
+		fmt.Fprint(w, `{"revision":5}`)
+	})
+	mux.HandleFunc("/api/v1/metadata/foo/repos/bar/perm/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"id":"ci-bot","permissions":["READ","WRITE"]}`+"\n")
+		fmt.Fprint(w, `{"revision":6}`)
+	})
+
+	perRolePermissions := &PerRolePermissions{
+		Owner:  []Permission{PermissionRead, PermissionWrite},
+		Member: []Permission{PermissionRead},
+		Guest:  []Permission{},
+	}
+	httpStatusCode, _ := c.SetRepoRolePermissions(context.Background(), "foo", "bar", perRolePermissions)
+	testStatusCode(t, httpStatusCode, 200)
+
+	httpStatusCode, _ = c.SetRepoTokenPermissions(context.Background(), "foo", "bar", "ci-bot",
+		[]Permission{PermissionRead, PermissionWrite})
+	testStatusCode(t, httpStatusCode, 200)
+}
+
+func TestSetRepoUserPermissions_Existing(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/metadata/foo/repos/bar/perm/users", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, `{"id":"minux@m.x","permissions":["READ"]}`+"\n")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.ChangeConflictException",`+
+			`"message":"the member already has the permissions"}`)
+	})
+	mux.HandleFunc("/api/v1/metadata/foo/repos/bar/perm/users/minux@m.x",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodPatch)
+			testBody(t, r, `[{"op":"replace","path":"/permissions","value":["READ"]}]`+"\n")
+			fmt.Fprint(w, `{"revision":7}`)
+		})
+
+	httpStatusCode, err := c.SetRepoUserPermissions(context.Background(), "foo", "bar", "minux@m.x",
+		[]Permission{PermissionRead})
+	if err != nil {
+		t.Fatalf("SetRepoUserPermissions returned error: %v", err)
+	}
+	testStatusCode(t, httpStatusCode, 200)
+
+	// The permissions are revoked with an empty list rather than null.
+	mux.HandleFunc("/api/v1/metadata/foo/repos/bar/perm/tokens", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.ChangeConflictException"}`)
+	})
+	mux.HandleFunc("/api/v1/metadata/foo/repos/bar/perm/tokens/ci-bot", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testBody(t, r, `[{"op":"replace","path":"/permissions","value":[]}]`+"\n")
+		fmt.Fprint(w, `{"revision":8}`)
+	})
+	httpStatusCode, err = c.SetRepoTokenPermissions(context.Background(), "foo", "bar", "ci-bot", nil)
+	if err != nil {
+		t.Fatalf("SetRepoTokenPermissions returned error: %v", err)
+	}
+	testStatusCode(t, httpStatusCode, 200)
+}
@@ -86,10 +86,18 @@ func TestMetricCollector(t *testing.T) {
 
 	sink := globalPrometheusSink.(*promMetrics.PrometheusSink)
 
-	ch := make(chan prometheus.Metric, 100)
-	sink.Collect(ch)
-
-	if metric, ok := <-ch; !ok || metric == nil {
+	// Collect in another goroutine because Collect blocks until all metrics are received.
+	ch := make(chan prometheus.Metric)
+	go func() {
+		sink.Collect(ch)
+		close(ch)
+	}()
+
+	var metrics []prometheus.Metric
+	for metric := range ch {
+		metrics = append(metrics, metric)
+	}
+	if len(metrics) == 0 || metrics[0] == nil {
 		t.Fatal()
 	}
 }
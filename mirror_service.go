@@ -0,0 +1,192 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"path"
+)
+
+type mirrorService service
+
+// Mirror represents a mirroring configuration between a repository and a Git repository.
+type Mirror struct {
+	ID           string          `json:"id"`
+	Enabled      bool            `json:"enabled"`
+	ProjectName  string          `json:"projectName,omitempty"`
+	Schedule     string          `json:"schedule,omitempty"` // Quartz cron expression, e.g. "0 * * * * ?"
+	Direction    MirrorDirection `json:"direction"`
+	LocalRepo    string          `json:"localRepo"`
+	LocalPath    string          `json:"localPath,omitempty"`
+	RemoteScheme string          `json:"remoteScheme"` // e.g. "git+https" or "git+ssh"
+	RemoteURL    string          `json:"remoteUrl"`
+	RemotePath   string          `json:"remotePath,omitempty"`
+	RemoteBranch string          `json:"remoteBranch,omitempty"`
+	Gitignore    string          `json:"gitignore,omitempty"`
+	CredentialID string          `json:"credentialId,omitempty"`
+}
+
+// Credential represents a credential which is used to access a remote Git repository.
+// Type can be "none", "password", "access_token" or "public_key".
+type Credential struct {
+	ID               string   `json:"id"`
+	Type             string   `json:"type"`
+	HostnamePatterns []string `json:"hostnamePatterns,omitempty"`
+	Enabled          bool     `json:"enabled"`
+	Username         string   `json:"username,omitempty"`
+	Password         string   `json:"password,omitempty"`
+	AccessToken      string   `json:"accessToken,omitempty"`
+	PublicKey        string   `json:"publicKey,omitempty"`
+	PrivateKey       string   `json:"privateKey,omitempty"`
+	Passphrase       string   `json:"passphrase,omitempty"`
+}
+
+func (m *mirrorService) newRequest(method string, body interface{}, elem ...string) (*http.Request, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(append([]string{defaultPathPrefix, projects}, elem...)...))
+	if err != nil {
+		return nil, err
+	}
+	return m.client.newRequest(method, u, body)
+}
+
+func (m *mirrorService) listMirrors(ctx context.Context, projectName string) ([]*Mirror, int, error) {
+	req, err := m.newRequest(http.MethodGet, nil, projectName, mirrors)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	var mirrors []*Mirror
+	httpStatusCode, err := m.client.do(ctx, req, &mirrors, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return mirrors, httpStatusCode, nil
+}
+
+func (m *mirrorService) getMirror(ctx context.Context, projectName, id string) (*Mirror, int, error) {
+	if id == "" {
+		return nil, UnknownHttpStatusCode, errors.New("mirror ID should not be empty")
+	}
+
+	req, err := m.newRequest(http.MethodGet, nil, projectName, mirrors, id)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	mirror := new(Mirror)
+	httpStatusCode, err := m.client.do(ctx, req, mirror, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return mirror, httpStatusCode, nil
+}
+
+func (m *mirrorService) createMirror(ctx context.Context, projectName string, mirror *Mirror) (int, error) {
+	if mirror == nil {
+		return UnknownHttpStatusCode, errors.New("mirror should not be nil")
+	}
+
+	req, err := m.newRequest(http.MethodPost, mirror, projectName, mirrors)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+	return m.client.do(ctx, req, nil, false)
+}
+
+func (m *mirrorService) updateMirror(ctx context.Context, projectName string, mirror *Mirror) (int, error) {
+	if mirror == nil {
+		return UnknownHttpStatusCode, errors.New("mirror should not be nil")
+	}
+	if mirror.ID == "" {
+		return UnknownHttpStatusCode, errors.New("mirror ID should not be empty")
+	}
+
+	req, err := m.newRequest(http.MethodPut, mirror, projectName, mirrors, mirror.ID)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+	return m.client.do(ctx, req, nil, false)
+}
+
+func (m *mirrorService) removeMirror(ctx context.Context, projectName, id string) (int, error) {
+	if id == "" {
+		return UnknownHttpStatusCode, errors.New("mirror ID should not be empty")
+	}
+
+	req, err := m.newRequest(http.MethodDelete, nil, projectName, mirrors, id)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+	return m.client.do(ctx, req, nil, false)
+}
+
+func (m *mirrorService) listCredentials(ctx context.Context, projectName string) ([]*Credential, int, error) {
+	req, err := m.newRequest(http.MethodGet, nil, projectName, credentials)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	var credentials []*Credential
+	httpStatusCode, err := m.client.do(ctx, req, &credentials, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return credentials, httpStatusCode, nil
+}
+
+func (m *mirrorService) createCredential(ctx context.Context,
+	projectName string, credential *Credential) (int, error) {
+	if credential == nil {
+		return UnknownHttpStatusCode, errors.New("credential should not be nil")
+	}
+
+	req, err := m.newRequest(http.MethodPost, credential, projectName, credentials)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+	return m.client.do(ctx, req, nil, false)
+}
+
+func (m *mirrorService) updateCredential(ctx context.Context,
+	projectName string, credential *Credential) (int, error) {
+	if credential == nil {
+		return UnknownHttpStatusCode, errors.New("credential should not be nil")
+	}
+	if credential.ID == "" {
+		return UnknownHttpStatusCode, errors.New("credential ID should not be empty")
+	}
+
+	req, err := m.newRequest(http.MethodPut, credential, projectName, credentials, credential.ID)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+	return m.client.do(ctx, req, nil, false)
+}
+
+func (m *mirrorService) removeCredential(ctx context.Context, projectName, id string) (int, error) {
+	if id == "" {
+		return UnknownHttpStatusCode, errors.New("credential ID should not be empty")
+	}
+
+	req, err := m.newRequest(http.MethodDelete, nil, projectName, credentials, id)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+	return m.client.do(ctx, req, nil, false)
+}
@@ -0,0 +1,131 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListMirrors(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/mirrors", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"id":"m1", "enabled":true, "projectName":"foo", "schedule":"0 * * * * ?",
+"direction":"REMOTE_TO_LOCAL", "localRepo":"bar", "localPath":"/", "remoteScheme":"git+https",
+"remoteUrl":"github.com/line/centraldogma.git", "remotePath":"/", "remoteBranch":"main",
+"credentialId":"c1"}]`)
+	})
+
+	mirrors, _, _ := c.ListMirrors(context.Background(), "foo")
+	want := []*Mirror{{ID: "m1", Enabled: true, ProjectName: "foo", Schedule: "0 * * * * ?",
+		Direction: RemoteToLocal, LocalRepo: "bar", LocalPath: "/", RemoteScheme: "git+https",
+		RemoteURL: "github.com/line/centraldogma.git", RemotePath: "/", RemoteBranch: "main",
+		CredentialID: "c1"}}
+	if !reflect.DeepEqual(mirrors, want) {
+		t.Errorf("ListMirrors returned %+v, want %+v", mirrors, want)
+	}
+}
+
+func TestCreateAndUpdateMirror(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mirror := &Mirror{ID: "m1", Enabled: true, Direction: LocalToRemote, LocalRepo: "bar",
+		RemoteScheme: "git+ssh", RemoteURL: "github.com/line/centraldogma.git"}
+	handler := func(method string) func(w http.ResponseWriter, r *http.Request) {
+		return func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, method)
+			got := new(Mirror)
+			_ = json.NewDecoder(r.Body).Decode(got)
+			if !reflect.DeepEqual(got, mirror) {
+				t.Errorf("Request body = %+v, want %+v", got, mirror)
+			}
+			fmt.Fprint(w, `{"revision":2}`)
+		}
+	}
+	mux.HandleFunc("/api/v1/projects/foo/mirrors", handler(http.MethodPost))
+	mux.HandleFunc("/api/v1/projects/foo/mirrors/m1", handler(http.MethodPut))
+
+	httpStatusCode, _ := c.CreateMirror(context.Background(), "foo", mirror)
+	testStatusCode(t, httpStatusCode, 200)
+	httpStatusCode, _ = c.UpdateMirror(context.Background(), "foo", mirror)
+	testStatusCode(t, httpStatusCode, 200)
+}
+
+func TestCredentials(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/credentials", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			fmt.Fprint(w, `[{"id":"c1", "type":"access_token", "hostnamePatterns":["^github.com$"],
+"enabled":true}]`)
+		case http.MethodPost:
+			testBody(t, r, `{"id":"c2","type":"password","enabled":true,"username":"foo","password":"bar"}`+"\n")
+			fmt.Fprint(w, `{"revision":3}`)
+		}
+	})
+	mux.HandleFunc("/api/v1/projects/foo/credentials/c1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	credentials, _, _ := c.ListCredentials(context.Background(), "foo")
+	want := []*Credential{{ID: "c1", Type: "access_token", HostnamePatterns: []string{"^github.com$"}, Enabled: true}}
+	if !reflect.DeepEqual(credentials, want) {
+		t.Errorf("ListCredentials returned %+v, want %+v", credentials, want)
+	}
+
+	credential := &Credential{ID: "c2", Type: "password", Enabled: true, Username: "foo", Password: "bar"}
+	httpStatusCode, _ := c.CreateCredential(context.Background(), "foo", credential)
+	testStatusCode(t, httpStatusCode, 200)
+
+	httpStatusCode, _ = c.RemoveCredential(context.Background(), "foo", "c1")
+	testStatusCode(t, httpStatusCode, 204)
+}
+
+func TestMirrorsAndCredentials_EmptyID(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+	})
+
+	ctx := context.Background()
+	if _, _, err := c.GetMirror(ctx, "foo", ""); err == nil {
+		t.Error("GetMirror should fail with an empty ID")
+	}
+	if _, err := c.UpdateMirror(ctx, "foo", &Mirror{}); err == nil {
+		t.Error("UpdateMirror should fail with an empty ID")
+	}
+	if _, err := c.RemoveMirror(ctx, "foo", ""); err == nil {
+		t.Error("RemoveMirror should fail with an empty ID")
+	}
+	if _, err := c.UpdateCredential(ctx, "foo", &Credential{}); err == nil {
+		t.Error("UpdateCredential should fail with an empty ID")
+	}
+	if _, err := c.RemoveCredential(ctx, "foo", ""); err == nil {
+		t.Error("RemoveCredential should fail with an empty ID")
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+)
+
+const defaultProjectPageSize = 100
+
+// ProjectListOptions specifies the projects returned by ListProjectsIterator.
+type ProjectListOptions struct {
+	// Status is the status of the projects to list. The active projects are listed by default.
+	Status ProjectStatus
+	// NamePrefix filters out the projects whose name does not start with it.
+	NamePrefix string
+	// PageSize is the maximum number of the projects fetched at once. 100 is used if not specified.
+	PageSize int
+}
+
+// ProjectIterator iterates over the projects, fetching them page by page.
+// Note that a server which does not support paging returns all projects in the first page.
+type ProjectIterator struct {
+	ctx     context.Context
+	project *projectService
+	opts    ProjectListOptions
+
+	offset int
+	done   bool
+	seen   map[string]struct{}
+
+	buf []*Project
+	cur *Project
+	err error
+}
+
+func (p *projectService) listIterator(ctx context.Context, opts *ProjectListOptions) *ProjectIterator {
+	it := &ProjectIterator{ctx: ctx, project: p, seen: make(map[string]struct{})}
+	if opts != nil {
+		it.opts = *opts
+	}
+	if it.opts.PageSize <= 0 {
+		it.opts.PageSize = defaultProjectPageSize
+	}
+	return it
+}
+
+func (p *projectService) listPage(ctx context.Context, status ProjectStatus, offset, limit int) ([]*Project,
+	int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		projects,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	// build query params
+	q := u.Query()
+	if status != ProjectActive {
+		q.Set("status", status.String())
+	}
+	q.Set("offset", strconv.Itoa(offset))
+	q.Set("limit", strconv.Itoa(limit))
+	u.RawQuery = q.Encode()
+
+	req, err := p.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	var projects []*Project
+	httpStatusCode, err := p.client.do(ctx, req, &projects, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return projects, httpStatusCode, nil
+}
+
+// Next advances the iterator to the next project. It returns false when there are no more projects or
+// an error occurred. Err should be checked after Next returns false.
+func (it *ProjectIterator) Next() bool {
+	for {
+		if it.err != nil {
+			it.cur = nil
+			return false
+		}
+		for len(it.buf) > 0 {
+			project := it.buf[0]
+			it.buf = it.buf[1:]
+			if strings.HasPrefix(project.Name, it.opts.NamePrefix) {
+				it.cur = project
+				return true
+			}
+		}
+		if it.done {
+			it.cur = nil
+			return false
+		}
+		it.err = it.fetch()
+	}
+}
+
+// Project returns the current project.
+func (it *ProjectIterator) Project() *Project {
+	return it.cur
+}
+
+// Err returns the error occurred during the iteration.
+func (it *ProjectIterator) Err() error {
+	return it.err
+}
+
+func (it *ProjectIterator) fetch() error {
+	projects, _, err := it.project.listPage(it.ctx, it.opts.Status, it.offset, it.opts.PageSize)
+	if err != nil {
+		return err
+	}
+	it.offset += len(projects)
+	// A page which is not full means the end of the list. So does a page which is larger than requested or
+	// has nothing new, which means the server ignored the paging parameters.
+	if len(projects) != it.opts.PageSize {
+		it.done = true
+	}
+
+	fresh := projects[:0]
+	for _, project := range projects {
+		if _, ok := it.seen[project.Name]; ok {
+			continue
+		}
+		it.seen[project.Name] = struct{}{}
+		fresh = append(fresh, project)
+	}
+	if len(fresh) == 0 {
+		it.done = true
+	}
+	it.buf = fresh
+	return nil
+}
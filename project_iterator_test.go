@@ -0,0 +1,108 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestListProjectsIterator(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	var names []string
+	for i := 0; i < 5; i++ {
+		names = append(names, fmt.Sprintf("team-%d", i), fmt.Sprintf("other-%d", i))
+	}
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "status", "removed")
+		testURLQuery(t, r, "limit", "3")
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+		end := offset + 3
+		if end > len(names) {
+			end = len(names)
+		}
+		fmt.Fprint(w, "[")
+		for i, name := range names[offset:end] {
+			if i > 0 {
+				fmt.Fprint(w, ",")
+			}
+			fmt.Fprintf(w, `{"name":"%s", "createdAt":"2017-09-28T15:33:35Z"}`, name)
+		}
+		fmt.Fprint(w, "]")
+	})
+
+	it := c.ListProjectsIterator(context.Background(),
+		&ProjectListOptions{Status: ProjectRemoved, NamePrefix: "team-", PageSize: 3})
+	var got []string
+	for it.Next() {
+		got = append(got, it.Project().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ProjectIterator returned error: %v", err)
+	}
+	want := []string{"team-0", "team-1", "team-2", "team-3", "team-4"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ProjectIterator returned %v, want %v", got, want)
+	}
+}
+
+func TestListProjectsIteratorWithoutPaging(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Query().Get("status") != "" {
+			t.Errorf("status should not be set for the active projects")
+		}
+		// Ignores the paging parameters.
+		fmt.Fprint(w, `[{"name":"foo"}, {"name":"bar"}]`)
+	})
+
+	it := c.ListProjectsIterator(context.Background(), &ProjectListOptions{PageSize: 2})
+	var got []string
+	for it.Next() {
+		got = append(got, it.Project().Name)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("ProjectIterator returned error: %v", err)
+	}
+	if want := []string{"foo", "bar"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("ProjectIterator returned %v, want %v", got, want)
+	}
+	if requests != 2 {
+		t.Errorf("sent %v requests, want %v", requests, 2)
+	}
+}
+
+func TestProjectCreationTime(t *testing.T) {
+	project := &Project{Name: "foo", CreatedAt: "2017-09-28T15:33:35Z"}
+	createdAt, err := project.CreationTime()
+	if err != nil {
+		t.Fatalf("CreationTime returned error: %v", err)
+	}
+	if want := time.Date(2017, 9, 28, 15, 33, 35, 0, time.UTC); !createdAt.Equal(want) {
+		t.Errorf("CreationTime returned %v, want %v", createdAt, want)
+	}
+}
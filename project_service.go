@@ -19,24 +19,87 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"time"
 )
 
 type projectService service
 
 // Project represents a project in the Central Dogma server.
 type Project struct {
-	Name      string `json:"name"`
-	Creator   Author `json:"creator,omitempty"`
-	URL       string `json:"url,omitempty"`
+	Name string `json:"name"`
+	// Creator is the author who created the project.
+	Creator Author `json:"creator,omitempty"`
+	URL     string `json:"url,omitempty"`
+	// CreatedAt is the time when the project was created in ISO-8601 format. Use CreationTime to parse it.
 	CreatedAt string `json:"createdAt,omitempty"`
 }
 
+// CreationTime returns the time when the project was created.
+func (p *Project) CreationTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, p.CreatedAt)
+}
+
 type Author struct {
 	Name  string `json:"name,omitempty"`
 	Email string `json:"email,omitempty"`
 }
 
+// ProjectOptions specifies the description and the initial members and tokens of a project created with
+// CreateProjectWithOptions.
+type ProjectOptions struct {
+	// Description is sent along with the creation request.
+	Description string
+	// Members are added to the project with their Role. Use RoleOwner for the owners.
+	Members []*Member
+	// Tokens are registered to the project with their Role.
+	Tokens []*TokenRegistration
+}
+
 func (p *projectService) create(ctx context.Context, name string) (*Project, int, error) {
+	return p.createWithDescription(ctx, name, "")
+}
+
+func (p *projectService) createWithOptions(ctx context.Context, name string,
+	opts *ProjectOptions) (*Project, int, error) {
+	if opts == nil {
+		opts = &ProjectOptions{}
+	}
+	project, httpStatusCode, err := p.createWithDescription(ctx, name, opts.Description)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+
+	metadata := (*metadataService)(p)
+	for _, member := range opts.Members {
+		if httpStatusCode, err = metadata.addMember(ctx, name, member.Login, member.Role); err != nil {
+			p.rollbackCreation(ctx, name, err)
+			return nil, httpStatusCode, err
+		}
+	}
+	for _, token := range opts.Tokens {
+		if httpStatusCode, err = metadata.addToken(ctx, name, token.AppID, token.Role); err != nil {
+			p.rollbackCreation(ctx, name, err)
+			return nil, httpStatusCode, err
+		}
+	}
+	return project, httpStatusCode, nil
+}
+
+// rollbackCreation removes and purges the project which failed to be set up, so that the creation can be
+// retried with the same name.
+func (p *projectService) rollbackCreation(ctx context.Context, name string, cause error) {
+	p.client.logger.Warnf("Rolling back the creation of the project: %s, err=%v", name, cause)
+	if _, err := p.remove(ctx, name); err != nil {
+		p.client.logger.Warnf("Failed to remove the project: %s, err=%v", name, err)
+		return
+	}
+	if _, err := p.purge(ctx, name); err != nil {
+		p.client.logger.Warnf("Failed to purge the project: %s, err=%v", name, err)
+	}
+}
+
+func (p *projectService) createWithDescription(ctx context.Context, name, description string) (*Project, int,
+	error) {
 	// build relative url
 	u, err := url.Parse(path.Join(
 		defaultPathPrefix,
@@ -47,6 +110,9 @@ func (p *projectService) create(ctx context.Context, name string) (*Project, int
 	}
 
 	body := map[string]string{"name": name}
+	if len(description) > 0 {
+		body["description"] = description
+	}
 	req, err := p.client.newRequest(http.MethodPost, u, body)
 	if err != nil {
 		return nil, UnknownHttpStatusCode, err
@@ -134,3 +134,87 @@ func TestListRemovedProject(t *testing.T) {
 		t.Errorf("ListRemovedProjects returned %+v, want %+v", projects, want)
 	}
 }
+
+func TestCreateProjectWithOptions(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]string{"name": "foo", "description": "The foo project"}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"foo"}`)
+	})
+	var members []identifierWithRole
+	mux.HandleFunc("/api/v1/metadata/foo/members", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var member identifierWithRole
+		_ = json.NewDecoder(r.Body).Decode(&member)
+		members = append(members, member)
+	})
+	mux.HandleFunc("/api/v1/metadata/foo/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var token identifierWithRole
+		_ = json.NewDecoder(r.Body).Decode(&token)
+		if want := (identifierWithRole{ID: "app", Role: RoleMember}); token != want {
+			t.Errorf("Request body = %+v, want %+v", token, want)
+		}
+	})
+
+	opts := &ProjectOptions{
+		Description: "The foo project",
+		Members:     []*Member{{Login: "minux@m.x", Role: RoleOwner}, {Login: "bar@m.x", Role: RoleMember}},
+		Tokens:      []*TokenRegistration{{AppID: "app", Role: RoleMember}},
+	}
+	project, _, err := c.CreateProjectWithOptions(context.Background(), "foo", opts)
+	if err != nil {
+		t.Fatalf("CreateProjectWithOptions returned error: %v", err)
+	}
+	if project.Name != "foo" {
+		t.Errorf("CreateProjectWithOptions returned %+v, want foo", project)
+	}
+	want := []identifierWithRole{{ID: "minux@m.x", Role: RoleOwner}, {ID: "bar@m.x", Role: RoleMember}}
+	if !reflect.DeepEqual(members, want) {
+		t.Errorf("added members %+v, want %+v", members, want)
+	}
+}
+
+func TestCreateProjectWithOptionsRollback(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"foo"}`)
+	})
+	mux.HandleFunc("/api/v1/metadata/foo/members", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"message":"no such user"}`)
+	})
+	var rollback []string
+	mux.HandleFunc("/api/v1/projects/foo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		rollback = append(rollback, "remove")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/removed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		rollback = append(rollback, "purge")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &ProjectOptions{Members: []*Member{{Login: "minux@m.x", Role: RoleOwner}}}
+	_, httpStatusCode, err := c.CreateProjectWithOptions(context.Background(), "foo", opts)
+	if err == nil {
+		t.Fatalf("CreateProjectWithOptions should fail when a member cannot be added")
+	}
+	testStatusCode(t, httpStatusCode, http.StatusBadRequest)
+	if want := []string{"remove", "purge"}; !reflect.DeepEqual(rollback, want) {
+		t.Errorf("rollback = %v, want %v", rollback, want)
+	}
+}
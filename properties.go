@@ -0,0 +1,241 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// NewUpsertProperties returns a Change which adds or replaces the JSON file at the path with the Java-style
+// .properties content converted into a JSON object whose values are strings, so that the changes of each
+// property can be compared. The properties can be retrieved using Entry.Properties and converted back into
+// the .properties format using EncodeProperties.
+func NewUpsertProperties(path string, propertiesContent []byte) (*Change, error) {
+	if err := validateJSONPath(path); err != nil {
+		return nil, err
+	}
+	properties, err := DecodeProperties(propertiesContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the properties (path: %v): %v", path, err)
+	}
+	return NewUpsertJSON(path, properties)
+}
+
+// Properties returns the properties stored in the JSON entry by NewUpsertProperties. It fails if the entry is
+// not a JSON entry or its content is not a JSON object whose values are strings.
+func (c *Entry) Properties() (map[string]string, error) {
+	raw, err := c.RawJSON()
+	if err != nil {
+		return nil, err
+	}
+	var properties map[string]string
+	if err = json.Unmarshal(raw, &properties); err != nil {
+		return nil, fmt.Errorf("the content is not properties (path: %v): %v", c.Path, err)
+	}
+	return properties, nil
+}
+
+// DecodeProperties parses the content in the format of the .properties file of Java. The content is read as
+// UTF-8 and the unicode escapes such as \u00e9 are also decoded.
+func DecodeProperties(content []byte) (map[string]string, error) {
+	properties := make(map[string]string)
+	normalized := strings.Replace(strings.Replace(string(content), "\r\n", "\n", -1), "\r", "\n", -1)
+	lines := strings.Split(normalized, "\n")
+	for i := 0; i < len(lines); i++ {
+		line := strings.TrimLeft(lines[i], " \t\f")
+		if len(line) == 0 || line[0] == '#' || line[0] == '!' {
+			continue
+		}
+
+		// Join the continued lines.
+		lineNumber := i + 1
+		for endsWithEscape(line) && i+1 < len(lines) {
+			i++
+			line = line[:len(line)-1] + strings.TrimLeft(lines[i], " \t\f")
+		}
+		if endsWithEscape(line) {
+			line = line[:len(line)-1]
+		}
+
+		key, value := splitProperty(line)
+		unescapedKey, err := unescapeProperty(key)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+		unescapedValue, err := unescapeProperty(value)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", lineNumber, err)
+		}
+		properties[unescapedKey] = unescapedValue
+	}
+	return properties, nil
+}
+
+// endsWithEscape returns true if the line ends with an odd number of backslashes.
+func endsWithEscape(line string) bool {
+	n := 0
+	for i := len(line) - 1; i >= 0 && line[i] == '\\'; i-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// splitProperty splits the line at the first unescaped '=', ':' or whitespace.
+func splitProperty(line string) (key, value string) {
+	end := len(line)
+	for i := 0; i < len(line); i++ {
+		c := line[i]
+		if c == '\\' {
+			i++
+			continue
+		}
+		if c == '=' || c == ':' || c == ' ' || c == '\t' || c == '\f' {
+			end = i
+			break
+		}
+	}
+	key = line[:end]
+	rest := strings.TrimLeft(line[end:], " \t\f")
+	if len(rest) > 0 && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+	return key, rest
+}
+
+func unescapeProperty(s string) (string, error) {
+	if !strings.Contains(s, "\\") {
+		return s, nil
+	}
+
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 == len(s) {
+			buf.WriteByte(c)
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			buf.WriteByte('\t')
+		case 'n':
+			buf.WriteByte('\n')
+		case 'r':
+			buf.WriteByte('\r')
+		case 'f':
+			buf.WriteByte('\f')
+		case 'u':
+			if i+5 > len(s) {
+				return "", fmt.Errorf("malformed \\uxxxx encoding: %q", s[i-1:])
+			}
+			r, err := parseUnicodeEscape(s[i+1 : i+5])
+			if err != nil {
+				return "", err
+			}
+			i += 4
+			if utf16.IsSurrogate(r) && i+6 < len(s) && s[i+1] == '\\' && s[i+2] == 'u' {
+				// Decode the surrogate pair.
+				if low, err := parseUnicodeEscape(s[i+3 : i+7]); err == nil {
+					if decoded := utf16.DecodeRune(r, low); decoded != utf8.RuneError {
+						r = decoded
+						i += 6
+					}
+				}
+			}
+			buf.WriteRune(r)
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	return buf.String(), nil
+}
+
+func parseUnicodeEscape(hex string) (rune, error) {
+	r, err := strconv.ParseUint(hex, 16, 16)
+	if err != nil {
+		return 0, fmt.Errorf("malformed \\uxxxx encoding: %q", hex)
+	}
+	return rune(r), nil
+}
+
+// EncodeProperties returns the properties in the format of the .properties file of Java. The keys are sorted
+// and the non-ASCII characters are escaped as unicode escapes so that the content can be read as ISO 8859-1
+// as well.
+func EncodeProperties(properties map[string]string) []byte {
+	keys := make([]string, 0, len(properties))
+	for key := range properties {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	for _, key := range keys {
+		escapeProperty(&buf, key, true)
+		buf.WriteByte('=')
+		escapeProperty(&buf, properties[key], false)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
+
+func escapeProperty(buf *bytes.Buffer, s string, isKey bool) {
+	for i, r := range s {
+		switch r {
+		case ' ':
+			if isKey || i == 0 {
+				buf.WriteByte('\\')
+			}
+			buf.WriteByte(' ')
+		case '\t':
+			buf.WriteString(`\t`)
+		case '\n':
+			buf.WriteString(`\n`)
+		case '\r':
+			buf.WriteString(`\r`)
+		case '\f':
+			buf.WriteString(`\f`)
+		case '=', ':', '#', '!', '\\':
+			buf.WriteByte('\\')
+			buf.WriteRune(r)
+		default:
+			if r < 0x20 || r > 0x7e {
+				writeUnicodeEscape(buf, r)
+			} else {
+				buf.WriteRune(r)
+			}
+		}
+	}
+}
+
+func writeUnicodeEscape(buf *bytes.Buffer, r rune) {
+	if r > 0xffff {
+		// Encode as a surrogate pair.
+		r -= 0x10000
+		writeUnicodeEscape(buf, 0xd800+(r>>10))
+		writeUnicodeEscape(buf, 0xdc00+(r&0x3ff))
+		return
+	}
+	if r == utf8.RuneError {
+		r = 0xfffd
+	}
+	fmt.Fprintf(buf, `\u%04x`, r)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestDecodeProperties(t *testing.T) {
+	content := "# comment\r\n" +
+		"! another comment\n" +
+		"  a=1\n" +
+		"b : 2\n" +
+		"c 3\n" +
+		"d\n" +
+		"long = first, \\\n" +
+		"       second\n" +
+		"escaped\\ key\\:=\\u00e9\\ud83d\\ude00\\t\\\\\n" +
+		"url=http://example.com/#fragment\r" +
+		"last=value\\"
+	properties, err := DecodeProperties([]byte(content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]string{
+		"a":            "1",
+		"b":            "2",
+		"c":            "3",
+		"d":            "",
+		"long":         "first, second",
+		"escaped key:": "é\U0001F600\t\\",
+		"url":          "http://example.com/#fragment",
+		"last":         "value",
+	}
+	if !reflect.DeepEqual(properties, want) {
+		t.Errorf("DecodeProperties returned %q, want %q", properties, want)
+	}
+
+	if _, err = DecodeProperties([]byte(`a=\u00`)); err == nil {
+		t.Error("DecodeProperties should fail for a malformed unicode escape")
+	}
+}
+
+func TestEncodeProperties(t *testing.T) {
+	properties := map[string]string{
+		"b":            "2",
+		"a":            " leading space",
+		"escaped key:": "é\U0001F600\t\\#",
+	}
+	want := "a=\\ leading space\n" +
+		"b=2\n" +
+		"escaped\\ key\\:=\\u00e9\\ud83d\\ude00\\t\\\\\\#\n"
+	encoded := EncodeProperties(properties)
+	if string(encoded) != want {
+		t.Errorf("EncodeProperties returned %q, want %q", encoded, want)
+	}
+
+	decoded, err := DecodeProperties(encoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, properties) {
+		t.Errorf("decoded: %q, want %q", decoded, properties)
+	}
+}
+
+func TestNewUpsertProperties(t *testing.T) {
+	change, err := NewUpsertProperties("/app.properties.json", []byte("b=2\na=1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(change.Content.(json.RawMessage)), `{"a":"1","b":"2"}`; got != want {
+		t.Errorf("content: %v, want %v", got, want)
+	}
+
+	entry := &Entry{Path: "/app.properties.json", Type: JSON, Content: EntryContent(`{"a":"1","b":"2"}`)}
+	properties, err := entry.Properties()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]string{"a": "1", "b": "2"}; !reflect.DeepEqual(properties, want) {
+		t.Errorf("Properties returned %v, want %v", properties, want)
+	}
+
+	if _, err = NewUpsertProperties("/app.properties", []byte("a=1")); err == nil {
+		t.Error("NewUpsertProperties should fail for a non-JSON path")
+	}
+	entry.Content = EntryContent(`{"a":1}`)
+	if _, err = entry.Properties(); err == nil {
+		t.Error("Properties should fail for a non-string value")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"golang.org/x/net/proxy"
+)
+
+// proxyDialer connects to the server through an HTTP, HTTPS or SOCKS5 proxy. http2.Transport does not
+// support proxies by itself, so the HTTP and HTTPS proxies are tunneled with the CONNECT method.
+type proxyDialer struct {
+	proxyURL *url.URL
+	dialer   *net.Dialer
+	socks    proxy.Dialer
+}
+
+func newProxyDialer(rawURL string) (*proxyDialer, error) {
+	proxyURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, err
+	}
+	d := &proxyDialer{
+		proxyURL: proxyURL,
+		dialer:   &net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second},
+	}
+	switch proxyURL.Scheme {
+	case "http", "https":
+	case "socks5", "socks5h":
+		if d.socks, err = proxy.FromURL(proxyURL, d.dialer); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme: %q", proxyURL.Scheme)
+	}
+	return d, nil
+}
+
+// proxyAddr returns the address of the proxy with the default port of the scheme if not specified.
+func (d *proxyDialer) proxyAddr() string {
+	if port := d.proxyURL.Port(); len(port) != 0 {
+		return d.proxyURL.Host
+	}
+	port := "80"
+	if d.proxyURL.Scheme == "https" {
+		port = "443"
+	}
+	return net.JoinHostPort(d.proxyURL.Hostname(), port)
+}
+
+// dialContext connects to the addr through the proxy.
+func (d *proxyDialer) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if d.socks != nil {
+		if contextDialer, ok := d.socks.(proxy.ContextDialer); ok {
+			return contextDialer.DialContext(ctx, network, addr)
+		}
+		return d.socks.Dial(network, addr)
+	}
+
+	conn, err := d.dialer.DialContext(ctx, "tcp", d.proxyAddr())
+	if err != nil {
+		return nil, err
+	}
+	if d.proxyURL.Scheme == "https" {
+		if conn, err = tlsClientHandshake(ctx, conn, d.proxyAddr(), nil); err != nil {
+			return nil, err
+		}
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+		defer conn.SetDeadline(time.Time{})
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Opaque: addr},
+		Host:   addr,
+		Header: make(http.Header),
+	}
+	if user := d.proxyURL.User; user != nil {
+		password, _ := user.Password()
+		credentials := base64.StdEncoding.EncodeToString([]byte(user.Username() + ":" + password))
+		req.Header.Set("Proxy-Authorization", "Basic "+credentials)
+	}
+	if err = req.Write(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	// It is okay to discard the buffered reader because the server does not send anything until
+	// the client speaks.
+	res, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		conn.Close()
+		return nil, fmt.Errorf("proxy %s refused to connect to %s: %s", d.proxyURL.Host, addr, res.Status)
+	}
+	return conn, nil
+}
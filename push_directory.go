@@ -0,0 +1,184 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// PushDirectoryOption configures Client.PushDirectory.
+type PushDirectoryOption func(*pushDirectoryOptions)
+
+type pushDirectoryOptions struct {
+	excludePatterns []string
+	removeMissing   bool
+}
+
+// WithExcludePatterns excludes the local files whose names match any of the patterns. The patterns are
+// matched against the base name of the files using filepath.Match, e.g. "*.bak".
+func WithExcludePatterns(patterns ...string) PushDirectoryOption {
+	return func(o *pushDirectoryOptions) {
+		o.excludePatterns = append(o.excludePatterns, patterns...)
+	}
+}
+
+// WithRemoveMissing removes the remote files under the remote prefix which do not exist in the local
+// directory.
+func WithRemoveMissing() PushDirectoryOption {
+	return func(o *pushDirectoryOptions) {
+		o.removeMissing = true
+	}
+}
+
+func (o *pushDirectoryOptions) excluded(name string) bool {
+	for _, pattern := range o.excludePatterns {
+		if matched, _ := filepath.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (con *contentService) pushDirectory(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, localDir, remotePrefix string, opts ...PushDirectoryOption) (*PushResult, int,
+	error) {
+	options := &pushDirectoryOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	remotePrefix = path.Join("/", remotePrefix)
+	localChanges, err := readLocalDirectory(localDir, remotePrefix, options)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	remoteEntries, httpStatusCode, err := con.getFiles(ctx, projectName, repoName, baseRevision,
+		path.Join(remotePrefix, "**"))
+	if err != nil {
+		if apiError, ok := err.(*APIError); !ok || !apiError.Is(ErrEntryNotFound) {
+			return nil, httpStatusCode, err
+		}
+	}
+	remoteFiles := make(map[string]*Entry, len(remoteEntries))
+	for _, entry := range remoteEntries {
+		if entry.Type != Directory {
+			remoteFiles[entry.Path] = entry
+		}
+	}
+
+	var changes []*Change
+	for _, change := range localChanges {
+		if entry, ok := remoteFiles[change.Path]; !ok || !sameContent(change, entry) {
+			changes = append(changes, change)
+		}
+		delete(remoteFiles, change.Path)
+	}
+	if options.removeMissing {
+		for _, entry := range sortedEntries(remoteFiles) {
+			changes = append(changes, &Change{Path: entry.Path, Type: Remove})
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, httpStatusCode, ErrRedundantChange
+	}
+	return con.push(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+}
+
+// readLocalDirectory converts the files in the localDir into the changes. The hidden files and directories
+// are skipped.
+func readLocalDirectory(localDir, remotePrefix string, options *pushDirectoryOptions) ([]*Change, error) {
+	var changes []*Change
+	err := filepath.Walk(localDir, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		name := info.Name()
+		if localPath != localDir && (strings.HasPrefix(name, ".") || options.excluded(name)) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(localDir, localPath)
+		if err != nil {
+			return err
+		}
+		content, err := ioutil.ReadFile(localPath)
+		if err != nil {
+			return err
+		}
+
+		remotePath := path.Join(remotePrefix, filepath.ToSlash(relativePath))
+		var change *Change
+		if strings.HasSuffix(strings.ToLower(remotePath), ".json") {
+			change, err = NewUpsertJSON(remotePath, json.RawMessage(content))
+		} else {
+			change, err = NewUpsertText(remotePath, string(content))
+		}
+		if err != nil {
+			return err
+		}
+		changes = append(changes, change)
+		return nil
+	})
+	return changes, err
+}
+
+func sameContent(change *Change, entry *Entry) bool {
+	switch change.Type {
+	case UpsertJSON:
+		if entry.Type != JSON {
+			return false
+		}
+		var local, remote interface{}
+		if json.Unmarshal(change.Content.(json.RawMessage), &local) != nil ||
+			json.Unmarshal(entry.Content, &remote) != nil {
+			return false
+		}
+		return reflect.DeepEqual(local, remote)
+	case UpsertText:
+		// The server appends a line feed to a text file if missing.
+		return entry.Type == Text &&
+			strings.TrimSuffix(change.Content.(string), "\n") == strings.TrimSuffix(string(entry.Content), "\n")
+	}
+	return false
+}
+
+func sortedEntries(entries map[string]*Entry) []*Entry {
+	paths := make([]string, 0, len(entries))
+	for p := range entries {
+		paths = append(paths, p)
+	}
+	sort.Strings(paths)
+	sorted := make([]*Entry, len(paths))
+	for i, p := range paths {
+		sorted[i] = entries[p]
+	}
+	return sorted
+}
@@ -0,0 +1,81 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPushDirectory(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	dir, err := ioutil.TempDir("", "centraldogma")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFile(t, filepath.Join(dir, "a.json"), `{"a": "b"}`)
+	writeFile(t, filepath.Join(dir, "sub", "b.yaml"), "b: c\n")
+	writeFile(t, filepath.Join(dir, "sub", "c.txt"), "new")
+	writeFile(t, filepath.Join(dir, "d.bak"), "excluded")
+	writeFile(t, filepath.Join(dir, ".hidden", "e.txt"), "hidden")
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/configs/**",
+		func(w http.ResponseWriter, r *http.Request) {
+			testMethod(t, r, http.MethodGet)
+			testURLQuery(t, r, "revision", "-1")
+			fmt.Fprint(w, `[{"path":"/configs/a.json", "type":"JSON", "content":{"a":"b"}},
+{"path":"/configs/sub", "type":"DIRECTORY"},
+{"path":"/configs/sub/b.yaml", "type":"TEXT", "content":"b: c\n"},
+{"path":"/configs/sub/c.txt", "type":"TEXT", "content":"old\n"},
+{"path":"/configs/f.txt", "type":"TEXT", "content":"removed\n"}]`)
+		})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var reqBody push
+		_ = json.NewDecoder(r.Body).Decode(&reqBody)
+		b, _ := json.Marshal(reqBody.Changes)
+		testString(t, string(b), `[{"type":"UPSERT_TEXT","path":"/configs/sub/c.txt","content":"new"},`+
+			`{"type":"REMOVE","path":"/configs/f.txt"}]`, "changes")
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	commitMessage := &CommitMessage{Summary: "Import configs"}
+	result, _, err := c.PushDirectory(context.Background(), "foo", "bar", "-1", commitMessage, dir, "configs",
+		WithExcludePatterns("*.bak"), WithRemoveMissing())
+	if err != nil {
+		t.Fatalf("PushDirectory returned error: %v", err)
+	}
+	if result.Revision != 3 {
+		t.Errorf("PushDirectory returned revision %v, want %v", result.Revision, 3)
+	}
+}
+
+func writeFile(t *testing.T, filename, content string) {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filename, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"strings"
+)
+
+// PushOptions specifies how PushWithOptions pushes the changes.
+type PushOptions struct {
+	// DryRun validates the changes and previews the diffs which would be applied without committing them.
+	DryRun bool
+	// OnBehalfOf is the author who requested the changes, e.g. when the changes are pushed with a token shared
+	// by a bot. The server records the owner of the token as the author of the commit, so the author is
+	// recorded as an "On-behalf-of" trailer in the detail of the commit message.
+	OnBehalfOf *Author
+}
+
+// onBehalfOfTrailer is the trailer of the commit message detail which records the author who requested
+// the changes.
+const onBehalfOfTrailer = "On-behalf-of: "
+
+// commitMessage returns the commit message to push, leaving the specified one intact.
+func (o *PushOptions) commitMessage(commitMessage *CommitMessage) *CommitMessage {
+	if o.OnBehalfOf == nil || commitMessage == nil {
+		return commitMessage
+	}
+
+	trailer := onBehalfOfTrailer + o.OnBehalfOf.Name
+	if len(o.OnBehalfOf.Email) != 0 {
+		trailer += " <" + o.OnBehalfOf.Email + ">"
+	}
+	copied := *commitMessage
+	if len(copied.Detail) == 0 {
+		copied.Detail = trailer
+	} else {
+		copied.Detail = strings.TrimRight(copied.Detail, "\n") + "\n\n" + trailer
+	}
+	return &copied
+}
+
+// OnBehalfOf returns the author recorded in the commit message by PushOptions.OnBehalfOf, or nil if there is no
+// such record.
+func (c *CommitMessage) OnBehalfOf() *Author {
+	lines := strings.Split(c.Detail, "\n")
+	for i := len(lines) - 1; i >= 0; i-- {
+		if !strings.HasPrefix(lines[i], onBehalfOfTrailer) {
+			continue
+		}
+		value := strings.TrimSpace(strings.TrimPrefix(lines[i], onBehalfOfTrailer))
+		author := &Author{Name: value}
+		if start := strings.LastIndex(value, " <"); start >= 0 && strings.HasSuffix(value, ">") {
+			author.Name = value[:start]
+			author.Email = value[start+2 : len(value)-1]
+		}
+		return author
+	}
+	return nil
+}
+
+func (con *contentService) pushWithOptions(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, changes []*Change, opts *PushOptions) (*PushResultDetail, int, error) {
+	if opts == nil {
+		opts = &PushOptions{}
+	}
+	commitMessage = opts.commitMessage(commitMessage)
+	if !opts.DryRun {
+		result, httpStatusCode, err := con.push(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+		if err != nil {
+			return nil, httpStatusCode, err
+		}
+		return &PushResultDetail{PushResult: *result}, httpStatusCode, nil
+	}
+	return con.dryRunPush(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+}
+
+// dryRunPush runs the same validation as push and previews the diffs on top of the base revision.
+func (con *contentService) dryRunPush(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, changes []*Change) (*PushResultDetail, int, error) {
+	if err := con.client.validatePush(commitMessage, changes); err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	if len(baseRevision) == 0 {
+		baseRevision = Head.String()
+	}
+	repository := (*repositoryService)(con)
+	var base int64
+	var httpStatusCode int
+	var err error
+	if baseRevision == Head.String() {
+		// The previewed revision would be wrong if the cached head is stale.
+		base, httpStatusCode, err = repository.fetchHead(ctx, projectName, repoName)
+	} else {
+		base, httpStatusCode, err = repository.normalizeRevision(ctx, projectName, repoName, baseRevision)
+	}
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+
+	previewed, httpStatusCode, err := con.previewDiffs(ctx, projectName, repoName, Rev(base).String(), changes)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	if len(previewed) == 0 {
+		// The server rejects a push which changes nothing.
+		return nil, httpStatusCode, ErrRedundantChange
+	}
+
+	return &PushResultDetail{
+		PushResult: PushResult{Revision: base + 1},
+		Changes:    previewed,
+	}, httpStatusCode, nil
+}
@@ -0,0 +1,128 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestPushWithOptions_DryRun(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"revision":2}`)
+	})
+	redundant := false
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/preview", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURLQuery(t, r, "revision", "2")
+		if redundant {
+			fmt.Fprint(w, `[]`)
+			return
+		}
+		fmt.Fprint(w, `[{"path":"/a.json", "type":"UPSERT_JSON", "content":{"a":"b"}}]`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("a dry run should not push the changes")
+	})
+
+	commitMessage := &CommitMessage{Summary: "Add a.json"}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}
+	opts := &PushOptions{DryRun: true}
+	result, _, err := c.PushWithOptions(context.Background(), "foo", "bar", "-1", commitMessage, changes, opts)
+	if err != nil {
+		t.Fatalf("PushWithOptions returned error: %v", err)
+	}
+	if result.Revision != 3 || len(result.Changes) != 1 || result.Changes[0].Path != "/a.json" {
+		t.Errorf("PushWithOptions returned %+v, want revision 3 with the change of /a.json", result)
+	}
+
+	redundant = true
+	if _, _, err = c.PushWithOptions(context.Background(), "foo", "bar", "-1", commitMessage, changes,
+		opts); err != ErrRedundantChange {
+		t.Errorf("PushWithOptions returned %v, want %v", err, ErrRedundantChange)
+	}
+
+	if _, _, err = c.PushWithOptions(context.Background(), "foo", "bar", "-1", &CommitMessage{}, changes,
+		opts); err == nil {
+		t.Errorf("PushWithOptions should fail when the summary is empty")
+	}
+	if _, _, err = c.PushWithOptions(context.Background(), "foo", "bar", "-1", commitMessage, nil,
+		opts); err == nil {
+		t.Errorf("PushWithOptions should fail when there are no changes")
+	}
+}
+
+func TestPushWithOptions(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	commitMessage := &CommitMessage{Summary: "Add a.json"}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}
+	result, _, err := c.PushWithOptions(context.Background(), "foo", "bar", "-1", commitMessage, changes, nil)
+	if err != nil {
+		t.Fatalf("PushWithOptions returned error: %v", err)
+	}
+	if result.Revision != 3 || result.PushedAt != "2017-05-22T00:00:00Z" {
+		t.Errorf("PushWithOptions returned %+v, want revision 3", result)
+	}
+}
+
+func TestPushWithOptions_OnBehalfOf(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	var pushed *CommitMessage
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body push
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		pushed = body.CommitMessage
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	commitMessage := &CommitMessage{Summary: "Add a.json", Detail: "Requested in #123\n"}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}
+	opts := &PushOptions{OnBehalfOf: &Author{Name: "minux", Email: "minux@m.x"}}
+	if _, _, err := c.PushWithOptions(context.Background(), "foo", "bar", "-1", commitMessage, changes,
+		opts); err != nil {
+		t.Fatalf("PushWithOptions returned error: %v", err)
+	}
+
+	if want := "Requested in #123\n\nOn-behalf-of: minux <minux@m.x>"; pushed.Detail != want {
+		t.Errorf("pushed detail %q, want %q", pushed.Detail, want)
+	}
+	if commitMessage.Detail != "Requested in #123\n" {
+		t.Errorf("the commit message should not be modified: %q", commitMessage.Detail)
+	}
+	if author := pushed.OnBehalfOf(); !reflect.DeepEqual(author, opts.OnBehalfOf) {
+		t.Errorf("OnBehalfOf returned %+v, want %+v", author, opts.OnBehalfOf)
+	}
+	if author := commitMessage.OnBehalfOf(); author != nil {
+		t.Errorf("OnBehalfOf returned %+v, want nil", author)
+	}
+}
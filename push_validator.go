@@ -0,0 +1,143 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+)
+
+// PushValidator validates a change before it is pushed, so that a broken change never reaches the server.
+// It is registered with WithPushValidator.
+type PushValidator interface {
+	Validate(change *Change) error
+}
+
+// PushValidatorFunc is an adapter which allows the use of an ordinary func as a PushValidator.
+type PushValidatorFunc func(change *Change) error
+
+// Validate calls f(change).
+func (f PushValidatorFunc) Validate(change *Change) error {
+	return f(change)
+}
+
+// JSONValidator returns a PushValidator which validates the value decoded from the content of an UpsertJSON
+// change. The other types of the changes are not validated.
+func JSONValidator(validate func(value interface{}) error) PushValidator {
+	return PushValidatorFunc(func(change *Change) error {
+		if change.Type != UpsertJSON {
+			return nil
+		}
+		content, err := marshalJSONContent(change.Content)
+		if err != nil {
+			return err
+		}
+		var value interface{}
+		if err = json.Unmarshal(content, &value); err != nil {
+			return err
+		}
+		return validate(value)
+	})
+}
+
+// PushValidationError is returned when the changes are rejected by the PushValidators.
+type PushValidationError struct {
+	Failures []*PushValidationFailure
+}
+
+// PushValidationFailure represents a change rejected by a PushValidator.
+type PushValidationFailure struct {
+	Path string
+	Err  error
+}
+
+func (e *PushValidationError) Error() string {
+	messages := make([]string, len(e.Failures))
+	for i, failure := range e.Failures {
+		messages[i] = fmt.Sprintf("%s: %v", failure.Path, failure.Err)
+	}
+	return "invalid changes: " + strings.Join(messages, "; ")
+}
+
+type pushValidator struct {
+	pathPattern string
+	validator   PushValidator
+}
+
+// validateChanges validates the changes with the PushValidators whose path pattern matches the path of
+// the change.
+func (c *Client) validateChanges(changes []*Change) error {
+	if len(c.pushValidators) == 0 {
+		return nil
+	}
+
+	var failures []*PushValidationFailure
+	for _, change := range changes {
+		for _, v := range c.pushValidators {
+			if !matchPathPattern(v.pathPattern, change.Path) {
+				continue
+			}
+			if err := v.validator.Validate(change); err != nil {
+				failures = append(failures, &PushValidationFailure{Path: change.Path, Err: err})
+			}
+		}
+	}
+	if len(failures) != 0 {
+		return &PushValidationError{Failures: failures}
+	}
+	return nil
+}
+
+// matchPathPattern returns whether the file path matches the path pattern, which is a variant of glob as
+// the one of GetFiles. A pattern which does not start with "/" matches the files at any depth.
+func matchPathPattern(pathPattern, filePath string) bool {
+	for _, pattern := range strings.Split(pathPattern, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if len(pattern) == 0 {
+			continue
+		}
+		if !strings.HasPrefix(pattern, "/") {
+			pattern = "/**/" + pattern
+		}
+		if matchSegments(strings.Split(pattern[1:], "/"), strings.Split(strings.TrimPrefix(filePath, "/"), "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchSegments(patterns, segments []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == "**" {
+			// "**" matches zero or more segments.
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(patterns[1:], segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		if matched, err := path.Match(patterns[0], segments[0]); err != nil || !matched {
+			return false
+		}
+		patterns, segments = patterns[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
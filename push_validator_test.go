@@ -0,0 +1,113 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestMatchPathPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"/**", "/a.json", true},
+		{"/**", "/a/b/c.json", true},
+		{"*.json", "/a.json", true},
+		{"*.json", "/a/b/c.json", true},
+		{"*.json", "/a/b/c.txt", false},
+		{"/a/*.json", "/a/b.json", true},
+		{"/a/*.json", "/a/b/c.json", false},
+		{"/a/**/*.json", "/a/b.json", true},
+		{"/a/**/*.json", "/a/b/c/d.json", true},
+		{"/*/foo.txt", "/a/foo.txt", true},
+		{"/*/foo.txt", "/foo.txt", false},
+		{"/a.json", "/a.json", true},
+		{"/b.json, *.txt", "/c/d.txt", true},
+		{"/b.json, *.txt", "/c.json", false},
+	}
+	for _, test := range tests {
+		if got := matchPathPattern(test.pattern, test.path); got != test.want {
+			t.Errorf("matchPathPattern(%q, %q) = %v, want %v", test.pattern, test.path, got, test.want)
+		}
+	}
+}
+
+func TestWithPushValidator(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	pushes := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		pushes++
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	requirePort := JSONValidator(func(value interface{}) error {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("should be an object")
+		}
+		if _, ok = object["port"]; !ok {
+			return fmt.Errorf("port is missing")
+		}
+		return nil
+	})
+	noRemove := PushValidatorFunc(func(change *Change) error {
+		if change.Type == Remove {
+			return fmt.Errorf("should not be removed")
+		}
+		return nil
+	})
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport),
+		WithPushValidator("/servers/*.json", requirePort), WithPushValidator("/**", noRemove))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	commitMessage := &CommitMessage{Summary: "Update servers"}
+	changes := []*Change{
+		{Path: "/servers/a.json", Type: UpsertJSON, Content: map[string]interface{}{"port": 8080}},
+		{Path: "/servers/b.json", Type: UpsertJSON, Content: map[string]interface{}{"host": "b"}},
+		{Path: "/c.json", Type: UpsertJSON, Content: map[string]interface{}{"host": "c"}},
+		{Path: "/d.json", Type: Remove},
+	}
+	_, httpStatusCode, err := c.Push(context.Background(), "foo", "bar", "-1", commitMessage, changes)
+	validationError, ok := err.(*PushValidationError)
+	if !ok {
+		t.Fatalf("Push returned %v, want a PushValidationError", err)
+	}
+	testStatusCode(t, httpStatusCode, UnknownHttpStatusCode)
+	if len(validationError.Failures) != 2 || validationError.Failures[0].Path != "/servers/b.json" ||
+		validationError.Failures[1].Path != "/d.json" {
+		t.Errorf("Push returned %v, want the failures of /servers/b.json and /d.json", err)
+	}
+	if pushes != 0 {
+		t.Errorf("the invalid changes should not be pushed")
+	}
+
+	if _, _, err = c.Push(context.Background(), "foo", "bar", "-1", commitMessage, changes[:1]); err != nil {
+		t.Fatalf("Push returned error: %v", err)
+	}
+	if pushes != 1 {
+		t.Errorf("pushed %v times, want %v", pushes, 1)
+	}
+}
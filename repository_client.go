@@ -0,0 +1,198 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+)
+
+// RepositoryClient provides the APIs on a repository so that the project and repository names do not have to
+// be specified on every call. For example:
+//
+//	repo := client.Repo("foo", "bar")
+//	entry, _, err := repo.File("/a.json").Revision("-1").Get(ctx)
+//	...
+//	result, _, err := repo.Commit("Update a.json").Push(ctx, change)
+type RepositoryClient struct {
+	client      *Client
+	projectName string
+	repoName    string
+}
+
+// Repo returns a RepositoryClient of the specified repository.
+func (c *Client) Repo(projectName, repoName string) *RepositoryClient {
+	return &RepositoryClient{client: c, projectName: projectName, repoName: repoName}
+}
+
+// ProjectName returns the name of the project.
+func (r *RepositoryClient) ProjectName() string {
+	return r.projectName
+}
+
+// RepoName returns the name of the repository.
+func (r *RepositoryClient) RepoName() string {
+	return r.repoName
+}
+
+// NormalizeRevision converts the relative revision number to the absolute revision number(e.g. -1 -> 3).
+func (r *RepositoryClient) NormalizeRevision(ctx context.Context, revision string) (normalizedRev int64,
+	httpStatusCode int, err error) {
+	return r.client.NormalizeRevision(ctx, r.projectName, r.repoName, revision)
+}
+
+// ListFiles returns the list of files that match the given path pattern.
+func (r *RepositoryClient) ListFiles(ctx context.Context, revision, pathPattern string) (entries []*Entry,
+	httpStatusCode int, err error) {
+	return r.client.ListFiles(ctx, r.projectName, r.repoName, revision, pathPattern)
+}
+
+// GetFiles returns the files that match the given path pattern.
+func (r *RepositoryClient) GetFiles(ctx context.Context, revision, pathPattern string) (entries []*Entry,
+	httpStatusCode int, err error) {
+	return r.client.GetFiles(ctx, r.projectName, r.repoName, revision, pathPattern)
+}
+
+// History returns the history of the files that match the given path pattern.
+func (r *RepositoryClient) History(ctx context.Context, from, to, pathPattern string, maxCommits int) (
+	commits []*Commit, httpStatusCode int, err error) {
+	return r.client.GetHistory(ctx, r.projectName, r.repoName, from, to, pathPattern, maxCommits)
+}
+
+// Diff returns the diffs of the files that match the given path pattern between two revisions.
+func (r *RepositoryClient) Diff(ctx context.Context, from, to, pathPattern string) (changes []*Change,
+	httpStatusCode int, err error) {
+	return r.client.GetDiffs(ctx, r.projectName, r.repoName, from, to, pathPattern)
+}
+
+// File returns a FileRequest which retrieves the file at the path.
+func (r *RepositoryClient) File(path string) *FileRequest {
+	return &FileRequest{repo: r, query: Query{Path: path, Type: Identity}, revision: "-1"}
+}
+
+// MergeFiles returns a MergeRequest which merges the JSON files specified in the MergeSources.
+func (r *RepositoryClient) MergeFiles(mergeSources ...*MergeSource) *MergeRequest {
+	return &MergeRequest{repo: r, query: MergeQuery{MergeSources: mergeSources, Type: Identity}, revision: "-1"}
+}
+
+// Commit returns a CommitRequest which pushes changes with the summary.
+func (r *RepositoryClient) Commit(summary string) *CommitRequest {
+	return &CommitRequest{repo: r, commitMessage: CommitMessage{Summary: summary}, baseRevision: "-1"}
+}
+
+// Watcher returns a Watcher which notifies its listeners when the file at the path becomes available or
+// changes.
+func (r *RepositoryClient) Watcher(path string) (*Watcher, error) {
+	return r.File(path).Watcher()
+}
+
+// RepoWatcher returns a Watcher which notifies its listeners when the files that match the given pathPattern
+// become available or change.
+func (r *RepositoryClient) RepoWatcher(pathPattern string) (*Watcher, error) {
+	return r.client.RepoWatcher(r.projectName, r.repoName, pathPattern)
+}
+
+// FileRequest retrieves or watches a file. It is created by RepositoryClient.File.
+type FileRequest struct {
+	repo     *RepositoryClient
+	query    Query
+	revision string
+}
+
+// JSONPath applies the JSON path expressions to the content of the file.
+func (f *FileRequest) JSONPath(expressions ...string) *FileRequest {
+	f.query.Type = JSONPath
+	f.query.Expressions = expressions
+	return f
+}
+
+// Revision sets the revision of the file to retrieve. The latest revision is used by default.
+func (f *FileRequest) Revision(revision string) *FileRequest {
+	f.revision = revision
+	return f
+}
+
+// Get retrieves the file.
+func (f *FileRequest) Get(ctx context.Context) (entry *Entry, httpStatusCode int, err error) {
+	query := f.query
+	return f.repo.client.GetFile(ctx, f.repo.projectName, f.repo.repoName, f.revision, &query)
+}
+
+// Diff returns the diff of the file between two revisions.
+func (f *FileRequest) Diff(ctx context.Context, from, to string) (change *Change, httpStatusCode int, err error) {
+	query := f.query
+	return f.repo.client.GetDiff(ctx, f.repo.projectName, f.repo.repoName, from, to, &query)
+}
+
+// Watcher returns a Watcher which notifies its listeners when the file becomes available or changes.
+// The revision of the FileRequest is ignored.
+func (f *FileRequest) Watcher() (*Watcher, error) {
+	query := f.query
+	return f.repo.client.FileWatcher(f.repo.projectName, f.repo.repoName, &query)
+}
+
+// MergeRequest retrieves the merged JSON files. It is created by RepositoryClient.MergeFiles.
+type MergeRequest struct {
+	repo     *RepositoryClient
+	query    MergeQuery
+	revision string
+}
+
+// JSONPath applies the JSON path expressions to the merged content.
+func (m *MergeRequest) JSONPath(expressions ...string) *MergeRequest {
+	m.query.Type = JSONPath
+	m.query.Expressions = expressions
+	return m
+}
+
+// Revision sets the revision of the files to merge. The latest revision is used by default.
+func (m *MergeRequest) Revision(revision string) *MergeRequest {
+	m.revision = revision
+	return m
+}
+
+// Get retrieves the merged entry.
+func (m *MergeRequest) Get(ctx context.Context) (mergedEntry *MergedEntry, httpStatusCode int, err error) {
+	query := m.query
+	return m.repo.client.GetMergedEntry(ctx, m.repo.projectName, m.repo.repoName, m.revision, &query)
+}
+
+// CommitRequest pushes changes to the repository. It is created by RepositoryClient.Commit.
+type CommitRequest struct {
+	repo          *RepositoryClient
+	commitMessage CommitMessage
+	baseRevision  string
+}
+
+// Detail sets the detail of the commit message. The markup can be MarkupPlaintext or MarkupMarkdown.
+func (cr *CommitRequest) Detail(detail, markup string) *CommitRequest {
+	cr.commitMessage.Detail = detail
+	cr.commitMessage.Markup = markup
+	return cr
+}
+
+// BaseRevision sets the revision which the changes are pushed on top of. The latest revision is used
+// by default.
+func (cr *CommitRequest) BaseRevision(revision string) *CommitRequest {
+	cr.baseRevision = revision
+	return cr
+}
+
+// Push pushes the changes.
+func (cr *CommitRequest) Push(ctx context.Context, changes ...*Change) (result *PushResult,
+	httpStatusCode int, err error) {
+	commitMessage := cr.commitMessage
+	return cr.repo.client.Push(ctx, cr.repo.projectName, cr.repo.repoName, cr.baseRevision,
+		&commitMessage, changes)
+}
@@ -0,0 +1,93 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestRepositoryClient_File(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "2")
+		testURLQuery(t, r, "jsonpath", "$.a")
+		fmt.Fprint(w, `{"path":"/a.json", "type":"JSON", "content":{"b":"c"}, "revision":2}`)
+	})
+
+	entry, _, err := c.Repo("foo", "bar").File("/a.json").JSONPath("$.a").Revision("2").Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	want := &Entry{Path: "/a.json", Type: JSON, Content: EntryContent(`{"b":"c"}`), Revision: 2}
+	if !reflect.DeepEqual(entry, want) {
+		t.Errorf("Get returned %+v, want %+v", entry, want)
+	}
+}
+
+func TestRepositoryClient_MergeFiles(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/merge", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "path", "/a.json")
+		testURLQuery(t, r, "optional_path", "/b.json")
+		fmt.Fprint(w, `{"paths":["/a.json"], "type":"JSON", "content":{"a":"b"}, "revision":3}`)
+	})
+
+	mergedEntry, _, err := c.Repo("foo", "bar").
+		MergeFiles(&MergeSource{Path: "/a.json"}, &MergeSource{Path: "/b.json", Optional: true}).
+		Get(context.Background())
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	testString(t, string(mergedEntry.Content), `{"a":"b"}`, "content")
+}
+
+func TestRepositoryClient_Commit(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURLQuery(t, r, "revision", "3")
+
+		var reqBody push
+		_ = json.NewDecoder(r.Body).Decode(&reqBody)
+		want := &CommitMessage{Summary: "Add b.txt", Detail: "detail", Markup: "PLAINTEXT"}
+		if !reflect.DeepEqual(reqBody.CommitMessage, want) {
+			t.Errorf("commit message %+v, want %+v", reqBody.CommitMessage, want)
+		}
+		fmt.Fprint(w, `{"revision":4, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	change, _ := NewUpsertText("/b.txt", "hello")
+	result, _, err := c.Repo("foo", "bar").Commit("Add b.txt").Detail("detail", "PLAINTEXT").
+		BaseRevision("3").Push(context.Background(), change)
+	if err != nil {
+		t.Fatalf("Push returned error: %v", err)
+	}
+	if result.Revision != 4 {
+		t.Errorf("Push returned revision %v, want %v", result.Revision, 4)
+	}
+}
@@ -16,6 +16,7 @@ package centraldogma
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"path"
@@ -32,7 +33,64 @@ type Repository struct {
 	CreatedAt    string `json:"createdAt,omitempty"`
 }
 
+// RepositoryOptions specifies the description and the initial files of a repository created with
+// CreateRepositoryWithOptions.
+type RepositoryOptions struct {
+	// Description is sent along with the creation request.
+	Description string
+	// InitialChanges are pushed on top of the initial commit of the repository.
+	InitialChanges []*Change
+	// InitialCommitMessage is the commit message of InitialChanges. "Add initial files" is used if not
+	// specified.
+	InitialCommitMessage *CommitMessage
+}
+
 func (r *repositoryService) create(ctx context.Context, projectName, repoName string) (*Repository, int, error) {
+	return r.createWithDescription(ctx, projectName, repoName, "")
+}
+
+func (r *repositoryService) createWithOptions(ctx context.Context, projectName, repoName string,
+	opts *RepositoryOptions) (*Repository, int, error) {
+	if opts == nil {
+		opts = &RepositoryOptions{}
+	}
+	repo, httpStatusCode, err := r.createWithDescription(ctx, projectName, repoName, opts.Description)
+	if err != nil || len(opts.InitialChanges) == 0 {
+		return repo, httpStatusCode, err
+	}
+
+	commitMessage := opts.InitialCommitMessage
+	if commitMessage == nil {
+		commitMessage = &CommitMessage{Summary: "Add initial files"}
+	}
+	// Push on top of the initial commit so that the push fails rather than races with someone else's.
+	content := (*contentService)(r)
+	result, httpStatusCode, err := content.pushIfUnchanged(ctx, projectName, repoName, Rev(1),
+		commitMessage, opts.InitialChanges)
+	if err != nil {
+		r.rollbackCreation(ctx, projectName, repoName, err)
+		return nil, httpStatusCode, err
+	}
+	repo.HeadRevision = result.Revision
+	return repo, httpStatusCode, nil
+}
+
+// rollbackCreation removes and purges the repository which failed to be set up, so that the creation can be
+// retried with the same name.
+func (r *repositoryService) rollbackCreation(ctx context.Context, projectName, repoName string, cause error) {
+	r.client.logger.Warnf("Rolling back the creation of the repository: %s/%s, err=%v", projectName, repoName,
+		cause)
+	if _, err := r.remove(ctx, projectName, repoName); err != nil {
+		r.client.logger.Warnf("Failed to remove the repository: %s/%s, err=%v", projectName, repoName, err)
+		return
+	}
+	if _, err := r.purge(ctx, projectName, repoName); err != nil {
+		r.client.logger.Warnf("Failed to purge the repository: %s/%s, err=%v", projectName, repoName, err)
+	}
+}
+
+func (r *repositoryService) createWithDescription(ctx context.Context, projectName, repoName,
+	description string) (*Repository, int, error) {
 	// build relative url
 	u, err := url.Parse(path.Join(
 		defaultPathPrefix,
@@ -44,6 +102,9 @@ func (r *repositoryService) create(ctx context.Context, projectName, repoName st
 	}
 
 	body := map[string]string{"name": repoName}
+	if len(description) > 0 {
+		body["description"] = description
+	}
 	req, err := r.client.newRequest(http.MethodPost, u, body)
 	if err != nil {
 		return nil, UnknownHttpStatusCode, err
@@ -183,6 +244,67 @@ func (r *repositoryService) listRemoved(ctx context.Context, projectName string)
 }
 
 func (r *repositoryService) normalizeRevision(
+	ctx context.Context, projectName, repoName, revision string) (int64, int, error) {
+	var relative Revision
+	if _, ok := callBaseURLFromContext(ctx); !ok && r.client.revisionCache != nil && len(revision) != 0 {
+		if parsed, err := ParseRevision(revision); err == nil && parsed.IsRelative() {
+			if normalized, ok := r.client.revisionCache.normalize(projectName, repoName, parsed); ok {
+				return normalized, http.StatusOK, nil
+			}
+			relative = parsed
+		}
+	}
+
+	normalized, httpStatusCode, err := r.fetchNormalizedRevision(ctx, projectName, repoName, revision)
+	if err == nil && relative != 0 {
+		r.client.observeRevision(ctx, projectName, repoName, normalized-int64(relative)-1)
+	}
+	return normalized, httpStatusCode, err
+}
+
+// fetchHead retrieves the latest revision from the server without the revision cache, which may be stale,
+// and records it in the cache. It is used to find the base revision of the pushes which must not conflict
+// with a stale head.
+func (r *repositoryService) fetchHead(ctx context.Context, projectName, repoName string) (int64, int, error) {
+	head, httpStatusCode, err := r.fetchNormalizedRevision(ctx, projectName, repoName, Head.String())
+	if err == nil {
+		r.client.observeRevision(ctx, projectName, repoName, head)
+	}
+	return head, httpStatusCode, err
+}
+
+// normalizeRevisions normalizes the revisions with a single request which retrieves the latest revision.
+func (r *repositoryService) normalizeRevisions(
+	ctx context.Context, projectName, repoName string, revisions []string) ([]int64, int, error) {
+	parsed := make([]Revision, len(revisions))
+	for i, revision := range revisions {
+		var err error
+		if parsed[i], err = ParseRevision(revision); err != nil {
+			return nil, UnknownHttpStatusCode, err
+		}
+	}
+
+	head, httpStatusCode, err := r.normalizeRevision(ctx, projectName, repoName, Head.String())
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+
+	normalized := make([]int64, len(parsed))
+	for i, revision := range parsed {
+		if revision.IsRelative() {
+			normalized[i] = head + int64(revision) + 1
+		} else {
+			normalized[i] = int64(revision)
+		}
+		if normalized[i] <= 0 || normalized[i] > head {
+			return nil, UnknownHttpStatusCode, fmt.Errorf(
+				"revision %v does not exist (head: %v)", revisions[i], head)
+		}
+	}
+	return normalized, httpStatusCode, nil
+}
+
+func (r *repositoryService) fetchNormalizedRevision(
 	ctx context.Context, projectName, repoName, revision string) (int64, int, error) {
 	// build relative url
 	u, err := url.Parse(path.Join(
@@ -52,6 +52,82 @@ func TestCreateRepository(t *testing.T) {
 	}
 }
 
+func TestCreateRepositoryWithOptions(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		var body map[string]string
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		want := map[string]string{"name": "bar", "description": "The bar repository"}
+		if !reflect.DeepEqual(body, want) {
+			t.Errorf("Request body = %+v, want %+v", body, want)
+		}
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"bar", "headRevision": 1}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURLQuery(t, r, "revision", "1")
+		var body map[string]interface{}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		if summary := body["commitMessage"].(map[string]interface{})["summary"]; summary != "Add initial files" {
+			t.Errorf("summary = %v, want %v", summary, "Add initial files")
+		}
+		fmt.Fprint(w, `{"revision":2, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	opts := &RepositoryOptions{
+		Description:    "The bar repository",
+		InitialChanges: []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}},
+	}
+	repo, _, err := c.CreateRepositoryWithOptions(context.Background(), "foo", "bar", opts)
+	if err != nil {
+		t.Fatalf("CreateRepositoryWithOptions returned error: %v", err)
+	}
+	if want := (&Repository{Name: "bar", HeadRevision: 2}); !reflect.DeepEqual(repo, want) {
+		t.Errorf("CreateRepositoryWithOptions returned %+v, want %+v", repo, want)
+	}
+}
+
+func TestCreateRepositoryWithOptionsRollback(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"bar", "headRevision": 1}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.ChangeConflictException",`+
+			`"message":"conflict"}`)
+	})
+	var rollback []string
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		rollback = append(rollback, "remove")
+		w.WriteHeader(http.StatusNoContent)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/removed", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		rollback = append(rollback, "purge")
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	opts := &RepositoryOptions{
+		InitialChanges: []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}},
+	}
+	_, _, err := c.CreateRepositoryWithOptions(context.Background(), "foo", "bar", opts)
+	if !isChangeConflict(err) {
+		t.Errorf("CreateRepositoryWithOptions returned %v, want a conflict", err)
+	}
+	if want := []string{"remove", "purge"}; !reflect.DeepEqual(rollback, want) {
+		t.Errorf("rollback = %v, want %v", rollback, want)
+	}
+}
+
 func TestRemoveRepository(t *testing.T) {
 	c, mux, teardown := setup()
 	defer teardown()
@@ -163,3 +239,33 @@ func TestNormalizeRevision(t *testing.T) {
 		t.Errorf("NormalizeRevision returned %v, want %v", normalizedRevision, want)
 	}
 }
+
+func TestNormalizeRevisions(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	requests := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		requests++
+		fmt.Fprint(w, `{"revision":5}`)
+	})
+
+	normalized, _, err := c.NormalizeRevisions(context.Background(), "foo", "bar", "-1", "-3", "2", "head")
+	if err != nil {
+		t.Fatalf("NormalizeRevisions returned error: %v", err)
+	}
+	if want := []int64{5, 3, 2, 5}; !reflect.DeepEqual(normalized, want) {
+		t.Errorf("NormalizeRevisions returned %v, want %v", normalized, want)
+	}
+	if requests != 1 {
+		t.Errorf("sent %v requests, want %v", requests, 1)
+	}
+
+	if _, _, err = c.NormalizeRevisions(context.Background(), "foo", "bar", "6"); err == nil {
+		t.Errorf("NormalizeRevisions should fail for a revision after the head")
+	}
+	if _, _, err = c.NormalizeRevisions(context.Background(), "foo", "bar", "-6"); err == nil {
+		t.Errorf("NormalizeRevisions should fail for a revision before the initial commit")
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"time"
+)
+
+// RequestIDHeader is the header which carries the ID of every request sent by a Client, so that a failure can be
+// correlated with the access logs of the server. The ID is also logged and set to the RequestID of an APIError.
+const RequestIDHeader = "X-Request-Id"
+
+type requestIDKey struct{}
+
+// ContextWithRequestID returns a copy of the ctx which makes the requests sent with it carry the id instead of
+// a generated one. All the requests sent with the returned context carry the same id, e.g. the requests of
+// CreateProjectWithOptions. For example:
+//
+//	ctx := centraldogma.ContextWithRequestID(ctx, id)
+//	entry, _, err := client.GetFile(ctx, "foo", "bar", "-1", query)
+func ContextWithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID set by ContextWithRequestID, or an empty string if not set.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// newRequestID returns a random ID of 32 hexadecimal digits.
+func newRequestID() string {
+	var id [16]byte
+	if _, err := rand.Read(id[:]); err != nil {
+		// Fall back to the time which is unique enough to correlate the logs.
+		return strconv.FormatInt(time.Now().UnixNano(), 16)
+	}
+	return hex.EncodeToString(id[:])
+}
@@ -0,0 +1,68 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+)
+
+func TestRequestID(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	var requestIDs []string
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		requestIDs = append(requestIDs, r.Header.Get(RequestIDHeader))
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	})
+
+	// A new ID is generated for every request.
+	for i := 0; i < 2; i++ {
+		if _, _, err := c.ListProjects(context.Background()); err != nil {
+			t.Fatalf("ListProjects returned error: %v", err)
+		}
+	}
+	for _, requestID := range requestIDs {
+		if !regexp.MustCompile("^[0-9a-f]{32}$").MatchString(requestID) {
+			t.Errorf("request id: %q, want 32 hexadecimal digits", requestID)
+		}
+	}
+	if requestIDs[0] == requestIDs[1] {
+		t.Errorf("request id %q is used twice", requestIDs[0])
+	}
+
+	// The ID in the context is used instead.
+	ctx := ContextWithRequestID(context.Background(), "my-request")
+	testString(t, RequestIDFromContext(ctx), "my-request", "request id")
+	if _, _, err := c.ListProjects(ctx); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, requestIDs[2], "my-request", "request id")
+
+	// The ID is set to the APIError of a streamed response as well.
+	_, _, err := c.OpenFile(ctx, "foo", "bar", "-1", "/a.txt")
+	apiError, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("OpenFile returned %v, want *APIError", err)
+	}
+	testString(t, apiError.RequestID, "my-request", "request id")
+}
@@ -0,0 +1,113 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// requestLimiter limits the rate and the concurrency of the requests sent by a Client, so that a misbehaving
+// caller cannot overload the server. It is shared by all the services of the Client.
+type requestLimiter struct {
+	// rate limit using a token bucket. Disabled if rps is 0.
+	rps   float64
+	burst float64
+	now   func() time.Time
+
+	lock   sync.Mutex
+	tokens float64
+	last   time.Time
+
+	// concurrency limit. nil if disabled.
+	inflight chan struct{}
+}
+
+func newRequestLimiter(rps float64, burst, maxInflight int) *requestLimiter {
+	l := &requestLimiter{now: time.Now}
+	if rps > 0 {
+		if burst < 1 {
+			burst = 1
+		}
+		l.rps = rps
+		l.burst = float64(burst)
+		l.tokens = l.burst
+		l.last = l.now()
+	}
+	if maxInflight > 0 {
+		l.inflight = make(chan struct{}, maxInflight)
+	}
+	return l
+}
+
+// acquire waits until the request is allowed to be sent or the context is done. The returned func must be
+// called after the response is consumed. Watch requests are not counted as in-flight requests because they
+// are pending for a long time by design.
+func (l *requestLimiter) acquire(ctx context.Context, watchRequest bool) (release func(), err error) {
+	if err = l.waitRate(ctx); err != nil {
+		return nil, err
+	}
+	if l.inflight == nil || watchRequest {
+		return func() {}, nil
+	}
+
+	select {
+	case l.inflight <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			<-l.inflight
+		})
+	}, nil
+}
+
+// waitRate takes a token from the bucket, waiting until the token is available.
+func (l *requestLimiter) waitRate(ctx context.Context) error {
+	if l.rps == 0 {
+		return nil
+	}
+
+	l.lock.Lock()
+	now := l.now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rps
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	// Reserve the token in advance so that the waiting requests are served in order.
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rps * float64(time.Second))
+	l.lock.Unlock()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Give back the reserved token.
+		l.lock.Lock()
+		l.tokens++
+		l.lock.Unlock()
+		return ctx.Err()
+	}
+}
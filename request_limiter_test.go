@@ -0,0 +1,100 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRequestLimiter_Rate(t *testing.T) {
+	now := time.Now()
+	l := newRequestLimiter(10, 2, 0)
+	l.now = func() time.Time { return now }
+	l.last = now
+
+	// The burst is allowed immediately.
+	for i := 0; i < 2; i++ {
+		if err := l.waitRate(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// The next one has to wait 100ms, so it fails with a shorter deadline and gives back the token.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := l.waitRate(ctx); err != context.DeadlineExceeded {
+		t.Errorf("waitRate returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// The tokens are refilled as the time passes.
+	now = now.Add(200 * time.Millisecond)
+	for i := 0; i < 2; i++ {
+		start := time.Now()
+		if err := l.waitRate(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+		if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+			t.Errorf("waitRate waited %v, want no wait", elapsed)
+		}
+	}
+}
+
+func TestWithMaxInflight(t *testing.T) {
+	var inflight, maxInflight int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&inflight, 1)
+		defer atomic.AddInt32(&inflight, -1)
+		for {
+			max := atomic.LoadInt32(&maxInflight)
+			if n <= max || atomic.CompareAndSwapInt32(&maxInflight, max, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		fmt.Fprint(w, `[]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithMaxInflight(2),
+		WithRateLimit(1000, 10))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.ListProjects(context.Background()); err != nil {
+				t.Errorf("ListProjects returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if n := atomic.LoadInt32(&maxInflight); n != 2 {
+		t.Errorf("max inflight: %v, want 2", n)
+	}
+}
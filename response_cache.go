@@ -0,0 +1,113 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"container/list"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// responseCache is an LRU cache of the response bodies of the content requests. The responses at an absolute
+// revision never change so they are reused without sending a request.
+type responseCache struct {
+	size int
+	ttl  time.Duration
+	now  func() time.Time
+
+	lock    sync.Mutex
+	lru     *list.List // of *cachedResponse, the most recently used first
+	entries map[string]*list.Element
+}
+
+type cachedResponse struct {
+	key      string
+	body     []byte
+	storedAt time.Time
+}
+
+func newResponseCache(size int, ttl time.Duration) *responseCache {
+	return &responseCache{
+		size:    size,
+		ttl:     ttl,
+		now:     time.Now,
+		lru:     list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+// isCacheableRequest returns whether the response of the request can be cached. Only the requests which
+// retrieve the contents of a repository are cached.
+func isCacheableRequest(req *http.Request) bool {
+	return req.Method == http.MethodGet && strings.Contains(req.URL.Path, "/"+contents+"/")
+}
+
+// isImmutableRequest returns whether the request retrieves the contents at an absolute revision.
+func isImmutableRequest(req *http.Request) bool {
+	revision, err := strconv.ParseInt(req.URL.Query().Get("revision"), 10, 64)
+	return err == nil && revision > 0
+}
+
+// contentsRequestRepo returns the project and the repository of the request which retrieves the contents.
+func contentsRequestRepo(req *http.Request) (projectName, repoName string, ok bool) {
+	segments := strings.Split(req.URL.Path, "/")
+	for i := 0; i+4 < len(segments); i++ {
+		if segments[i] == projects && segments[i+2] == repos && segments[i+4] == contents {
+			return segments[i+1], segments[i+3], true
+		}
+	}
+	return "", "", false
+}
+
+func (rc *responseCache) get(key string) *cachedResponse {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	element, ok := rc.entries[key]
+	if !ok {
+		return nil
+	}
+	cached := element.Value.(*cachedResponse)
+	if rc.ttl > 0 && rc.now().Sub(cached.storedAt) > rc.ttl {
+		rc.removeElement(element)
+		return nil
+	}
+	rc.lru.MoveToFront(element)
+	return cached
+}
+
+func (rc *responseCache) put(cached *cachedResponse) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	cached.storedAt = rc.now()
+	if element, ok := rc.entries[cached.key]; ok {
+		element.Value = cached
+		rc.lru.MoveToFront(element)
+		return
+	}
+	rc.entries[cached.key] = rc.lru.PushFront(cached)
+	for rc.lru.Len() > rc.size {
+		rc.removeElement(rc.lru.Back())
+	}
+}
+
+func (rc *responseCache) removeElement(element *list.Element) {
+	rc.lru.Remove(element)
+	delete(rc.entries, element.Value.(*cachedResponse).key)
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestResponseCache_Eviction(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache := newResponseCache(2, time.Minute)
+	cache.now = func() time.Time { return now }
+
+	cache.put(&cachedResponse{key: "a"})
+	cache.put(&cachedResponse{key: "b"})
+	cache.get("a")
+	cache.put(&cachedResponse{key: "c"})
+	if cache.get("b") != nil {
+		t.Errorf("the least recently used response should be evicted")
+	}
+	if cache.get("a") == nil || cache.get("c") == nil {
+		t.Errorf("the recently used responses should not be evicted")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if cache.get("a") != nil {
+		t.Errorf("the expired response should not be returned")
+	}
+}
+
+func TestWithCache(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithCache(10, time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	absoluteRequests, headRequests, head := 0, 0, 2
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		headRequests++
+		fmt.Fprintf(w, `{"revision":%d}`, head)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		// A conditional request is a watch request on the server.
+		testHeader(t, r, "If-None-Match", "")
+		absoluteRequests++
+		fmt.Fprintf(w, `{"path":"/a.json", "type":"JSON", "content":{"a":"%s"}, "revision":%s}`,
+			r.URL.Query().Get("revision"), r.URL.Query().Get("revision"))
+	})
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	read := func(revision, want string) {
+		entry, httpStatusCode, err := c.GetFile(context.Background(), "foo", "bar", revision, query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		testStatusCode(t, httpStatusCode, http.StatusOK)
+		testString(t, string(entry.Content), want, "content")
+	}
+	for i := 0; i < 2; i++ {
+		read("2", `{"a":"2"}`)
+		// The head revision is normalized and the contents at the normalized revision are reused.
+		read("-1", `{"a":"2"}`)
+	}
+	head = 3
+	read("-1", `{"a":"3"}`)
+
+	if absoluteRequests != 2 {
+		t.Errorf("sent %v requests for the contents, want %v", absoluteRequests, 2)
+	}
+	if headRequests != 3 {
+		t.Errorf("sent %v requests to normalize the head revision, want %v", headRequests, 3)
+	}
+}
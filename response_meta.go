@@ -0,0 +1,97 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// ResponseMeta describes the responses of the requests sent with the context returned by
+// ContextWithResponseMeta, e.g. to report the latency for SLOs. If more than one request is sent with
+// the context, e.g. by CreateProjectWithOptions, the Latency and the Attempts are accumulated and the others
+// describe the last request. A response served from the cache of WithCache without a request is not recorded.
+type ResponseMeta struct {
+	// Header is the header of the last response, e.g. the Location of a created resource. It is nil if no
+	// response is received.
+	Header http.Header
+	// StatusCode is the status code of the last response or UnknownHttpStatusCode if no response is received.
+	StatusCode int
+	// Latency is the total time taken until the response headers are received.
+	Latency time.Duration
+	// Attempts is the number of the requests sent including the ones failed over to the other endpoints.
+	// See WithEndpoints.
+	Attempts int
+	// Endpoint is the scheme and the host of the server which the last request was sent to,
+	// e.g. "https://dogma1.example.com:8443".
+	Endpoint string
+	// RequestID is the ID of the last request. See RequestIDHeader.
+	RequestID string
+}
+
+type responseMetaKey struct{}
+
+// responseMetaRecorder guards the ResponseMeta because the requests sent with the same context can be
+// concurrent.
+type responseMetaRecorder struct {
+	lock sync.Mutex
+	meta *ResponseMeta
+}
+
+// ContextWithResponseMeta returns a copy of the ctx and the ResponseMeta which is filled when the requests sent
+// with the returned context complete. For example:
+//
+//	ctx, meta := centraldogma.ContextWithResponseMeta(ctx)
+//	_, _, err := client.CreateProject(ctx, "foo")
+//	log.Printf("latency=%v, attempts=%v, location=%s", meta.Latency, meta.Attempts, meta.Header.Get("Location"))
+func ContextWithResponseMeta(ctx context.Context) (context.Context, *ResponseMeta) {
+	meta := &ResponseMeta{}
+	return context.WithValue(ctx, responseMetaKey{}, &responseMetaRecorder{meta: meta}), meta
+}
+
+func recordResponseMeta(ctx context.Context, req *http.Request, res *http.Response, err error, attempts int,
+	latency time.Duration) {
+	recorder, _ := ctx.Value(responseMetaKey{}).(*responseMetaRecorder)
+	if recorder == nil {
+		return
+	}
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+	meta := recorder.meta
+	meta.Latency += latency
+	meta.Attempts += attempts
+	meta.RequestID = req.Header.Get(RequestIDHeader)
+	endpoint := req.URL
+	if err == nil {
+		meta.Header = res.Header
+		meta.StatusCode = res.StatusCode
+		if res.Request != nil { // rewritten by the endpoint group or redirected
+			endpoint = res.Request.URL
+		}
+	} else {
+		meta.Header = nil
+		meta.StatusCode = UnknownHttpStatusCode
+		if urlErr, ok := err.(*url.Error); ok { // the URL of the last attempt
+			if u, parseErr := url.Parse(urlErr.URL); parseErr == nil {
+				endpoint = u
+			}
+		}
+	}
+	meta.Endpoint = endpoint.Scheme + "://" + endpoint.Host
+}
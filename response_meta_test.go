@@ -0,0 +1,97 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestContextWithResponseMeta(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/api/v1/projects/foo")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"foo"}`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, meta := ContextWithResponseMeta(ContextWithRequestID(context.Background(), "my-request"))
+	if _, _, err = c.CreateProject(ctx, "foo"); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	testStatusCode(t, meta.StatusCode, http.StatusCreated)
+	testString(t, meta.Header.Get("Location"), "/api/v1/projects/foo", "location")
+	testString(t, meta.Endpoint, server.URL, "endpoint")
+	testString(t, meta.RequestID, "my-request", "request id")
+	if meta.Attempts != 1 || meta.Latency <= 0 {
+		t.Errorf("attempts: %v, latency: %v, want 1 and a positive latency", meta.Attempts, meta.Latency)
+	}
+
+	// The latency and the attempts are accumulated.
+	latency := meta.Latency
+	if _, _, err = c.CreateProject(ctx, "foo"); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if meta.Attempts != 2 || meta.Latency <= latency {
+		t.Errorf("attempts: %v, latency: %v, want 2 and more than %v", meta.Attempts, meta.Latency, latency)
+	}
+}
+
+func TestContextWithResponseMeta_FailOver(t *testing.T) {
+	var healthy1, healthy2, hits1, hits2 int32 = 0, 1, 0, 0
+	replica1 := newReplica(t, &healthy1, &hits1)
+	defer replica1.Close()
+	replica2 := newReplica(t, &healthy2, &hits2)
+	defer replica2.Close()
+
+	c, err := NewClient(replica1.URL, WithTransport(http.DefaultTransport),
+		WithEndpoints(replica2.URL), WithHealthCheckInterval(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, meta := ContextWithResponseMeta(context.Background())
+	if _, _, err = c.ListProjects(ctx); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testStatusCode(t, meta.StatusCode, http.StatusOK)
+	testString(t, meta.Endpoint, replica2.URL, "endpoint")
+	if meta.Attempts != 2 {
+		t.Errorf("attempts: %v, want 2", meta.Attempts)
+	}
+
+	// The endpoint of the last attempt is reported even if the request fails.
+	replica2.Close()
+	ctx, meta = ContextWithResponseMeta(context.Background())
+	if _, _, err = c.ListProjects(ctx); err == nil {
+		t.Fatal("ListProjects should fail when all the replicas are down")
+	}
+	testStatusCode(t, meta.StatusCode, UnknownHttpStatusCode)
+	testString(t, meta.Endpoint, replica2.URL, "endpoint")
+	if meta.Header != nil {
+		t.Errorf("header: %v, want nil", meta.Header)
+	}
+}
@@ -0,0 +1,153 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Revision represents a revision of a repository. A positive revision is an absolute revision and a negative
+// revision is a relative revision from the latest revision, e.g. -1 is the latest revision and -2 is the
+// revision right before the latest revision. Zero is not a valid revision.
+// The Client APIs whose names end with Rev, e.g. GetFileRev, accept a Revision instead of the wire format:
+//
+//	entry, _, err := client.GetFileRev(ctx, "foo", "bar", centraldogma.Head, query)
+type Revision int64
+
+const (
+	// Head is the relative revision which denotes the latest revision.
+	Head Revision = -1
+	// Init is the absolute revision of the initial commit.
+	Init Revision = 1
+)
+
+// Rev returns the Revision of the specified revision number.
+func Rev(revision int64) Revision {
+	return Revision(revision)
+}
+
+// ParseRevision parses the wire format of a revision. An empty string is parsed as Head.
+func ParseRevision(revision string) (Revision, error) {
+	revision = strings.TrimSpace(revision)
+	if len(revision) == 0 || strings.EqualFold(revision, "head") {
+		return Head, nil
+	}
+
+	parsed, err := strconv.ParseInt(revision, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid revision: %q", revision)
+	}
+	if parsed == 0 {
+		return 0, fmt.Errorf("revision should not be 0")
+	}
+	return Revision(parsed), nil
+}
+
+// String returns the wire format of the Revision.
+func (r Revision) String() string {
+	return strconv.FormatInt(int64(r), 10)
+}
+
+// IsRelative returns whether the Revision is a relative revision.
+func (r Revision) IsRelative() bool {
+	return r < 0
+}
+
+// Forward returns the Revision which is n revisions after this Revision.
+func (r Revision) Forward(n int64) (Revision, error) {
+	forwarded := r + Revision(n)
+	if r.IsRelative() != forwarded.IsRelative() || forwarded == 0 {
+		return 0, fmt.Errorf("cannot forward the revision %v by %v", r, n)
+	}
+	return forwarded, nil
+}
+
+// Backward returns the Revision which is n revisions before this Revision.
+func (r Revision) Backward(n int64) (Revision, error) {
+	backwarded := r - Revision(n)
+	if r.IsRelative() != backwarded.IsRelative() || backwarded == 0 {
+		return 0, fmt.Errorf("cannot backward the revision %v by %v", r, n)
+	}
+	return backwarded, nil
+}
+
+// NormalizeRevisionRev is the same as NormalizeRevision except that it accepts a Revision.
+func (c *Client) NormalizeRevisionRev(ctx context.Context,
+	projectName, repoName string, revision Revision) (normalizedRev int64, httpStatusCode int, err error) {
+	return c.NormalizeRevision(ctx, projectName, repoName, revision.String())
+}
+
+// ListFilesRev is the same as ListFiles except that it accepts a Revision.
+func (c *Client) ListFilesRev(ctx context.Context, projectName, repoName string, revision Revision,
+	pathPattern string) (entries []*Entry, httpStatusCode int, err error) {
+	return c.ListFiles(ctx, projectName, repoName, revision.String(), pathPattern)
+}
+
+// GetFileRev is the same as GetFile except that it accepts a Revision.
+func (c *Client) GetFileRev(ctx context.Context, projectName, repoName string, revision Revision,
+	query *Query) (entry *Entry, httpStatusCode int, err error) {
+	return c.GetFile(ctx, projectName, repoName, revision.String(), query)
+}
+
+// GetFilesRev is the same as GetFiles except that it accepts a Revision.
+func (c *Client) GetFilesRev(ctx context.Context, projectName, repoName string, revision Revision,
+	pathPattern string) (entries []*Entry, httpStatusCode int, err error) {
+	return c.GetFiles(ctx, projectName, repoName, revision.String(), pathPattern)
+}
+
+// GetMergedEntryRev is the same as GetMergedEntry except that it accepts a Revision.
+func (c *Client) GetMergedEntryRev(ctx context.Context, projectName, repoName string, revision Revision,
+	mergeQuery *MergeQuery) (mergedEntry *MergedEntry, httpStatusCode int, err error) {
+	return c.GetMergedEntry(ctx, projectName, repoName, revision.String(), mergeQuery)
+}
+
+// GetHistoryRev is the same as GetHistory except that it accepts Revisions.
+func (c *Client) GetHistoryRev(ctx context.Context, projectName, repoName string, from, to Revision,
+	pathPattern string, maxCommits int) (commits []*Commit, httpStatusCode int, err error) {
+	return c.GetHistory(ctx, projectName, repoName, from.String(), to.String(), pathPattern, maxCommits)
+}
+
+// GetCommitRev is the same as GetCommit except that it accepts a Revision.
+func (c *Client) GetCommitRev(ctx context.Context, projectName, repoName string,
+	revision Revision) (commit *CommitDetail, httpStatusCode int, err error) {
+	return c.GetCommit(ctx, projectName, repoName, revision.String())
+}
+
+// GetDiffRev is the same as GetDiff except that it accepts Revisions.
+func (c *Client) GetDiffRev(ctx context.Context, projectName, repoName string, from, to Revision,
+	query *Query) (change *Change, httpStatusCode int, err error) {
+	return c.GetDiff(ctx, projectName, repoName, from.String(), to.String(), query)
+}
+
+// GetDiffsRev is the same as GetDiffs except that it accepts Revisions.
+func (c *Client) GetDiffsRev(ctx context.Context, projectName, repoName string, from, to Revision,
+	pathPattern string) (changes []*Change, httpStatusCode int, err error) {
+	return c.GetDiffs(ctx, projectName, repoName, from.String(), to.String(), pathPattern)
+}
+
+// PreviewDiffsRev is the same as PreviewDiffs except that it accepts a Revision.
+func (c *Client) PreviewDiffsRev(ctx context.Context, projectName, repoName string, baseRevision Revision,
+	changes []*Change) (diffs []*Change, httpStatusCode int, err error) {
+	return c.PreviewDiffs(ctx, projectName, repoName, baseRevision.String(), changes)
+}
+
+// PushRev is the same as Push except that it accepts a Revision.
+func (c *Client) PushRev(ctx context.Context, projectName, repoName string, baseRevision Revision,
+	commitMessage *CommitMessage, changes []*Change) (result *PushResult, httpStatusCode int, err error) {
+	return c.Push(ctx, projectName, repoName, baseRevision.String(), commitMessage, changes)
+}
@@ -0,0 +1,123 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// revisionCache caches the latest revision of the repositories so that the relative revisions are normalized
+// without sending a request. A cached revision expires after the ttl, is replaced as soon as a watch or
+// a push reveals a newer revision, and is removed when a push conflicts because it is stale.
+type revisionCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	lock  sync.Mutex
+	heads map[string]*cachedHead // keyed by project/repo
+}
+
+type cachedHead struct {
+	revision int64
+	storedAt time.Time
+}
+
+func newRevisionCache(ttl time.Duration) *revisionCache {
+	return &revisionCache{ttl: ttl, now: time.Now, heads: make(map[string]*cachedHead)}
+}
+
+func revisionCacheKey(projectName, repoName string) string {
+	return projectName + "/" + repoName
+}
+
+// head returns the cached latest revision of the repository.
+func (rc *revisionCache) head(projectName, repoName string) (int64, bool) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	key := revisionCacheKey(projectName, repoName)
+	cached, ok := rc.heads[key]
+	if !ok {
+		return 0, false
+	}
+	if rc.ttl > 0 && rc.now().Sub(cached.storedAt) > rc.ttl {
+		delete(rc.heads, key)
+		return 0, false
+	}
+	return cached.revision, true
+}
+
+// observe records the latest revision of the repository. An older revision than the cached one is ignored.
+func (rc *revisionCache) observe(projectName, repoName string, head int64) {
+	if head <= 0 {
+		return
+	}
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+
+	key := revisionCacheKey(projectName, repoName)
+	if cached, ok := rc.heads[key]; ok && cached.revision > head {
+		return
+	}
+	rc.heads[key] = &cachedHead{revision: head, storedAt: rc.now()}
+}
+
+// invalidate removes the cached latest revision of the repository.
+func (rc *revisionCache) invalidate(projectName, repoName string) {
+	rc.lock.Lock()
+	defer rc.lock.Unlock()
+	delete(rc.heads, revisionCacheKey(projectName, repoName))
+}
+
+// normalize converts the relative revision into the absolute one using the cached latest revision.
+// false is returned if the revision is not relative or the latest revision is not cached.
+func (rc *revisionCache) normalize(projectName, repoName string, revision Revision) (int64, bool) {
+	if !revision.IsRelative() {
+		return 0, false
+	}
+	head, ok := rc.head(projectName, repoName)
+	if !ok {
+		return 0, false
+	}
+	normalized := head + int64(revision) + 1
+	if normalized <= 0 {
+		return 0, false
+	}
+	return normalized, true
+}
+
+// observeRevision records the latest revision of the repository if the revision cache is enabled and
+// the revision is not from the server specified by ContextWithBaseURL.
+func (c *Client) observeRevision(ctx context.Context, projectName, repoName string, head int64) {
+	if _, ok := callBaseURLFromContext(ctx); ok {
+		return
+	}
+	if c.revisionCache != nil {
+		c.revisionCache.observe(projectName, repoName, head)
+	}
+}
+
+// invalidateRevision removes the cached latest revision of the repository if the revision cache is enabled and
+// the revision is not from the server specified by ContextWithBaseURL.
+func (c *Client) invalidateRevision(ctx context.Context, projectName, repoName string) {
+	if _, ok := callBaseURLFromContext(ctx); ok {
+		return
+	}
+	if c.revisionCache != nil {
+		c.revisionCache.invalidate(projectName, repoName)
+	}
+}
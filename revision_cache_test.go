@@ -0,0 +1,183 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRevisionCache(t *testing.T) {
+	now := time.Unix(0, 0)
+	cache := newRevisionCache(time.Minute)
+	cache.now = func() time.Time { return now }
+
+	if _, ok := cache.normalize("foo", "bar", Head); ok {
+		t.Errorf("normalize should fail when the head is not cached")
+	}
+
+	cache.observe("foo", "bar", 5)
+	cache.observe("foo", "bar", 3) // ignored
+	for _, tc := range []struct {
+		revision Revision
+		want     int64
+		ok       bool
+	}{
+		{Head, 5, true},
+		{-2, 4, true},
+		{-5, 1, true},
+		{-6, 0, false},
+		{3, 0, false},
+	} {
+		got, ok := cache.normalize("foo", "bar", tc.revision)
+		if got != tc.want || ok != tc.ok {
+			t.Errorf("normalize(%v) = (%v, %v), want (%v, %v)", tc.revision, got, ok, tc.want, tc.ok)
+		}
+	}
+	if _, ok := cache.normalize("foo", "baz", Head); ok {
+		t.Errorf("normalize should fail for another repository")
+	}
+
+	now = now.Add(2 * time.Minute)
+	if _, ok := cache.normalize("foo", "bar", Head); ok {
+		t.Errorf("normalize should fail when the cached head is expired")
+	}
+}
+
+func TestWithRevisionCache(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithRevisionCache(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	requests := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-2", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		fmt.Fprint(w, `{"revision":2}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		fmt.Fprint(w, `{"revision":4, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	for i := 0; i < 3; i++ {
+		normalized, httpStatusCode, err := c.NormalizeRevision(context.Background(), "foo", "bar", "-2")
+		if err != nil {
+			t.Fatal(err)
+		}
+		testStatusCode(t, httpStatusCode, http.StatusOK)
+		if normalized != 2 {
+			t.Errorf("NormalizeRevision returned %v, want %v", normalized, 2)
+		}
+	}
+	if requests != 1 {
+		t.Errorf("sent %v requests, want %v", requests, 1)
+	}
+
+	// The push reveals the new head.
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}
+	if _, _, err = c.Push(context.Background(), "foo", "bar", "-1", &CommitMessage{Summary: "Add a.json"},
+		changes); err != nil {
+		t.Fatal(err)
+	}
+	normalized, _, err := c.NormalizeRevision(context.Background(), "foo", "bar", "-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if normalized != 4 {
+		t.Errorf("NormalizeRevision returned %v, want %v", normalized, 4)
+	}
+	if requests != 1 {
+		t.Errorf("sent %v requests, want %v", requests, 1)
+	}
+}
+
+func TestWithRevisionCache_Conflict(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithRevisionCache(time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	head := 2
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"revision":%d}`, head)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if r.URL.Query().Get("revision") != fmt.Sprint(head) {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.ChangeConflictException",`+
+				`"message":"conflict"}`)
+			return
+		}
+		head++
+		fmt.Fprintf(w, `{"revision":%d, "pushedAt":"2017-05-22T00:00:00Z"}`, head)
+	})
+	ctx := context.Background()
+	normalizeHead := func(want int64) {
+		normalized, _, err := c.NormalizeRevision(ctx, "foo", "bar", "-1")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if normalized != want {
+			t.Errorf("NormalizeRevision returned %v, want %v", normalized, want)
+		}
+	}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}
+	transform := func(ctx context.Context, baseRevision Revision) ([]*Change, error) {
+		return changes, nil
+	}
+
+	// Another writer advances the head after it is cached.
+	normalizeHead(2)
+	head = 3
+	result, _, err := c.PushCAS(ctx, "foo", "bar", &CommitMessage{Summary: "Add a.json"}, transform, 0)
+	if err != nil {
+		t.Fatalf("PushCAS returned error: %v", err)
+	}
+	if result.Revision != 4 {
+		t.Errorf("PushCAS returned revision %v, want %v", result.Revision, 4)
+	}
+	normalizeHead(4)
+
+	head = 5
+	tx, _, err := c.BeginTransaction(ctx, "foo", "bar")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.BaseRevision() != 5 {
+		t.Errorf("BeginTransaction returned the base revision %v, want %v", tx.BaseRevision(), 5)
+	}
+
+	// The conflict evicts the stale head.
+	head = 6
+	_, _, err = c.Push(ctx, "foo", "bar", "5", &CommitMessage{Summary: "Add a.json"}, changes)
+	if !isChangeConflict(err) {
+		t.Fatalf("Push returned %v, want %v", err, ErrChangeConflict)
+	}
+	normalizeHead(6)
+}
@@ -0,0 +1,106 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestParseRevision(t *testing.T) {
+	var tests = []struct {
+		revision string
+		want     Revision
+		wantErr  bool
+	}{
+		{"", Head, false},
+		{"head", Head, false},
+		{"-1", Head, false},
+		{"1", Init, false},
+		{"42", Rev(42), false},
+		{"0", 0, true},
+		{"foo", 0, true},
+	}
+
+	for _, test := range tests {
+		got, err := ParseRevision(test.revision)
+		if (err != nil) != test.wantErr {
+			t.Errorf("ParseRevision(%q) returned error %v, wantErr %v", test.revision, err, test.wantErr)
+		}
+		if got != test.want {
+			t.Errorf("ParseRevision(%q) returned %v, want %v", test.revision, got, test.want)
+		}
+	}
+}
+
+func TestRevision_ForwardAndBackward(t *testing.T) {
+	testString(t, Head.String(), "-1", "Head")
+	testString(t, Rev(42).String(), "42", "Rev(42)")
+
+	if rev, _ := Head.Backward(2); rev != Rev(-3) {
+		t.Errorf("Head.Backward(2) returned %v, want -3", rev)
+	}
+	if rev, _ := Rev(3).Backward(2); rev != Init {
+		t.Errorf("Rev(3).Backward(2) returned %v, want 1", rev)
+	}
+	if _, err := Init.Backward(1); err == nil {
+		t.Errorf("Init.Backward(1) should fail")
+	}
+	if _, err := Head.Forward(1); err == nil {
+		t.Errorf("Head.Forward(1) should fail")
+	}
+	if rev, _ := Init.Forward(2); rev != Rev(3) {
+		t.Errorf("Init.Forward(2) returned %v, want 3", rev)
+	}
+}
+
+func TestRevisionVariants(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		testURLQuery(t, r, "revision", "-2")
+		fmt.Fprint(w, `{"path":"/a.json", "type":"JSON", "content":{"a":"b"}}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/compare", func(w http.ResponseWriter, r *http.Request) {
+		testURLQuery(t, r, "from", "1")
+		testURLQuery(t, r, "to", "-1")
+		fmt.Fprint(w, `[]`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURLQuery(t, r, "revision", "2")
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	if _, _, err := c.GetFileRev(context.Background(), "foo", "bar", Head-1, query); err != nil {
+		t.Errorf("GetFileRev returned error: %v", err)
+	}
+	if _, _, err := c.GetDiffsRev(context.Background(), "foo", "bar", Init, Head, "/**"); err != nil {
+		t.Errorf("GetDiffsRev returned error: %v", err)
+	}
+	commitMessage := &CommitMessage{Summary: "Add a.json"}
+	changes := []*Change{{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}}
+	result, _, err := c.PushRev(context.Background(), "foo", "bar", Rev(2), commitMessage, changes)
+	if err != nil {
+		t.Fatalf("PushRev returned error: %v", err)
+	}
+	if result.Revision != 3 {
+		t.Errorf("PushRev returned revision %v, want %v", result.Revision, 3)
+	}
+}
@@ -0,0 +1,197 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// SecretRule detects a secret such as a credential or a private key in the content of a change.
+type SecretRule struct {
+	Name    string
+	Pattern *regexp.Regexp
+
+	// MinEntropy is the minimum Shannon entropy in bits per character of the matched string, so that
+	// a placeholder such as "changeme" is not regarded as a secret. The first submatch is measured if
+	// the pattern has one. The entropy is not checked if MinEntropy is 0.
+	MinEntropy float64
+}
+
+// DefaultSecretRules returns the rules which detect the well-known credentials and private keys.
+func DefaultSecretRules() []SecretRule {
+	return []SecretRule{
+		{Name: "private-key", Pattern: regexp.MustCompile(`-----BEGIN (?:[A-Z0-9]+ )*PRIVATE KEY(?: BLOCK)?-----`)},
+		{Name: "aws-access-key-id", Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+		{Name: "github-token", Pattern: regexp.MustCompile(`\bgh[pousr]_[0-9A-Za-z]{36,}\b`)},
+		{Name: "slack-token", Pattern: regexp.MustCompile(`\bxox[abprs]-[0-9A-Za-z-]{10,}`)},
+		{Name: "jwt", Pattern: regexp.MustCompile(`\beyJ[0-9A-Za-z_-]{10,}\.eyJ[0-9A-Za-z_-]{10,}\.[0-9A-Za-z_-]{10,}`)},
+		{
+			Name: "generic-secret",
+			Pattern: regexp.MustCompile(`(?i)(?:password|passwd|secret|token|api[_-]?key|access[_-]?key)` +
+				`["']?\s*[:=]\s*["']?([^\s"'\\,;]{16,})`),
+			MinEntropy: 3.5,
+		},
+	}
+}
+
+// SecretScanner is a PushValidator which rejects the changes whose content looks like a secret, so that
+// a credential is not pushed to the server by mistake. For example:
+//
+//	scanner := centraldogma.NewSecretScanner().
+//	    Allow("/tests/**", "generic-secret")
+//	client, err := centraldogma.NewClient("https://localhost:443",
+//	    centraldogma.WithPushValidator("/**", scanner))
+//
+// The content of the UpsertJSON, UpsertText and ApplyJSONPatch changes and the added lines of the
+// ApplyTextPatch changes are scanned. A push is rejected with a PushValidationError whose failures have
+// a SecretError.
+type SecretScanner struct {
+	rules      []SecretRule
+	allowances []secretAllowance
+}
+
+type secretAllowance struct {
+	pathPattern string
+	ruleNames   []string
+}
+
+// NewSecretScanner returns a SecretScanner which scans with the rules. DefaultSecretRules is used if no rule is
+// specified.
+func NewSecretScanner(rules ...SecretRule) *SecretScanner {
+	if len(rules) == 0 {
+		rules = DefaultSecretRules()
+	}
+	return &SecretScanner{rules: rules}
+}
+
+// Allow skips the rules of the names for the files which match the path pattern, e.g. the test fixtures
+// which have fake credentials. All the rules are skipped if no name is specified. The path pattern is
+// a variant of glob as the one of WithPushValidator. It must be called before the scanner is registered.
+func (s *SecretScanner) Allow(pathPattern string, ruleNames ...string) *SecretScanner {
+	s.allowances = append(s.allowances, secretAllowance{pathPattern: pathPattern, ruleNames: ruleNames})
+	return s
+}
+
+// SecretError is returned when the content of a change matches the SecretRules. It does not contain
+// the matched content, so that the secret is not leaked to the logs.
+type SecretError struct {
+	Rules []string
+}
+
+func (e *SecretError) Error() string {
+	return fmt.Sprintf("content looks like a secret (rules: %s)", strings.Join(e.Rules, ", "))
+}
+
+// Validate implements PushValidator.
+func (s *SecretScanner) Validate(change *Change) error {
+	content, err := scannedContent(change)
+	if err != nil || len(content) == 0 {
+		return err
+	}
+
+	var matched []string
+	for i := range s.rules {
+		rule := &s.rules[i]
+		if s.allowed(change.Path, rule.Name) {
+			continue
+		}
+		if rule.matches(content) {
+			matched = append(matched, rule.Name)
+		}
+	}
+	if len(matched) != 0 {
+		return &SecretError{Rules: matched}
+	}
+	return nil
+}
+
+func (s *SecretScanner) allowed(filePath, ruleName string) bool {
+	for _, allowance := range s.allowances {
+		if !matchPathPattern(allowance.pathPattern, filePath) {
+			continue
+		}
+		if len(allowance.ruleNames) == 0 {
+			return true
+		}
+		for _, name := range allowance.ruleNames {
+			if name == ruleName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (r *SecretRule) matches(content string) bool {
+	if r.MinEntropy <= 0 {
+		return r.Pattern.MatchString(content)
+	}
+	for _, loc := range r.Pattern.FindAllStringSubmatchIndex(content, -1) {
+		start, end := loc[0], loc[1]
+		if len(loc) > 2 && loc[2] >= 0 {
+			start, end = loc[2], loc[3]
+		}
+		if shannonEntropy(content[start:end]) >= r.MinEntropy {
+			return true
+		}
+	}
+	return false
+}
+
+// scannedContent returns the content of the change which is scanned. Only the added lines of a text patch
+// are scanned, so that a secret can be removed.
+func scannedContent(change *Change) (string, error) {
+	switch change.Type {
+	case UpsertJSON, ApplyJSONPatch:
+		content, err := marshalJSONContent(change.Content)
+		if err != nil {
+			return "", err
+		}
+		return string(content), nil
+	case UpsertText:
+		return fmt.Sprint(change.Content), nil
+	case ApplyTextPatch:
+		var added []string
+		for _, line := range strings.Split(fmt.Sprint(change.Content), "\n") {
+			if strings.HasPrefix(line, "+") && !strings.HasPrefix(line, "+++") {
+				added = append(added, line[1:])
+			}
+		}
+		return strings.Join(added, "\n"), nil
+	default:
+		return "", nil
+	}
+}
+
+func shannonEntropy(s string) float64 {
+	if len(s) == 0 {
+		return 0
+	}
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+	entropy := 0.0
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+)
+
+const (
+	pathL7Check = "monitor/l7check"
+	pathStatus  = defaultPathPrefix + "status"
+	pathVersion = "monitor/version"
+)
+
+type serverService service
+
+// ServerStatus represents the status of the Central Dogma server.
+type ServerStatus struct {
+	Writable    bool `json:"writable"`
+	Replicating bool `json:"replicating"`
+}
+
+// ServerVersion represents the version information of the Central Dogma server.
+type ServerVersion struct {
+	Version          string `json:"version"`
+	ShortCommitHash  string `json:"shortCommitHash,omitempty"`
+	LongCommitHash   string `json:"longCommitHash,omitempty"`
+	CommitTimeMillis int64  `json:"commitTimeMillis,omitempty"`
+	RepositoryStatus string `json:"repositoryStatus,omitempty"`
+}
+
+func (s *serverService) healthy(ctx context.Context) (bool, int, error) {
+	// build relative url
+	u, err := url.Parse(pathL7Check)
+	if err != nil {
+		return false, UnknownHttpStatusCode, err
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return false, UnknownHttpStatusCode, err
+	}
+
+	httpStatusCode, err := s.client.do(ctx, req, nil, false)
+	if err != nil {
+		if httpStatusCode == http.StatusServiceUnavailable {
+			// The server is up but not healthy.
+			return false, httpStatusCode, nil
+		}
+		return false, httpStatusCode, err
+	}
+	return true, httpStatusCode, nil
+}
+
+func (s *serverService) status(ctx context.Context) (*ServerStatus, int, error) {
+	// build relative url
+	u, err := url.Parse(pathStatus)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	status := new(ServerStatus)
+	httpStatusCode, err := s.client.do(ctx, req, status, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return status, httpStatusCode, nil
+}
+
+func (s *serverService) version(ctx context.Context) (*ServerVersion, int, error) {
+	// build relative url
+	u, err := url.Parse(pathVersion)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	req, err := s.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	version := new(ServerVersion)
+	httpStatusCode, err := s.client.do(ctx, req, version, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return version, httpStatusCode, nil
+}
@@ -0,0 +1,80 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestServerHealthy(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	healthy := true
+	mux.HandleFunc("/monitor/l7check", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+	})
+
+	got, _, err := c.ServerHealthy(context.Background())
+	if !got || err != nil {
+		t.Errorf("ServerHealthy returned %v, %v, want true, nil", got, err)
+	}
+
+	healthy = false
+	got, httpStatusCode, err := c.ServerHealthy(context.Background())
+	if got || err != nil {
+		t.Errorf("ServerHealthy returned %v, %v, want false, nil", got, err)
+	}
+	testStatusCode(t, httpStatusCode, http.StatusServiceUnavailable)
+}
+
+func TestServerStatus(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/status", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"writable":true, "replicating":false}`)
+	})
+
+	status, _, _ := c.ServerStatus(context.Background())
+	want := &ServerStatus{Writable: true}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("ServerStatus returned %+v, want %+v", status, want)
+	}
+}
+
+func TestServerVersion(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/monitor/version", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"version":"0.61.0", "shortCommitHash":"a9367c9"}`)
+	})
+
+	version, _, _ := c.ServerVersion(context.Background())
+	want := &ServerVersion{Version: "0.61.0", ShortCommitHash: "a9367c9"}
+	if !reflect.DeepEqual(version, want) {
+		t.Errorf("ServerVersion returned %+v, want %+v", version, want)
+	}
+}
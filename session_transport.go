@@ -0,0 +1,108 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"sync"
+
+	"golang.org/x/oauth2"
+)
+
+// sessionTransport logs in to the server with the username and password and attaches the session token to
+// every request. When the session expires, it logs in again and retries the failed request once.
+type sessionTransport struct {
+	base     http.RoundTripper
+	config   *oauth2.Config
+	username string
+	password string
+	logger   Logger
+
+	lock  sync.Mutex
+	token *oauth2.Token
+}
+
+func newSessionTransport(baseURL, username, password string, base http.RoundTripper,
+	logger Logger) *sessionTransport {
+	return &sessionTransport{
+		base: base,
+		config: &oauth2.Config{Endpoint: oauth2.Endpoint{
+			TokenURL:  baseURL + pathLogin,
+			AuthStyle: oauth2.AuthStyleInParams,
+		}},
+		username: username,
+		password: password,
+		logger:   logger,
+	}
+}
+
+func (t *sessionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.currentToken(req.Context(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := t.base.RoundTrip(withAccessToken(req, token))
+	if err != nil || res.StatusCode != http.StatusUnauthorized {
+		return res, err
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return res, nil // cannot send the body again
+	}
+
+	// The session has expired.
+	drainupAndCloseResponseBody(res.Body)
+	if token, err = t.currentToken(req.Context(), token); err != nil {
+		return nil, err
+	}
+	retry := withAccessToken(req, token)
+	if req.GetBody != nil {
+		if retry.Body, err = req.GetBody(); err != nil {
+			return nil, err
+		}
+	}
+	return t.base.RoundTrip(retry)
+}
+
+// currentToken returns the current session token. It logs in again if there is no valid token or the current
+// token is the same as the expired one.
+func (t *sessionTransport) currentToken(ctx context.Context, expired *oauth2.Token) (*oauth2.Token, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.token != nil && t.token != expired && t.token.Valid() {
+		return t.token, nil
+	}
+
+	if t.token == nil {
+		t.logger.Debugf("Logging in as %s", t.username)
+	} else {
+		t.logger.Debugf("The session has expired. Logging in again as %s", t.username)
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, &http.Client{Transport: t.base})
+	token, err := t.config.PasswordCredentialsToken(ctx, t.username, t.password)
+	if err != nil {
+		return nil, err
+	}
+	t.token = token
+	return token, nil
+}
+
+// withAccessToken returns a shallow copy of the request with the authorization header of the token.
+func withAccessToken(req *http.Request, token *oauth2.Token) *http.Request {
+	clone := cloneRequest(req)
+	token.SetAuthHeader(clone)
+	return clone
+}
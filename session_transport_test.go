@@ -0,0 +1,153 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestWithPasswordLogin(t *testing.T) {
+	var logins, expired int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/login", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		if r.FormValue("username") != "foo" || r.FormValue("password") != "bar" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		n := atomic.AddInt32(&logins, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token%d","token_type":"Bearer","expires_in":3600}`, n)
+	})
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&expired) == 1 && r.Header.Get("Authorization") == "Bearer token1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Method == http.MethodPost {
+			body, _ := ioutil.ReadAll(r.Body)
+			testString(t, string(body), `{"name":"foo"}`+"\n", "body")
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"name":"foo"}`)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithPasswordLogin("foo", "bar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	if n := atomic.LoadInt32(&logins); n != 1 {
+		t.Errorf("logins: %v, want 1", n)
+	}
+
+	// The session expires, so the client logs in again and retries the request with its body.
+	atomic.StoreInt32(&expired, 1)
+	if _, _, err = c.CreateProject(context.Background(), "foo"); err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	if n := atomic.LoadInt32(&logins); n != 2 {
+		t.Errorf("logins: %v, want 2", n)
+	}
+}
+
+func TestWithPasswordLogin_ConcurrentExpiry(t *testing.T) {
+	var logins, expired int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/login", func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&logins, 1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token%d","token_type":"Bearer","expires_in":3600}`, n)
+	})
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&expired) == 1 && r.Header.Get("Authorization") == "Bearer token1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithPasswordLogin("foo", "bar"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+
+	// All the requests fail with the expired session at the same time, but the client logs in only once.
+	atomic.StoreInt32(&expired, 1)
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, _, err := c.ListProjects(context.Background()); err != nil {
+				t.Errorf("ListProjects returned error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+	if n := atomic.LoadInt32(&logins); n != 2 {
+		t.Errorf("logins: %v, want 2", n)
+	}
+}
+
+func TestWithPasswordLogin_WrongPassword(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/login", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithPasswordLogin("foo", "baz"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err == nil {
+		t.Errorf("ListProjects should fail with a wrong password")
+	}
+}
+
+func TestWithPasswordLogin_Conflict(t *testing.T) {
+	if _, err := NewClient("localhost:36462", WithPasswordLogin("foo", "bar"),
+		WithToken("anonymous")); err != ErrPasswordLoginConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrPasswordLoginConflict)
+	}
+	if _, err := NewClient("localhost:36462", WithPasswordLogin("foo", "bar"),
+		WithHTTPClient(http.DefaultClient)); err != ErrHTTPClientConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrHTTPClientConflict)
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+)
+
+// TokenProvider provides the token which is attached to every request using the authorization header.
+// It is called for every request, so it should cache the token if obtaining it is expensive.
+type TokenProvider interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenProviderFunc is an adapter to allow the use of an ordinary function as a TokenProvider.
+type TokenProviderFunc func(ctx context.Context) (string, error)
+
+// Token calls f(ctx).
+func (f TokenProviderFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+type staticTokenProvider string
+
+// NewStaticTokenProvider returns a TokenProvider which always provides the token.
+func NewStaticTokenProvider(token string) TokenProvider {
+	return staticTokenProvider(token)
+}
+
+func (p staticTokenProvider) Token(context.Context) (string, error) {
+	return string(p), nil
+}
+
+type envTokenProvider string
+
+// NewEnvTokenProvider returns a TokenProvider which provides the value of the environment variable. The variable
+// is looked up for every request, so the changes of the variable are picked up.
+func NewEnvTokenProvider(name string) TokenProvider {
+	return envTokenProvider(name)
+}
+
+func (p envTokenProvider) Token(context.Context) (string, error) {
+	token := strings.TrimSpace(os.Getenv(string(p)))
+	if len(token) == 0 {
+		return "", fmt.Errorf("environment variable %s is not set", string(p))
+	}
+	return token, nil
+}
+
+type fileTokenProvider struct {
+	filename string
+	logger   Logger
+
+	lock    sync.Mutex
+	modTime time.Time
+	size    int64
+	token   string
+}
+
+// NewFileTokenProvider returns a TokenProvider which provides the content of the file without the leading and
+// trailing white spaces. The file is read again when its modification time or size changes, so that the tokens
+// which are rotated by an external agent are picked up.
+func NewFileTokenProvider(filename string) TokenProvider {
+	return &fileTokenProvider{filename: filename, logger: log}
+}
+
+func (p *fileTokenProvider) Token(context.Context) (string, error) {
+	info, err := os.Stat(p.filename)
+	if err != nil {
+		return "", err
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if len(p.token) != 0 && info.ModTime().Equal(p.modTime) && info.Size() == p.size {
+		return p.token, nil
+	}
+
+	content, err := ioutil.ReadFile(p.filename)
+	if err != nil {
+		return "", err
+	}
+	token := strings.TrimSpace(string(content))
+	if len(token) == 0 {
+		return "", fmt.Errorf("token file %s is empty", p.filename)
+	}
+	if len(p.token) != 0 && token != p.token {
+		p.logger.Debugf("Reloaded the token from %s", p.filename)
+	}
+	p.modTime = info.ModTime()
+	p.size = info.Size()
+	p.token = token
+	return token, nil
+}
+
+// withTokenProviderLogger returns the TokenProvider which logs with the logger if the provider is the one
+// returned by NewFileTokenProvider, so that the reloads are logged with the logger of the client.
+// The other providers are returned as they are.
+func withTokenProviderLogger(provider TokenProvider, logger Logger) TokenProvider {
+	if p, ok := provider.(*fileTokenProvider); ok {
+		return &fileTokenProvider{filename: p.filename, logger: logger}
+	}
+	return provider
+}
+
+// tokenProviderTransport attaches the token provided by the TokenProvider to every request.
+type tokenProviderTransport struct {
+	base     http.RoundTripper
+	provider TokenProvider
+}
+
+func (t *tokenProviderTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	token, err := t.provider.Token(req.Context())
+	if err != nil {
+		// A RoundTripper must always close the body, including on errors.
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return t.base.RoundTrip(withAccessToken(req, &oauth2.Token{AccessToken: token, TokenType: "Bearer"}))
+}
@@ -0,0 +1,178 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileTokenProvider(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "token")
+	writeFile(t, filename, "token1\n")
+
+	p := NewFileTokenProvider(filename)
+	token, err := p.Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testString(t, token, "token1", "token")
+
+	// The rotated token is picked up.
+	writeFile(t, filename, "rotated-token2\n")
+	future := time.Now().Add(time.Minute)
+	if err = os.Chtimes(filename, future, future); err != nil {
+		t.Fatal(err)
+	}
+	if token, err = p.Token(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	testString(t, token, "rotated-token2", "token")
+
+	writeFile(t, filename, "\n")
+	if _, err = NewFileTokenProvider(filename).Token(context.Background()); err == nil {
+		t.Errorf("Token should fail with an empty file")
+	}
+}
+
+func TestEnvTokenProvider(t *testing.T) {
+	const name = "CENTRALDOGMA_TEST_TOKEN"
+	os.Setenv(name, "token1")
+	defer os.Unsetenv(name)
+
+	token, err := NewEnvTokenProvider(name).Token(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	testString(t, token, "token1", "token")
+
+	os.Unsetenv(name)
+	if _, err = NewEnvTokenProvider(name).Token(context.Background()); err == nil {
+		t.Errorf("Token should fail if the variable is not set")
+	}
+}
+
+func TestWithTokenProvider(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "Authorization", "Bearer token1")
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	calls := 0
+	provider := TokenProviderFunc(func(ctx context.Context) (string, error) {
+		calls++
+		return "token1", nil
+	})
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithTokenProvider(provider))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls: %v, want 1", calls)
+	}
+
+	if _, err = NewClient(server.URL, WithTokenProvider(NewStaticTokenProvider("token1")),
+		WithToken("anonymous")); err != ErrTokenProviderConflict {
+		t.Errorf("NewClient returned %v, want %v", err, ErrTokenProviderConflict)
+	}
+}
+
+func TestWithTokenProvider_Logger(t *testing.T) {
+	dir, err := ioutil.TempDir("", "token")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "token")
+	writeFile(t, filename, "token1\n")
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"foo"}]`)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	logger := &testLogger{}
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport),
+		WithTokenProvider(NewFileTokenProvider(filename)), WithLogger(logger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	writeFile(t, filename, "token2 \n")
+	if _, _, err = c.ListProjects(context.Background()); err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+
+	logger.lock.Lock()
+	defer logger.lock.Unlock()
+	for _, message := range logger.messages {
+		if message == "Reloaded the token from "+filename {
+			return
+		}
+	}
+	t.Errorf("messages: %q, want the reload of %s", logger.messages, filename)
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (r *closeRecorder) Close() error {
+	r.closed = true
+	return nil
+}
+
+func TestTokenProviderTransport_ClosesBodyOnError(t *testing.T) {
+	transport := &tokenProviderTransport{
+		base: http.DefaultTransport,
+		provider: TokenProviderFunc(func(ctx context.Context) (string, error) {
+			return "", errors.New("no token")
+		}),
+	}
+	body := &closeRecorder{Reader: strings.NewReader("{}")}
+	req, _ := http.NewRequest(http.MethodPost, "http://localhost/", body)
+	if _, err := transport.RoundTrip(req); err == nil {
+		t.Error("RoundTrip returned no error")
+	}
+	if !body.closed {
+		t.Error("the request body was not closed")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+)
+
+type tokenService service
+
+// Token represents an application token in the Central Dogma server.
+type Token struct {
+	AppID        string            `json:"appId"`
+	Secret       string            `json:"secret,omitempty"` // only available when the token is created.
+	IsAdmin      bool              `json:"admin"`
+	Creation     *UserAndTimestamp `json:"creation,omitempty"`
+	Deactivation *UserAndTimestamp `json:"deactivation,omitempty"`
+	Deletion     *UserAndTimestamp `json:"deletion,omitempty"`
+}
+
+// IsActive returns whether the token is active.
+func (t *Token) IsActive() bool {
+	return t.Deactivation == nil && t.Deletion == nil
+}
+
+// UserAndTimestamp represents who did an action at when.
+type UserAndTimestamp struct {
+	User      string `json:"user"`
+	Timestamp string `json:"timestamp"`
+}
+
+func (t *tokenService) create(ctx context.Context, appID string, isAdmin bool) (*Token, int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		tokens,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	form := url.Values{}
+	form.Set("appId", appID)
+	form.Set("isAdmin", strconv.FormatBool(isAdmin))
+	req, err := t.client.newRequest(http.MethodPost, u, form.Encode())
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	token := new(Token)
+	httpStatusCode, err := t.client.do(ctx, req, token, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return token, httpStatusCode, nil
+}
+
+func (t *tokenService) list(ctx context.Context) ([]*Token, int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		tokens,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	req, err := t.client.newRequest(http.MethodGet, u, nil)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	var tokens []*Token
+	httpStatusCode, err := t.client.do(ctx, req, &tokens, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return tokens, httpStatusCode, nil
+}
+
+func (t *tokenService) updateStatus(ctx context.Context, appID, status string) (*Token, int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		tokens, appID,
+	))
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	req, err := t.client.newRequest(http.MethodPatch, u,
+		`[{"op":"replace", "path":"/status", "value":"`+status+`"}]`)
+	if err != nil {
+		return nil, UnknownHttpStatusCode, err
+	}
+
+	token := new(Token)
+	httpStatusCode, err := t.client.do(ctx, req, token, false)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return token, httpStatusCode, nil
+}
+
+func (t *tokenService) activate(ctx context.Context, appID string) (*Token, int, error) {
+	return t.updateStatus(ctx, appID, "active")
+}
+
+func (t *tokenService) deactivate(ctx context.Context, appID string) (*Token, int, error) {
+	return t.updateStatus(ctx, appID, "inactive")
+}
+
+func (t *tokenService) remove(ctx context.Context, appID string) (int, error) {
+	// build relative url
+	u, err := url.Parse(path.Join(
+		defaultPathPrefix,
+		tokens, appID,
+	))
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+
+	req, err := t.client.newRequest(http.MethodDelete, u, nil)
+	if err != nil {
+		return UnknownHttpStatusCode, err
+	}
+
+	httpStatusCode, err := t.client.do(ctx, req, nil, false)
+	if err != nil {
+		return httpStatusCode, err
+	}
+	return httpStatusCode, nil
+}
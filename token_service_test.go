@@ -0,0 +1,98 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestCreateToken(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testHeader(t, r, "Content-Type", "application/x-www-form-urlencoded")
+		testBody(t, r, "appId=ci-bot&isAdmin=false")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"appId":"ci-bot", "secret":"appToken-secret", "admin":false,
+"creation":{"user":"minux@m.x", "timestamp":"2017-05-22T00:00:00Z"}}`)
+	})
+
+	token, httpStatusCode, _ := c.CreateToken(context.Background(), "ci-bot", false)
+	testStatusCode(t, httpStatusCode, 201)
+
+	want := &Token{AppID: "ci-bot", Secret: "appToken-secret",
+		Creation: &UserAndTimestamp{User: "minux@m.x", Timestamp: "2017-05-22T00:00:00Z"}}
+	if !reflect.DeepEqual(token, want) {
+		t.Errorf("CreateToken returned %+v, want %+v", token, want)
+	}
+}
+
+func TestListTokens(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `[{"appId":"ci-bot", "admin":false},
+{"appId":"admin-bot", "admin":true, "deactivation":{"user":"minux@m.x", "timestamp":"2017-05-22T00:00:00Z"}}]`)
+	})
+
+	tokens, _, _ := c.ListTokens(context.Background())
+	want := []*Token{{AppID: "ci-bot"}, {AppID: "admin-bot", IsAdmin: true,
+		Deactivation: &UserAndTimestamp{User: "minux@m.x", Timestamp: "2017-05-22T00:00:00Z"}}}
+	if !reflect.DeepEqual(tokens, want) {
+		t.Errorf("ListTokens returned %+v, want %+v", tokens, want)
+	}
+	if !tokens[0].IsActive() || tokens[1].IsActive() {
+		t.Errorf("IsActive returned %v and %v, want true and false", tokens[0].IsActive(), tokens[1].IsActive())
+	}
+}
+
+func TestDeactivateToken(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/tokens/ci-bot", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPatch)
+		testHeader(t, r, "Content-Type", "application/json-patch+json")
+		testBody(t, r, `[{"op":"replace", "path":"/status", "value":"inactive"}]`)
+		fmt.Fprint(w, `{"appId":"ci-bot", "admin":false,
+"deactivation":{"user":"minux@m.x", "timestamp":"2017-05-22T00:00:00Z"}}`)
+	})
+
+	token, _, _ := c.DeactivateToken(context.Background(), "ci-bot")
+	if token.IsActive() {
+		t.Errorf("DeactivateToken returned an active token: %+v", token)
+	}
+}
+
+func TestDeleteToken(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/tokens/ci-bot", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	httpStatusCode, _ := c.DeleteToken(context.Background(), "ci-bot")
+	testStatusCode(t, httpStatusCode, 204)
+}
@@ -0,0 +1,60 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/BurntSushi/toml"
+)
+
+// NewUpsertTOML returns a Change which adds or replaces the JSON file at the path with the TOML content
+// converted into JSON, so that the changes of the content can be compared structurally. The date and time
+// values are converted into strings. The content can be converted back into TOML using Entry.TOML or
+// decoded using Entry.UnmarshalTo.
+func NewUpsertTOML(path string, tomlContent []byte) (*Change, error) {
+	if err := validateJSONPath(path); err != nil {
+		return nil, err
+	}
+	var value map[string]interface{}
+	if _, err := toml.Decode(string(tomlContent), &value); err != nil {
+		return nil, fmt.Errorf("failed to convert the TOML content into JSON (path: %v): %v", path, err)
+	}
+	return NewUpsertJSON(path, value)
+}
+
+// TOML returns the content of the JSON entry converted into TOML. The keys are sorted alphabetically and the
+// null values are omitted. It fails if the entry is not a JSON entry or its content is not a JSON object.
+func (c *Entry) TOML() ([]byte, error) {
+	raw, err := c.RawJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	var value map[string]interface{}
+	if err = dec.Decode(&value); err != nil {
+		return nil, fmt.Errorf("the content is not a JSON object (path: %v): %v", c.Path, err)
+	}
+
+	var buf bytes.Buffer
+	if err = toml.NewEncoder(&buf).Encode(value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
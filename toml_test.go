@@ -0,0 +1,69 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewUpsertTOML(t *testing.T) {
+	tomlContent := `
+title = "example"
+released = 2026-01-02T03:04:05Z
+
+[server]
+port = 8080
+ratio = 0.5
+hosts = ["a", "b"]
+`
+	change, err := NewUpsertTOML("/a.json", []byte(tomlContent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"released":"2026-01-02T03:04:05Z","server":{"hosts":["a","b"],"port":8080,"ratio":0.5},` +
+		`"title":"example"}`
+	if got := string(change.Content.(json.RawMessage)); got != want {
+		t.Errorf("content: %v, want %v", got, want)
+	}
+
+	if _, err = NewUpsertTOML("/a.json", []byte("a = ")); err == nil {
+		t.Error("NewUpsertTOML should fail for an invalid TOML")
+	}
+}
+
+func TestEntry_TOML(t *testing.T) {
+	entry := &Entry{Path: "/a.json", Type: JSON,
+		Content: EntryContent(`{"title":"example","server":{"port":8080,"ratio":0.5,"hosts":["a","b"]},"none":null}`)}
+	got, err := entry.TOML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `title = "example"
+
+[server]
+  hosts = ["a", "b"]
+  port = 8080
+  ratio = 0.5
+`
+	if string(got) != want {
+		t.Errorf("TOML returned %q, want %q", got, want)
+	}
+
+	entry.Content = EntryContent(`[1, 2]`)
+	if _, err = entry.TOML(); err == nil {
+		t.Error("TOML should fail for a non-object content")
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+// Tracer traces the requests sent by a Client. See the go.linecorp.com/centraldogma/contrib/otelcentraldogma
+// package for the OpenTelemetry integration.
+type Tracer interface {
+	// Start is invoked before the request is sent. The request is sent with the returned context and its
+	// header can be modified to propagate the trace context. The returned func is invoked when the response
+	// headers are received or the request fails.
+	Start(ctx context.Context, req *http.Request, info *RequestInfo) (context.Context, func(statusCode int, err error))
+}
+
+// RequestInfo describes a request sent by a Client.
+type RequestInfo struct {
+	// Name is the name of the API, e.g. "GetFile" or "Push". The Operation is used if unknown.
+	Name string
+	// Operation is the method and the path template of the request.
+	// See Instrumentation for the details.
+	Operation   string
+	ProjectName string
+	RepoName    string
+	// Path is the path or the path pattern of the files. It is empty if the request is not about files.
+	Path string
+}
+
+var apiNames = map[string]string{
+	"GET /api/v1/projects":                                  "ListProjects",
+	"POST /api/v1/projects":                                 "CreateProject",
+	"DELETE /api/v1/projects/{}":                            "RemoveProject",
+	"PATCH /api/v1/projects/{}":                             "UnremoveProject",
+	"GET /api/v1/projects/{}/repos":                         "ListRepositories",
+	"POST /api/v1/projects/{}/repos":                        "CreateRepository",
+	"DELETE /api/v1/projects/{}/repos/{}":                   "RemoveRepository",
+	"PATCH /api/v1/projects/{}/repos/{}":                    "UnremoveRepository",
+	"GET /api/v1/projects/{}/repos/{}/revision/{}":          "NormalizeRevision",
+	"GET /api/v1/projects/{}/repos/{}/list":                 "ListFiles",
+	"GET /api/v1/projects/{}/repos/{}/contents":             "GetFile",
+	"POST /api/v1/projects/{}/repos/{}/contents":            "Push",
+	"WATCH /api/v1/projects/{}/repos/{}/contents":           "Watch",
+	"GET /api/v1/projects/{}/repos/{}/commits/{}":           "GetHistory",
+	"GET /api/v1/projects/{}/repos/{}/compare":              "GetDiff",
+	"GET /api/v1/projects/{}/repos/{}/merge":                "GetMergedEntry",
+	"POST /api/v1/projects/{}/repos/{}/preview":             "PreviewDiffs",
+	"GET /api/v1/projects/{}/repos/{}/contents?pathPattern": "GetFiles",
+}
+
+func newRequestInfo(req *http.Request, watchRequest bool) *RequestInfo {
+	info := &RequestInfo{Operation: operationName(req, watchRequest)}
+
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	for i := 0; i+1 < len(segments); i++ {
+		switch segments[i] {
+		case projects:
+			info.ProjectName = segments[i+1]
+		case repos:
+			info.RepoName = segments[i+1]
+		case contents, actionList:
+			info.Path = "/" + strings.Join(segments[i+1:], "/")
+		}
+		if len(info.Path) != 0 {
+			break
+		}
+	}
+	if len(info.Path) == 0 {
+		info.Path = req.URL.Query().Get("path")
+		if len(info.Path) == 0 {
+			info.Path = req.URL.Query().Get("pathPattern")
+		}
+	}
+
+	operation := info.Operation
+	if strings.ContainsAny(info.Path, "*,") || strings.HasSuffix(info.Path, "/") {
+		operation += "?pathPattern"
+	}
+	if name, ok := apiNames[operation]; ok {
+		info.Name = name
+	} else if name, ok = apiNames[info.Operation]; ok {
+		info.Name = name
+	} else {
+		info.Name = info.Operation
+	}
+	return info
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func TestNewRequestInfo(t *testing.T) {
+	tests := []struct {
+		method string
+		url    string
+		want   *RequestInfo
+	}{
+		{http.MethodGet, "/api/v1/projects/foo/repos/bar/contents/a/b.json?revision=-1", &RequestInfo{
+			Name: "GetFile", Operation: "GET /api/v1/projects/{}/repos/{}/contents",
+			ProjectName: "foo", RepoName: "bar", Path: "/a/b.json",
+		}},
+		{http.MethodGet, "/api/v1/projects/foo/repos/bar/contents/a/*.json", &RequestInfo{
+			Name: "GetFiles", Operation: "GET /api/v1/projects/{}/repos/{}/contents",
+			ProjectName: "foo", RepoName: "bar", Path: "/a/*.json",
+		}},
+		{http.MethodGet, "/api/v1/projects/foo/repos/bar/compare?pathPattern=/**&from=1&to=2", &RequestInfo{
+			Name: "GetDiff", Operation: "GET /api/v1/projects/{}/repos/{}/compare",
+			ProjectName: "foo", RepoName: "bar", Path: "/**",
+		}},
+		{http.MethodDelete, "/api/v1/tokens/app", &RequestInfo{
+			Name: "DELETE /api/v1/tokens/{}", Operation: "DELETE /api/v1/tokens/{}",
+		}},
+	}
+
+	for _, test := range tests {
+		u, _ := url.Parse(test.url)
+		info := newRequestInfo(&http.Request{Method: test.method, URL: u}, false)
+		if !reflect.DeepEqual(info, test.want) {
+			t.Errorf("newRequestInfo(%v) = %+v, want %+v", test.url, info, test.want)
+		}
+	}
+}
+
+type testTracer struct {
+	infos       []*RequestInfo
+	statusCodes []int
+}
+
+func (tt *testTracer) Start(ctx context.Context, req *http.Request,
+	info *RequestInfo) (context.Context, func(statusCode int, err error)) {
+	tt.infos = append(tt.infos, info)
+	req.Header.Set("traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+	return ctx, func(statusCode int, err error) {
+		tt.statusCodes = append(tt.statusCodes, statusCode)
+	}
+}
+
+func TestWithTracer(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testHeader(t, r, "traceparent", "00-0af7651916cd43dd8448eb211c80319c-b7ad6b7169203331-01")
+		fmt.Fprint(w, `{"revision":2, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	tracer := &testTracer{}
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithTracer(tracer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	change, _ := NewRemove("/a.json")
+	if _, _, err = c.Push(context.Background(), "foo", "bar", "-1", &CommitMessage{Summary: "Remove a.json"},
+		[]*Change{change}); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(tracer.infos) != 1 || tracer.infos[0].Name != "Push" {
+		t.Errorf("traced %+v, want a Push", tracer.infos)
+	}
+	if !reflect.DeepEqual(tracer.statusCodes, []int{http.StatusOK}) {
+		t.Errorf("finished with %v, want %v", tracer.statusCodes, []int{http.StatusOK})
+	}
+}
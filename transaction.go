@@ -0,0 +1,128 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// Transaction reads the files of a repository at a single revision and pushes the accumulated changes as one
+// commit on top of that revision. The commit fails with an APIError which is ErrChangeConflict if the
+// repository has been changed since the revision, so that the changes are always made based on what was read.
+// For example:
+//
+//	tx, _, err := client.BeginTransaction(ctx, "foo", "bar")
+//	if err != nil {
+//	    ...
+//	}
+//	entry, _, err := tx.GetFile(ctx, &Query{Path: "/a.json", Type: Identity})
+//	... // build the changes from the entry
+//	tx.Add(changeA, changeB)
+//	result, _, err := tx.Commit(ctx, &CommitMessage{Summary: "Update a.json and b.json"})
+type Transaction struct {
+	client       *Client
+	projectName  string
+	repoName     string
+	baseRevision int64
+
+	lock      sync.Mutex
+	changes   []*Change
+	committed bool
+}
+
+// BeginTransaction returns a Transaction based on the latest revision of the repository.
+func (c *Client) BeginTransaction(ctx context.Context, projectName, repoName string) (tx *Transaction,
+	httpStatusCode int, err error) {
+	repository := (*repositoryService)(c.content)
+	head, httpStatusCode, err := repository.fetchHead(ctx, projectName, repoName)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	return &Transaction{client: c, projectName: projectName, repoName: repoName, baseRevision: head},
+		httpStatusCode, nil
+}
+
+// BeginTransaction returns a Transaction based on the latest revision of the repository.
+func (r *RepositoryClient) BeginTransaction(ctx context.Context) (tx *Transaction, httpStatusCode int,
+	err error) {
+	return r.client.BeginTransaction(ctx, r.projectName, r.repoName)
+}
+
+// BaseRevision returns the revision which the files are read at and the changes are pushed on top of.
+func (tx *Transaction) BaseRevision() Revision {
+	return Rev(tx.baseRevision)
+}
+
+// GetFile returns the file at the base revision with the specified Query.
+func (tx *Transaction) GetFile(ctx context.Context, query *Query) (entry *Entry, httpStatusCode int,
+	err error) {
+	return tx.client.GetFile(ctx, tx.projectName, tx.repoName, tx.revision(), query)
+}
+
+// GetFiles returns the files that match the given path pattern at the base revision.
+func (tx *Transaction) GetFiles(ctx context.Context, pathPattern string) (entries []*Entry,
+	httpStatusCode int, err error) {
+	return tx.client.GetFiles(ctx, tx.projectName, tx.repoName, tx.revision(), pathPattern)
+}
+
+// ListFiles returns the list of the files that match the given path pattern at the base revision.
+func (tx *Transaction) ListFiles(ctx context.Context, pathPattern string) (entries []*Entry,
+	httpStatusCode int, err error) {
+	return tx.client.ListFiles(ctx, tx.projectName, tx.repoName, tx.revision(), pathPattern)
+}
+
+func (tx *Transaction) revision() string {
+	return strconv.FormatInt(tx.baseRevision, 10)
+}
+
+// Add adds the changes to be pushed by Commit.
+func (tx *Transaction) Add(changes ...*Change) *Transaction {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+	tx.changes = append(tx.changes, changes...)
+	return tx
+}
+
+// Changes returns the changes added so far.
+func (tx *Transaction) Changes() []*Change {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+	return append([]*Change(nil), tx.changes...)
+}
+
+// Commit pushes all changes added to the transaction as one commit on top of the base revision.
+// ErrTransactionDone is returned if the transaction has been committed already.
+func (tx *Transaction) Commit(ctx context.Context, commitMessage *CommitMessage) (result *PushResult,
+	httpStatusCode int, err error) {
+	tx.lock.Lock()
+	defer tx.lock.Unlock()
+	if tx.committed {
+		return nil, UnknownHttpStatusCode, ErrTransactionDone
+	}
+	if len(tx.changes) == 0 {
+		return nil, UnknownHttpStatusCode, fmt.Errorf("no changes to commit")
+	}
+
+	result, httpStatusCode, err = tx.client.content.pushIfUnchanged(ctx, tx.projectName, tx.repoName,
+		Rev(tx.baseRevision), commitMessage, tx.changes)
+	if err != nil {
+		return nil, httpStatusCode, err
+	}
+	tx.committed = true
+	return result, httpStatusCode, nil
+}
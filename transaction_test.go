@@ -0,0 +1,112 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+func TestTransaction(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		fmt.Fprint(w, `{"revision":2}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "revision", "2")
+		fmt.Fprint(w, `{"path":"/a.json", "type":"JSON", "content":{"a":"b"}, "revision":2}`)
+	})
+	pushes := 0
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testURLQuery(t, r, "revision", "2")
+		pushes++
+		fmt.Fprint(w, `{"revision":3, "pushedAt":"2017-05-22T00:00:00Z"}`)
+	})
+
+	tx, _, err := c.BeginTransaction(context.Background(), "foo", "bar")
+	if err != nil {
+		t.Fatalf("BeginTransaction returned error: %v", err)
+	}
+	if tx.BaseRevision() != 2 {
+		t.Errorf("BaseRevision returned %v, want %v", tx.BaseRevision(), 2)
+	}
+
+	entry, _, err := tx.GetFile(context.Background(), &Query{Path: "/a.json", Type: Identity})
+	if err != nil {
+		t.Fatalf("GetFile returned error: %v", err)
+	}
+	if entry.Revision != 2 {
+		t.Errorf("GetFile returned revision %v, want %v", entry.Revision, 2)
+	}
+
+	commitMessage := &CommitMessage{Summary: "Update a.json and add b.json"}
+	if _, _, err = tx.Commit(context.Background(), commitMessage); err == nil {
+		t.Errorf("Commit should fail when there are no changes")
+	}
+
+	tx.Add(&Change{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "c"}}).
+		Add(&Change{Path: "/b.json", Type: UpsertJSON, Content: map[string]interface{}{"b": "d"}})
+	if len(tx.Changes()) != 2 {
+		t.Errorf("Changes returned %v changes, want %v", len(tx.Changes()), 2)
+	}
+
+	result, _, err := tx.Commit(context.Background(), commitMessage)
+	if err != nil {
+		t.Fatalf("Commit returned error: %v", err)
+	}
+	if result.Revision != 3 {
+		t.Errorf("Commit returned revision %v, want %v", result.Revision, 3)
+	}
+
+	if _, _, err = tx.Commit(context.Background(), commitMessage); err != ErrTransactionDone {
+		t.Errorf("Commit returned %v, want %v", err, ErrTransactionDone)
+	}
+	if pushes != 1 {
+		t.Errorf("pushed %v times, want %v", pushes, 1)
+	}
+}
+
+func TestTransactionConflict(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/revision/-1", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"revision":2}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents", func(w http.ResponseWriter, r *http.Request) {
+		testURLQuery(t, r, "revision", "2")
+		w.WriteHeader(http.StatusConflict)
+		fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.ChangeConflictException",`+
+			`"message":"conflict"}`)
+	})
+
+	tx, _, err := c.BeginTransaction(context.Background(), "foo", "bar")
+	if err != nil {
+		t.Fatalf("BeginTransaction returned error: %v", err)
+	}
+	tx.Add(&Change{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "c"}})
+	_, httpStatusCode, err := tx.Commit(context.Background(), &CommitMessage{Summary: "Update a.json"})
+	if !isChangeConflict(err) {
+		t.Errorf("Commit returned %v, want a conflict", err)
+	}
+	testStatusCode(t, httpStatusCode, http.StatusConflict)
+}
@@ -0,0 +1,217 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package centraldogma
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// TypedWatchResult represents a decoded result from TypedWatcher.
+type TypedWatchResult[T any] struct {
+	Revision int64
+	Value    T
+	Err      error
+}
+
+// TypedWatchListener listens to TypedWatcher.
+type TypedWatchListener[T any] func(revision int64, value T)
+
+// TypedWatcher watches the changes of a JSON file and decodes its content into T.
+// The listeners are notified only when the decoded value actually changes.
+type TypedWatcher[T any] struct {
+	watcher *Watcher
+
+	lock      sync.RWMutex
+	latest    *TypedWatchResult[T]
+	listeners []*typedListener[T]
+}
+
+// typedListener delivers the values to a listener in the order of the revisions on its own goroutine, like
+// Watcher does, so that neither onUpdate nor Watch blocks on a slow listener.
+type typedListener[T any] struct {
+	listener TypedWatchListener[T]
+	signal   chan struct{}
+
+	lock    sync.Mutex
+	pending []*TypedWatchResult[T]
+}
+
+func (l *typedListener[T]) enqueue(result *TypedWatchResult[T]) {
+	l.lock.Lock()
+	l.pending = append(l.pending, result)
+	l.lock.Unlock()
+	select {
+	case l.signal <- struct{}{}:
+	default: // the notifier is already signaled.
+	}
+}
+
+func (l *typedListener[T]) notifier(w *Watcher) {
+	for {
+		select {
+		case <-w.watchCTX.Done():
+			return
+		case <-l.signal:
+		}
+
+		l.lock.Lock()
+		pending := l.pending
+		l.pending = nil
+		l.lock.Unlock()
+		for _, result := range pending {
+			if w.isStopped() {
+				return
+			}
+			l.listener(result.Revision, result.Value)
+		}
+	}
+}
+
+// WatchJSONAs returns a TypedWatcher which decodes the JSON content of the file specified in the Query into T
+// whenever a new revision becomes available. For example:
+//
+//	type MyConfig struct {
+//	    A string `json:"a"`
+//	}
+//
+//	query := &Query{Path: "/a.json", Type: Identity}
+//	watcher, err := centraldogma.WatchJSONAs[MyConfig](ctx, client, "foo", "bar", query)
+//	if err != nil {
+//	    panic(err)
+//	}
+//	defer watcher.Close()
+//
+//	watcher.Watch(func(revision int64, value MyConfig) {
+//	    ...
+//	})
+func WatchJSONAs[T any](ctx context.Context, c *Client,
+	projectName, repoName string, query *Query, opts ...WatcherOption) (*TypedWatcher[T], error) {
+	w, err := c.watch.fileWatcher(ctx, projectName, repoName, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	tw := &TypedWatcher[T]{watcher: w}
+	if err = w.Watch(tw.onUpdate); err != nil {
+		return nil, err
+	}
+	w.start()
+	return tw, nil
+}
+
+func decodeTypedWatchResult[T any](codec Codec, result *WatchResult) *TypedWatchResult[T] {
+	if result.Err != nil {
+		return &TypedWatchResult[T]{Revision: result.Revision, Err: result.Err}
+	}
+
+	var value T
+	if err := codec.Unmarshal(result.Entry.Content, &value); err != nil {
+		return &TypedWatchResult[T]{Revision: result.Revision, Err: err}
+	}
+	return &TypedWatchResult[T]{Revision: result.Revision, Value: value}
+}
+
+func (tw *TypedWatcher[T]) onUpdate(result WatchResult) {
+	if isEntryRemovedEvent(result.Err) {
+		tw.watcher.logger.Debugf("TypedWatcher noticed the removal: %s/%s%s",
+			tw.watcher.projectName, tw.watcher.repoName, tw.watcher.pathPattern)
+		return
+	}
+	decoded := decodeTypedWatchResult[T](tw.watcher.codec, &result)
+	if decoded.Err != nil {
+		tw.watcher.logger.Warnf("TypedWatcher failed to decode: %s/%s%s, rev=%v, err=%v",
+			tw.watcher.projectName, tw.watcher.repoName, tw.watcher.pathPattern, result.Revision, decoded.Err)
+		return
+	}
+
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+	if tw.latest != nil && reflect.DeepEqual(tw.latest.Value, decoded.Value) {
+		// Only the revision is changed.
+		tw.latest = decoded
+		return
+	}
+	tw.latest = decoded
+	// Enqueue while holding the lock so that a listener registered concurrently never receives the value
+	// after a newer one.
+	for _, listener := range tw.listeners {
+		listener.enqueue(decoded)
+	}
+}
+
+// Latest returns the latest decoded value and its revision.
+func (tw *TypedWatcher[T]) Latest() *TypedWatchResult[T] {
+	tw.lock.RLock()
+	defer tw.lock.RUnlock()
+	if tw.latest != nil {
+		return tw.latest
+	}
+	return &TypedWatchResult[T]{Err: ErrLatestNotSet}
+}
+
+// AwaitInitialValueWithContext awaits for the initial value to be available until the specified context is done.
+func (tw *TypedWatcher[T]) AwaitInitialValueWithContext(ctx context.Context) *TypedWatchResult[T] {
+	return decodeTypedWatchResult[T](tw.watcher.codec, tw.watcher.AwaitInitialValueWithContext(ctx))
+}
+
+// InitialValue awaits for the initial value to be available until the specified context is done. If the initial
+// value is not available by then, it returns a TypedWatchResult with the fallback value whose Revision is 0.
+func (tw *TypedWatcher[T]) InitialValue(ctx context.Context, fallback T) *TypedWatchResult[T] {
+	result := tw.AwaitInitialValueWithContext(ctx)
+	if result.Err == nil {
+		return result
+	}
+	tw.watcher.logger.Warnf("TypedWatcher uses the fallback value: %s/%s%s, err=%v",
+		tw.watcher.projectName, tw.watcher.repoName, tw.watcher.pathPattern, result.Err)
+	return &TypedWatchResult[T]{Value: fallback}
+}
+
+// Watch registers a func that will be invoked when the decoded value of the watched file becomes available
+// or changes. The func is invoked on a separate goroutine with the values in the order of the revisions,
+// starting from the latest value if it is already available.
+func (tw *TypedWatcher[T]) Watch(listener TypedWatchListener[T]) error {
+	if listener == nil {
+		return nil // do nothing
+	}
+
+	tw.lock.Lock()
+	defer tw.lock.Unlock()
+	l := &typedListener[T]{listener: listener, signal: make(chan struct{}, 1)}
+	if !tw.watcher.goRoutine(func() { l.notifier(tw.watcher) }) {
+		return ErrWatcherClosed
+	}
+	// The latest value is delivered first through the same path as the updates.
+	if tw.latest != nil {
+		l.enqueue(tw.latest)
+	}
+	tw.listeners = append(tw.listeners, l)
+	return nil
+}
+
+// Close stops watching the file.
+func (tw *TypedWatcher[T]) Close() {
+	tw.watcher.Close()
+}
+
+// CloseAndWait stops watching the file and waits until the listeners being invoked return or the context is
+// done.
+func (tw *TypedWatcher[T]) CloseAndWait(ctx context.Context) error {
+	return tw.watcher.CloseAndWait(ctx)
+}
@@ -0,0 +1,155 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+//go:build go1.18
+// +build go1.18
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWatchJSONAs(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	expectedLastKnownRevision := 1
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		// Let's pretend that the content is modified after 100 millisecond and the revision is increased by 1.
+		time.Sleep(100 * time.Millisecond)
+		expectedLastKnownRevision++
+
+		// The value is changed only on every second revision.
+		fmt.Fprint(w, `{"revision":`+strconv.Itoa(expectedLastKnownRevision)+`,
+"entry":{"path":"/a.json", "type":"JSON", "content": {"a":`+strconv.Itoa(expectedLastKnownRevision/2)+`}}
+}`)
+	}
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", handler)
+
+	type myConfig struct {
+		A int `json:"a"`
+	}
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	tw, err := WatchJSONAs[myConfig](context.Background(), c, "foo", "bar", query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tw.Close()
+
+	myCh := make(chan myConfig, 128)
+	_ = tw.Watch(func(revision int64, value myConfig) { myCh <- value })
+
+	want := 1
+	for i := 0; i < 3; i++ {
+		select {
+		case value := <-myCh:
+			if value.A != want {
+				t.Errorf("watch returned: %v, want %v", value.A, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("failed to watch")
+		}
+		want++
+	}
+
+	if latest := tw.Latest(); latest.Err != nil || latest.Value.A < 3 {
+		t.Errorf("latest: %+v, want a value greater than or equal to 3", latest)
+	}
+}
+
+func TestTypedWatcher_InitialValue(t *testing.T) {
+	c, _, teardown := setup()
+	defer teardown()
+
+	type myConfig struct {
+		A int `json:"a"`
+	}
+
+	// The file does not exist.
+	query := &Query{Path: "/a.json", Type: Identity}
+	tw, err := WatchJSONAs[myConfig](context.Background(), c, "foo", "bar", query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tw.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if result := tw.InitialValue(ctx, myConfig{A: 42}); result.Err != nil || result.Value.A != 42 {
+		t.Errorf("InitialValue returned %+v, want the fallback", result)
+	}
+}
+
+func TestTypedWatcher_WatchInOrder(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	var revision int64 = 1
+	handler := func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(10 * time.Millisecond)
+		rev := atomic.AddInt64(&revision, 1)
+		fmt.Fprintf(w, `{"revision":%d,"entry":{"path":"/a.json", "type":"JSON", "content": {"a":%d}}}`, rev, rev)
+	}
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", handler)
+
+	type myConfig struct {
+		A int64 `json:"a"`
+	}
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	tw, err := WatchJSONAs[myConfig](context.Background(), c, "foo", "bar", query)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tw.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if result := tw.InitialValue(ctx, myConfig{}); result.Err != nil {
+		t.Fatal(result.Err)
+	}
+
+	// Watch must not invoke the listener on the caller's goroutine; otherwise it never returns.
+	release := make(chan struct{})
+	revisions := make(chan int64, 128)
+	if err := tw.Watch(func(revision int64, value myConfig) {
+		<-release
+		revisions <- revision
+	}); err != nil {
+		t.Fatal(err)
+	}
+	close(release)
+
+	var last int64
+	for i := 0; i < 5; i++ {
+		select {
+		case rev := <-revisions:
+			if rev <= last {
+				t.Errorf("listener received revision %d after %d", rev, last)
+			}
+			last = rev
+		case <-time.After(5 * time.Second):
+			t.Fatal("failed to watch")
+		}
+	}
+}
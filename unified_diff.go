@@ -0,0 +1,278 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// unifiedDiffContext is the number of the unchanged lines around the changed lines in a hunk.
+const unifiedDiffContext = 3
+
+const devNull = "/dev/null"
+
+// RenderUnifiedDiff returns the unified diff between the contents of the entries. A nil before or after denotes
+// that the file is added or removed respectively. A JSON entry is pretty-printed before the comparison so that
+// the diff is readable. An empty string is returned if the contents are the same. For example:
+//
+//	before, _, _ := client.GetFile(ctx, "foo", "bar", "2", &Query{Path: "/a.json", Type: Identity})
+//	after, _, _ := client.GetFile(ctx, "foo", "bar", "3", &Query{Path: "/a.json", Type: Identity})
+//	diff, err := centraldogma.RenderUnifiedDiff(before, after)
+func RenderUnifiedDiff(before, after *Entry) (string, error) {
+	if before == nil && after == nil {
+		return "", fmt.Errorf("either before or after should not be nil")
+	}
+
+	beforeName, beforeText, err := diffableEntry(before)
+	if err != nil {
+		return "", err
+	}
+	afterName, afterText, err := diffableEntry(after)
+	if err != nil {
+		return "", err
+	}
+	return unifiedDiff(beforeName, afterName, beforeText, afterText), nil
+}
+
+// UnifiedDiff returns the unified diff of the Change. Only the changes which contain the whole content
+// after the change, i.e. UpsertJSON and UpsertText, are rendered as the addition of the content.
+// ApplyTextPatch is returned as it is because its content is a unified diff already. Use RenderUnifiedDiff
+// with the entries before and after the change for the other types.
+func (c *Change) UnifiedDiff() (string, error) {
+	switch c.Type {
+	case UpsertJSON:
+		content, err := marshalJSONContent(c.Content)
+		if err != nil {
+			return "", err
+		}
+		text, err := prettyJSON(content)
+		if err != nil {
+			return "", err
+		}
+		return unifiedDiff(devNull, c.Path, "", text), nil
+	case UpsertText:
+		text, ok := c.Content.(string)
+		if !ok {
+			return "", fmt.Errorf("the content of %v should be a string (path: %v)", c.Type, c.Path)
+		}
+		return unifiedDiff(devNull, c.Path, "", text), nil
+	case ApplyTextPatch:
+		patch, ok := c.Content.(string)
+		if !ok {
+			return "", fmt.Errorf("the content of %v should be a string (path: %v)", c.Type, c.Path)
+		}
+		return patch, nil
+	default:
+		return "", fmt.Errorf("%v cannot be rendered without the content before the change (path: %v)",
+			c.Type, c.Path)
+	}
+}
+
+func diffableEntry(entry *Entry) (name, text string, err error) {
+	if entry == nil {
+		return devNull, "", nil
+	}
+	switch entry.Type {
+	case JSON:
+		text, err = prettyJSON(entry.Content)
+		return entry.Path, text, err
+	case Text:
+		return entry.Path, string(entry.Content), nil
+	default:
+		return "", "", fmt.Errorf("the entry cannot be compared (path: %v, type: %v)", entry.Path, entry.Type)
+	}
+}
+
+func prettyJSON(content []byte) (string, error) {
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, content, "", "  "); err != nil {
+		return "", err
+	}
+	buf.WriteByte('\n')
+	return buf.String(), nil
+}
+
+// diffOp is an operation of an edit script which turns the lines a into the lines b.
+type diffOp struct {
+	kind byte // ' ', '-' or '+'
+	line string
+}
+
+func unifiedDiff(beforeName, afterName, before, after string) string {
+	ops := diffLines(splitLines(before), splitLines(after))
+
+	var buf strings.Builder
+	for start := 0; start < len(ops); {
+		// find the next change
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		if buf.Len() == 0 {
+			fmt.Fprintf(&buf, "--- %s\n+++ %s\n", beforeName, afterName)
+		}
+
+		// extend the hunk until there are more unchanged lines than twice of the context
+		hunkStart := start - unifiedDiffContext
+		if hunkStart < 0 {
+			hunkStart = 0
+		}
+		end := start
+		for unchanged := 0; end < len(ops) && unchanged <= 2*unifiedDiffContext; end++ {
+			if ops[end].kind == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		for end > start && ops[end-1].kind == ' ' {
+			end--
+		}
+		hunkEnd := end + unifiedDiffContext
+		if hunkEnd > len(ops) {
+			hunkEnd = len(ops)
+		}
+		writeHunk(&buf, ops, hunkStart, hunkEnd)
+		start = hunkEnd
+	}
+	return buf.String()
+}
+
+func writeHunk(buf *strings.Builder, ops []diffOp, start, end int) {
+	// count the lines before the hunk
+	beforeLine, afterLine := 0, 0
+	for _, op := range ops[:start] {
+		if op.kind != '+' {
+			beforeLine++
+		}
+		if op.kind != '-' {
+			afterLine++
+		}
+	}
+	beforeCount, afterCount := 0, 0
+	for _, op := range ops[start:end] {
+		if op.kind != '+' {
+			beforeCount++
+		}
+		if op.kind != '-' {
+			afterCount++
+		}
+	}
+
+	fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(beforeLine, beforeCount), hunkRange(afterLine, afterCount))
+	for _, op := range ops[start:end] {
+		buf.WriteByte(op.kind)
+		buf.WriteString(op.line)
+		if !strings.HasSuffix(op.line, "\n") {
+			buf.WriteString("\n\\ No newline at end of file\n")
+		}
+	}
+}
+
+func hunkRange(linesBefore, count int) string {
+	switch count {
+	case 0:
+		return fmt.Sprintf("%d,0", linesBefore)
+	case 1:
+		return fmt.Sprintf("%d", linesBefore+1)
+	default:
+		return fmt.Sprintf("%d,%d", linesBefore+1, count)
+	}
+}
+
+// splitLines splits the text into the lines which keep their line terminators.
+func splitLines(text string) []string {
+	if len(text) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(text, "\n")
+	if len(lines[len(lines)-1]) == 0 {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines returns the shortest edit script which turns a into b using the Myers' algorithm.
+func diffLines(a, b []string) []diffOp {
+	n, m := len(a), len(b)
+	maxD := n + m
+	offset := maxD + 1
+	v := make([]int, 2*maxD+3)
+
+	// trace[d] holds v[-d-1:d+2] at the beginning of the step d, which is needed to backtrack.
+	var trace [][]int
+	found := false
+	for d := 0; d <= maxD && !found; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1] // down, i.e. insertion
+			} else {
+				x = v[offset+k-1] + 1 // right, i.e. deletion
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+
+	var ops []diffOp
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		tv := trace[d]
+		at := func(k int) int { return tv[k+d+1] }
+
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && at(k-1) < at(k+1)) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := at(prevK)
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, diffOp{kind: ' ', line: a[x-1]})
+			x--
+			y--
+		}
+		if d > 0 {
+			if x == prevX {
+				ops = append(ops, diffOp{kind: '+', line: b[y-1]})
+			} else {
+				ops = append(ops, diffOp{kind: '-', line: a[x-1]})
+			}
+			x, y = prevX, prevY
+		}
+	}
+
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
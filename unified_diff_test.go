@@ -0,0 +1,187 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestRenderUnifiedDiff(t *testing.T) {
+	tests := []struct {
+		name          string
+		before, after *Entry
+		want          string
+	}{
+		{
+			name:   "text",
+			before: &Entry{Path: "/a.txt", Type: Text, Content: EntryContent("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\n")},
+			after:  &Entry{Path: "/a.txt", Type: Text, Content: EntryContent("a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n")},
+			want: `--- /a.txt
++++ /a.txt
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -9,3 +9,4 @@
+ i
+ j
+ k
++l
+`,
+		},
+		{
+			name:   "json",
+			before: &Entry{Path: "/a.json", Type: JSON, Content: EntryContent(`{"a":"b","c":1}`)},
+			after:  &Entry{Path: "/a.json", Type: JSON, Content: EntryContent(`{"a":"b","c":2}`)},
+			want: `--- /a.json
++++ /a.json
+@@ -1,4 +1,4 @@
+ {
+   "a": "b",
+-  "c": 1
++  "c": 2
+ }
+`,
+		},
+		{
+			name:   "added",
+			before: nil,
+			after:  &Entry{Path: "/a.txt", Type: Text, Content: EntryContent("a\nb")},
+			want: `--- /dev/null
++++ /a.txt
+@@ -0,0 +1,2 @@
++a
++b
+\ No newline at end of file
+`,
+		},
+		{
+			name:   "removed",
+			before: &Entry{Path: "/a.txt", Type: Text, Content: EntryContent("a\n")},
+			after:  nil,
+			want: `--- /a.txt
++++ /dev/null
+@@ -1 +0,0 @@
+-a
+`,
+		},
+		{
+			name:   "same",
+			before: &Entry{Path: "/a.txt", Type: Text, Content: EntryContent("a\n")},
+			after:  &Entry{Path: "/a.txt", Type: Text, Content: EntryContent("a\n")},
+			want:   "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := RenderUnifiedDiff(test.before, test.after)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != test.want {
+				t.Errorf("RenderUnifiedDiff returned\n%s\nwant\n%s", got, test.want)
+			}
+		})
+	}
+
+	if _, err := RenderUnifiedDiff(nil, nil); err == nil {
+		t.Errorf("RenderUnifiedDiff should fail when both entries are nil")
+	}
+	if _, err := RenderUnifiedDiff(&Entry{Path: "/a", Type: Directory}, nil); err == nil {
+		t.Errorf("RenderUnifiedDiff should fail for a directory")
+	}
+}
+
+func TestChangeUnifiedDiff(t *testing.T) {
+	change := &Change{Path: "/a.json", Type: UpsertJSON, Content: map[string]interface{}{"a": "b"}}
+	got, err := change.UnifiedDiff()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "--- /dev/null\n+++ /a.json\n@@ -0,0 +1,3 @@\n+{\n+  \"a\": \"b\"\n+}\n"
+	if got != want {
+		t.Errorf("UnifiedDiff returned\n%s\nwant\n%s", got, want)
+	}
+
+	patch := "--- /a.txt\n+++ /a.txt\n@@ -1 +1 @@\n-a\n+b\n"
+	if got, _ = (&Change{Path: "/a.txt", Type: ApplyTextPatch, Content: patch}).UnifiedDiff(); got != patch {
+		t.Errorf("UnifiedDiff returned %q, want %q", got, patch)
+	}
+
+	if _, err = (&Change{Path: "/a.txt", Type: Remove}).UnifiedDiff(); err == nil {
+		t.Errorf("UnifiedDiff should fail for %v", Remove)
+	}
+}
+
+func TestDiffLines(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, r.Intn(20))
+		for i := range lines {
+			lines[i] = fmt.Sprintf("%d\n", r.Intn(5))
+		}
+		return lines
+	}
+
+	for i := 0; i < 1000; i++ {
+		a, b := randomLines(), randomLines()
+		var gotA, gotB []string
+		edits := 0
+		for _, op := range diffLines(a, b) {
+			if op.kind != '+' {
+				gotA = append(gotA, op.line)
+			}
+			if op.kind != '-' {
+				gotB = append(gotB, op.line)
+			}
+			if op.kind != ' ' {
+				edits++
+			}
+		}
+		if strings.Join(gotA, "") != strings.Join(a, "") || strings.Join(gotB, "") != strings.Join(b, "") {
+			t.Fatalf("diffLines(%q, %q) does not reproduce the inputs", a, b)
+		}
+		if want := len(a) + len(b) - 2*lcsLength(a, b); edits != want {
+			t.Fatalf("diffLines(%q, %q) returned %v edits, want %v", a, b, edits, want)
+		}
+	}
+}
+
+func lcsLength(a, b []string) int {
+	dp := make([][]int, len(a)+1)
+	for i := range dp {
+		dp[i] = make([]int, len(b)+1)
+	}
+	for i := 1; i <= len(a); i++ {
+		for j := 1; j <= len(b); j++ {
+			switch {
+			case a[i-1] == b[j-1]:
+				dp[i][j] = dp[i-1][j-1] + 1
+			case dp[i-1][j] > dp[i][j-1]:
+				dp[i][j] = dp[i-1][j]
+			default:
+				dp[i][j] = dp[i][j-1]
+			}
+		}
+	}
+	return dp[len(a)][len(b)]
+}
@@ -16,8 +16,8 @@ package centraldogma
 
 import (
 	"fmt"
-	"math"
 	"math/rand"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
@@ -87,27 +87,39 @@ func setJSONPaths(v *url.Values, query *Query) (err error) {
 	return
 }
 
-func nextDelay(numAttemptsSoFar int) time.Duration {
-	var nextDelay time.Duration
-	if numAttemptsSoFar == 1 {
-		nextDelay = minInterval
-	} else {
-		calculatedDelay := saturatedMultiply(minInterval, math.Pow(2.0, float64(numAttemptsSoFar-1)))
-		if calculatedDelay > maxInterval {
-			nextDelay = maxInterval
+func getMergedEntryURLValues(v *url.Values, revision string, mergeQuery *MergeQuery) error {
+	for _, mergeSource := range mergeQuery.MergeSources {
+		if !strings.HasSuffix(strings.ToLower(mergeSource.Path), ".json") {
+			return fmt.Errorf("the extension of the file should be .json (path: %v)", mergeSource.Path)
+		}
+		if mergeSource.Optional {
+			v.Add("optional_path", mergeSource.Path)
 		} else {
-			nextDelay = calculatedDelay
+			v.Add("path", mergeSource.Path)
 		}
 	}
-	minJitter := int64(float64(nextDelay) * (1 - jitterRate))
-	maxJitter := int64(float64(nextDelay) * (1 + jitterRate))
-	bound := maxJitter - minJitter + 1
-	random := random(bound)
-	result := saturatedAdd(minJitter, random)
-	if result < 0 {
-		result = 0
+	if mergeQuery.Type == JSONPath {
+		for _, jsonPath := range mergeQuery.Expressions {
+			v.Add("jsonpath", jsonPath)
+		}
 	}
-	return time.Duration(result)
+	setRevision(v, revision)
+	return nil
+}
+
+// cloneRequest returns a shallow copy of the request with a deep copy of the header, so that a RoundTripper
+// can modify the header without affecting the original request.
+func cloneRequest(req *http.Request) *http.Request {
+	clone := req.WithContext(req.Context())
+	clone.Header = make(http.Header, len(req.Header))
+	for key, values := range req.Header {
+		clone.Header[key] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+func nextDelay(numAttemptsSoFar int) time.Duration {
+	return DefaultBackoffPolicy().NextDelay(numAttemptsSoFar)
 }
 
 func saturatedMultiply(left time.Duration, right float64) time.Duration {
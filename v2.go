@@ -0,0 +1,39 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+//go:generate go run ./internal/v2gen -output v2_methods.go
+
+// ClientV2 provides the methods of Client which return (T, error) instead of (T, httpStatusCode int, error).
+// An error response of the server is returned as an *APIError which carries the HTTP status code, the exception
+// and the message from the server, and the request ID, so the status code rarely needs to be checked separately.
+// For example:
+//
+//	entry, err := client.V2().GetFile(ctx, "foo", "bar", "-1", query)
+//	var apiError *centraldogma.APIError
+//	if errors.As(err, &apiError) && apiError.StatusCode == http.StatusNotFound {
+//	    ...
+//	}
+//
+// The status codes which are not errors, e.g. 304 Not Modified from WatchRepositoryOnce, are not reported.
+type ClientV2 struct {
+	client *Client
+}
+
+// V2 returns the ClientV2 which calls the methods of the client. It shares the state of the client such as
+// the caches and the sessions.
+func (c *Client) V2() *ClientV2 {
+	return &ClientV2{client: c}
+}
@@ -0,0 +1,581 @@
+// Code generated by v2gen. DO NOT EDIT.
+
+package centraldogma
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// ActivateToken is the same as Client.ActivateToken except that it does not return the HTTP status code.
+func (c *ClientV2) ActivateToken(ctx context.Context, appID string) (token *Token, err error) {
+	token, _, err = c.client.ActivateToken(ctx, appID)
+	return
+}
+
+// AddMember is the same as Client.AddMember except that it does not return the HTTP status code.
+func (c *ClientV2) AddMember(ctx context.Context, projectName, login string, role ProjectRole) (err error) {
+	_, err = c.client.AddMember(ctx, projectName, login, role)
+	return
+}
+
+// AddTokenToProject is the same as Client.AddTokenToProject except that it does not return the HTTP status
+// code.
+func (c *ClientV2) AddTokenToProject(ctx context.Context, projectName, appID string, role ProjectRole) (err error) {
+	_, err = c.client.AddTokenToProject(ctx, projectName, appID, role)
+	return
+}
+
+// BeginTransaction is the same as Client.BeginTransaction except that it does not return the HTTP status
+// code.
+func (c *ClientV2) BeginTransaction(ctx context.Context, projectName, repoName string) (tx *Transaction, err error) {
+	tx, _, err = c.client.BeginTransaction(ctx, projectName, repoName)
+	return
+}
+
+// CreateCredential is the same as Client.CreateCredential except that it does not return the HTTP status
+// code.
+func (c *ClientV2) CreateCredential(ctx context.Context, projectName string, credential *Credential) (err error) {
+	_, err = c.client.CreateCredential(ctx, projectName, credential)
+	return
+}
+
+// CreateMirror is the same as Client.CreateMirror except that it does not return the HTTP status code.
+func (c *ClientV2) CreateMirror(ctx context.Context, projectName string, mirror *Mirror) (err error) {
+	_, err = c.client.CreateMirror(ctx, projectName, mirror)
+	return
+}
+
+// CreateProject is the same as Client.CreateProject except that it does not return the HTTP status code.
+func (c *ClientV2) CreateProject(ctx context.Context, name string) (pro *Project, err error) {
+	pro, _, err = c.client.CreateProject(ctx, name)
+	return
+}
+
+// CreateProjectWithOptions is the same as Client.CreateProjectWithOptions except that it does not return the
+// HTTP status code.
+func (c *ClientV2) CreateProjectWithOptions(ctx context.Context, name string,
+	opts *ProjectOptions) (pro *Project, err error) {
+	pro, _, err = c.client.CreateProjectWithOptions(ctx, name, opts)
+	return
+}
+
+// CreateRepository is the same as Client.CreateRepository except that it does not return the HTTP status
+// code.
+func (c *ClientV2) CreateRepository(ctx context.Context, projectName, repoName string) (repo *Repository, err error) {
+	repo, _, err = c.client.CreateRepository(ctx, projectName, repoName)
+	return
+}
+
+// CreateRepositoryWithOptions is the same as Client.CreateRepositoryWithOptions except that it does not
+// return the HTTP status code.
+func (c *ClientV2) CreateRepositoryWithOptions(ctx context.Context, projectName, repoName string,
+	opts *RepositoryOptions) (repo *Repository, err error) {
+	repo, _, err = c.client.CreateRepositoryWithOptions(ctx, projectName, repoName, opts)
+	return
+}
+
+// CreateToken is the same as Client.CreateToken except that it does not return the HTTP status code.
+func (c *ClientV2) CreateToken(ctx context.Context, appID string, isAdmin bool) (token *Token, err error) {
+	token, _, err = c.client.CreateToken(ctx, appID, isAdmin)
+	return
+}
+
+// DeactivateToken is the same as Client.DeactivateToken except that it does not return the HTTP status code.
+func (c *ClientV2) DeactivateToken(ctx context.Context, appID string) (token *Token, err error) {
+	token, _, err = c.client.DeactivateToken(ctx, appID)
+	return
+}
+
+// DeleteToken is the same as Client.DeleteToken except that it does not return the HTTP status code.
+func (c *ClientV2) DeleteToken(ctx context.Context, appID string) (err error) {
+	_, err = c.client.DeleteToken(ctx, appID)
+	return
+}
+
+// DiffAsJSONPatch is the same as Client.DiffAsJSONPatch except that it does not return the HTTP status code.
+func (c *ClientV2) DiffAsJSONPatch(ctx context.Context, projectName, repoName, path string,
+	newValue interface{}) (change *Change, err error) {
+	change, _, err = c.client.DiffAsJSONPatch(ctx, projectName, repoName, path, newValue)
+	return
+}
+
+// DownloadTo is the same as Client.DownloadTo except that it does not return the HTTP status code.
+func (c *ClientV2) DownloadTo(ctx context.Context, projectName, repoName, revision, path string,
+	w io.Writer) (written int64, err error) {
+	written, _, err = c.client.DownloadTo(ctx, projectName, repoName, revision, path, w)
+	return
+}
+
+// ExportRepository is the same as Client.ExportRepository except that it does not return the HTTP status
+// code.
+func (c *ClientV2) ExportRepository(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	dst ExportTarget) (exported int, err error) {
+	exported, _, err = c.client.ExportRepository(ctx, projectName, repoName, revision, pathPattern, dst)
+	return
+}
+
+// GetBinaryFile is the same as Client.GetBinaryFile except that it does not return the HTTP status code.
+func (c *ClientV2) GetBinaryFile(ctx context.Context,
+	projectName, repoName, revision, path string) (data []byte, err error) {
+	data, _, err = c.client.GetBinaryFile(ctx, projectName, repoName, revision, path)
+	return
+}
+
+// GetCommit is the same as Client.GetCommit except that it does not return the HTTP status code.
+func (c *ClientV2) GetCommit(ctx context.Context,
+	projectName, repoName, revision string) (commit *CommitDetail, err error) {
+	commit, _, err = c.client.GetCommit(ctx, projectName, repoName, revision)
+	return
+}
+
+// GetCommitRev is the same as Client.GetCommitRev except that it does not return the HTTP status code.
+func (c *ClientV2) GetCommitRev(ctx context.Context, projectName, repoName string,
+	revision Revision) (commit *CommitDetail, err error) {
+	commit, _, err = c.client.GetCommitRev(ctx, projectName, repoName, revision)
+	return
+}
+
+// GetDiff is the same as Client.GetDiff except that it does not return the HTTP status code.
+func (c *ClientV2) GetDiff(ctx context.Context, projectName, repoName, from, to string,
+	query *Query) (change *Change, err error) {
+	change, _, err = c.client.GetDiff(ctx, projectName, repoName, from, to, query)
+	return
+}
+
+// GetDiffRev is the same as Client.GetDiffRev except that it does not return the HTTP status code.
+func (c *ClientV2) GetDiffRev(ctx context.Context, projectName, repoName string, from, to Revision,
+	query *Query) (change *Change, err error) {
+	change, _, err = c.client.GetDiffRev(ctx, projectName, repoName, from, to, query)
+	return
+}
+
+// GetDiffs is the same as Client.GetDiffs except that it does not return the HTTP status code.
+func (c *ClientV2) GetDiffs(ctx context.Context,
+	projectName, repoName, from, to, pathPattern string) (changes []*Change, err error) {
+	changes, _, err = c.client.GetDiffs(ctx, projectName, repoName, from, to, pathPattern)
+	return
+}
+
+// GetDiffsRev is the same as Client.GetDiffsRev except that it does not return the HTTP status code.
+func (c *ClientV2) GetDiffsRev(ctx context.Context, projectName, repoName string, from, to Revision,
+	pathPattern string) (changes []*Change, err error) {
+	changes, _, err = c.client.GetDiffsRev(ctx, projectName, repoName, from, to, pathPattern)
+	return
+}
+
+// GetFile is the same as Client.GetFile except that it does not return the HTTP status code.
+func (c *ClientV2) GetFile(ctx context.Context, projectName, repoName, revision string,
+	query *Query) (entry *Entry, err error) {
+	entry, _, err = c.client.GetFile(ctx, projectName, repoName, revision, query)
+	return
+}
+
+// GetFileRev is the same as Client.GetFileRev except that it does not return the HTTP status code.
+func (c *ClientV2) GetFileRev(ctx context.Context, projectName, repoName string, revision Revision,
+	query *Query) (entry *Entry, err error) {
+	entry, _, err = c.client.GetFileRev(ctx, projectName, repoName, revision, query)
+	return
+}
+
+// GetFiles is the same as Client.GetFiles except that it does not return the HTTP status code.
+func (c *ClientV2) GetFiles(ctx context.Context,
+	projectName, repoName, revision, pathPattern string) (entries []*Entry, err error) {
+	entries, _, err = c.client.GetFiles(ctx, projectName, repoName, revision, pathPattern)
+	return
+}
+
+// GetFilesIterator is the same as Client.GetFilesIterator except that it does not return the HTTP status
+// code.
+func (c *ClientV2) GetFilesIterator(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	entryTypes ...EntryType) (it *EntryIterator, err error) {
+	it, _, err = c.client.GetFilesIterator(ctx, projectName, repoName, revision, pathPattern, entryTypes...)
+	return
+}
+
+// GetFilesRev is the same as Client.GetFilesRev except that it does not return the HTTP status code.
+func (c *ClientV2) GetFilesRev(ctx context.Context, projectName, repoName string, revision Revision,
+	pathPattern string) (entries []*Entry, err error) {
+	entries, _, err = c.client.GetFilesRev(ctx, projectName, repoName, revision, pathPattern)
+	return
+}
+
+// GetFilesWithJSONPaths is the same as Client.GetFilesWithJSONPaths except that it does not return the HTTP
+// status code.
+func (c *ClientV2) GetFilesWithJSONPaths(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	jsonPaths ...string) (entries []*Entry, err error) {
+	entries, _, err = c.client.GetFilesWithJSONPaths(ctx, projectName, repoName, revision, pathPattern, jsonPaths...)
+	return
+}
+
+// GetHistory is the same as Client.GetHistory except that it does not return the HTTP status code.
+func (c *ClientV2) GetHistory(ctx context.Context, projectName, repoName, from, to, pathPattern string,
+	maxCommits int) (commits []*Commit, err error) {
+	commits, _, err = c.client.GetHistory(ctx, projectName, repoName, from, to, pathPattern, maxCommits)
+	return
+}
+
+// GetHistoryRev is the same as Client.GetHistoryRev except that it does not return the HTTP status code.
+func (c *ClientV2) GetHistoryRev(ctx context.Context, projectName, repoName string, from, to Revision,
+	pathPattern string, maxCommits int) (commits []*Commit, err error) {
+	commits, _, err = c.client.GetHistoryRev(ctx, projectName, repoName, from, to, pathPattern, maxCommits)
+	return
+}
+
+// GetHistoryWithOptions is the same as Client.GetHistoryWithOptions except that it does not return the HTTP
+// status code.
+func (c *ClientV2) GetHistoryWithOptions(ctx context.Context, projectName, repoName string,
+	opts *HistoryOptions) (commits []*Commit, err error) {
+	commits, _, err = c.client.GetHistoryWithOptions(ctx, projectName, repoName, opts)
+	return
+}
+
+// GetMergedEntry is the same as Client.GetMergedEntry except that it does not return the HTTP status code.
+func (c *ClientV2) GetMergedEntry(ctx context.Context, projectName, repoName, revision string,
+	mergeQuery *MergeQuery) (mergedEntry *MergedEntry, err error) {
+	mergedEntry, _, err = c.client.GetMergedEntry(ctx, projectName, repoName, revision, mergeQuery)
+	return
+}
+
+// GetMergedEntryRev is the same as Client.GetMergedEntryRev except that it does not return the HTTP status
+// code.
+func (c *ClientV2) GetMergedEntryRev(ctx context.Context, projectName, repoName string, revision Revision,
+	mergeQuery *MergeQuery) (mergedEntry *MergedEntry, err error) {
+	mergedEntry, _, err = c.client.GetMergedEntryRev(ctx, projectName, repoName, revision, mergeQuery)
+	return
+}
+
+// GetMirror is the same as Client.GetMirror except that it does not return the HTTP status code.
+func (c *ClientV2) GetMirror(ctx context.Context, projectName, id string) (mirror *Mirror, err error) {
+	mirror, _, err = c.client.GetMirror(ctx, projectName, id)
+	return
+}
+
+// GetProjectMetadata is the same as Client.GetProjectMetadata except that it does not return the HTTP status
+// code.
+func (c *ClientV2) GetProjectMetadata(ctx context.Context,
+	projectName string) (projectMetadata *ProjectMetadata, err error) {
+	projectMetadata, _, err = c.client.GetProjectMetadata(ctx, projectName)
+	return
+}
+
+// GetRawFile is the same as Client.GetRawFile except that it does not return the HTTP status code.
+func (c *ClientV2) GetRawFile(ctx context.Context,
+	projectName, repoName, revision, path string) (content []byte, err error) {
+	content, _, err = c.client.GetRawFile(ctx, projectName, repoName, revision, path)
+	return
+}
+
+// LastModified is the same as Client.LastModified except that it does not return the HTTP status code.
+func (c *ClientV2) LastModified(ctx context.Context,
+	projectName, repoName, path, atRevision string) (commit *Commit, err error) {
+	commit, _, err = c.client.LastModified(ctx, projectName, repoName, path, atRevision)
+	return
+}
+
+// ListCredentials is the same as Client.ListCredentials except that it does not return the HTTP status code.
+func (c *ClientV2) ListCredentials(ctx context.Context, projectName string) (credentials []*Credential, err error) {
+	credentials, _, err = c.client.ListCredentials(ctx, projectName)
+	return
+}
+
+// ListFiles is the same as Client.ListFiles except that it does not return the HTTP status code.
+func (c *ClientV2) ListFiles(ctx context.Context,
+	projectName, repoName, revision, pathPattern string) (entries []*Entry, err error) {
+	entries, _, err = c.client.ListFiles(ctx, projectName, repoName, revision, pathPattern)
+	return
+}
+
+// ListFilesByType is the same as Client.ListFilesByType except that it does not return the HTTP status code.
+func (c *ClientV2) ListFilesByType(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	entryTypes ...EntryType) (entries []*Entry, err error) {
+	entries, _, err = c.client.ListFilesByType(ctx, projectName, repoName, revision, pathPattern, entryTypes...)
+	return
+}
+
+// ListFilesIterator is the same as Client.ListFilesIterator except that it does not return the HTTP status
+// code.
+func (c *ClientV2) ListFilesIterator(ctx context.Context, projectName, repoName, revision, pathPattern string,
+	entryTypes ...EntryType) (it *EntryIterator, err error) {
+	it, _, err = c.client.ListFilesIterator(ctx, projectName, repoName, revision, pathPattern, entryTypes...)
+	return
+}
+
+// ListFilesRev is the same as Client.ListFilesRev except that it does not return the HTTP status code.
+func (c *ClientV2) ListFilesRev(ctx context.Context, projectName, repoName string, revision Revision,
+	pathPattern string) (entries []*Entry, err error) {
+	entries, _, err = c.client.ListFilesRev(ctx, projectName, repoName, revision, pathPattern)
+	return
+}
+
+// ListMirrors is the same as Client.ListMirrors except that it does not return the HTTP status code.
+func (c *ClientV2) ListMirrors(ctx context.Context, projectName string) (mirrors []*Mirror, err error) {
+	mirrors, _, err = c.client.ListMirrors(ctx, projectName)
+	return
+}
+
+// ListProjects is the same as Client.ListProjects except that it does not return the HTTP status code.
+func (c *ClientV2) ListProjects(ctx context.Context) (pros []*Project, err error) {
+	pros, _, err = c.client.ListProjects(ctx)
+	return
+}
+
+// ListRemovedProjects is the same as Client.ListRemovedProjects except that it does not return the HTTP
+// status code.
+func (c *ClientV2) ListRemovedProjects(ctx context.Context) (removedPros []*Project, err error) {
+	removedPros, _, err = c.client.ListRemovedProjects(ctx)
+	return
+}
+
+// ListRemovedRepositories is the same as Client.ListRemovedRepositories except that it does not return the
+// HTTP status code.
+func (c *ClientV2) ListRemovedRepositories(ctx context.Context,
+	projectName string) (removedRepos []*Repository, err error) {
+	removedRepos, _, err = c.client.ListRemovedRepositories(ctx, projectName)
+	return
+}
+
+// ListRepositories is the same as Client.ListRepositories except that it does not return the HTTP status
+// code.
+func (c *ClientV2) ListRepositories(ctx context.Context, projectName string) (repos []*Repository, err error) {
+	repos, _, err = c.client.ListRepositories(ctx, projectName)
+	return
+}
+
+// ListTokens is the same as Client.ListTokens except that it does not return the HTTP status code.
+func (c *ClientV2) ListTokens(ctx context.Context) (tokens []*Token, err error) {
+	tokens, _, err = c.client.ListTokens(ctx)
+	return
+}
+
+// NormalizeRevision is the same as Client.NormalizeRevision except that it does not return the HTTP status
+// code.
+func (c *ClientV2) NormalizeRevision(ctx context.Context,
+	projectName, repoName, revision string) (normalizedRev int64, err error) {
+	normalizedRev, _, err = c.client.NormalizeRevision(ctx, projectName, repoName, revision)
+	return
+}
+
+// NormalizeRevisionRev is the same as Client.NormalizeRevisionRev except that it does not return the HTTP
+// status code.
+func (c *ClientV2) NormalizeRevisionRev(ctx context.Context, projectName, repoName string,
+	revision Revision) (normalizedRev int64, err error) {
+	normalizedRev, _, err = c.client.NormalizeRevisionRev(ctx, projectName, repoName, revision)
+	return
+}
+
+// NormalizeRevisions is the same as Client.NormalizeRevisions except that it does not return the HTTP status
+// code.
+func (c *ClientV2) NormalizeRevisions(ctx context.Context, projectName, repoName string,
+	revisions ...string) (normalizedRevs []int64, err error) {
+	normalizedRevs, _, err = c.client.NormalizeRevisions(ctx, projectName, repoName, revisions...)
+	return
+}
+
+// OpenFile is the same as Client.OpenFile except that it does not return the HTTP status code.
+func (c *ClientV2) OpenFile(ctx context.Context,
+	projectName, repoName, revision, path string) (content io.ReadCloser, err error) {
+	content, _, err = c.client.OpenFile(ctx, projectName, repoName, revision, path)
+	return
+}
+
+// PreviewDiffs is the same as Client.PreviewDiffs except that it does not return the HTTP status code.
+func (c *ClientV2) PreviewDiffs(ctx context.Context, projectName, repoName, baseRevision string,
+	changes []*Change) (diffs []*Change, err error) {
+	diffs, _, err = c.client.PreviewDiffs(ctx, projectName, repoName, baseRevision, changes)
+	return
+}
+
+// PreviewDiffsRev is the same as Client.PreviewDiffsRev except that it does not return the HTTP status code.
+func (c *ClientV2) PreviewDiffsRev(ctx context.Context, projectName, repoName string, baseRevision Revision,
+	changes []*Change) (diffs []*Change, err error) {
+	diffs, _, err = c.client.PreviewDiffsRev(ctx, projectName, repoName, baseRevision, changes)
+	return
+}
+
+// PurgeProject is the same as Client.PurgeProject except that it does not return the HTTP status code.
+func (c *ClientV2) PurgeProject(ctx context.Context, name string) (err error) {
+	_, err = c.client.PurgeProject(ctx, name)
+	return
+}
+
+// PurgeRepository is the same as Client.PurgeRepository except that it does not return the HTTP status code.
+func (c *ClientV2) PurgeRepository(ctx context.Context, projectName, repoName string) (err error) {
+	_, err = c.client.PurgeRepository(ctx, projectName, repoName)
+	return
+}
+
+// Push is the same as Client.Push except that it does not return the HTTP status code.
+func (c *ClientV2) Push(ctx context.Context, projectName, repoName, baseRevision string, commitMessage *CommitMessage,
+	changes []*Change) (result *PushResult, err error) {
+	result, _, err = c.client.Push(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	return
+}
+
+// PushCAS is the same as Client.PushCAS except that it does not return the HTTP status code.
+func (c *ClientV2) PushCAS(ctx context.Context, projectName, repoName string, commitMessage *CommitMessage,
+	transform PushTransform, maxRetries int) (result *PushResult, err error) {
+	result, _, err = c.client.PushCAS(ctx, projectName, repoName, commitMessage, transform, maxRetries)
+	return
+}
+
+// PushDirectory is the same as Client.PushDirectory except that it does not return the HTTP status code.
+func (c *ClientV2) PushDirectory(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, localDir, remotePrefix string,
+	opts ...PushDirectoryOption) (result *PushResult, err error) {
+	result, _, err = c.client.PushDirectory(ctx, projectName, repoName, baseRevision, commitMessage, localDir,
+		remotePrefix, opts...)
+	return
+}
+
+// PushIfUnchanged is the same as Client.PushIfUnchanged except that it does not return the HTTP status code.
+func (c *ClientV2) PushIfUnchanged(ctx context.Context, projectName, repoName string, baseRevision Revision,
+	commitMessage *CommitMessage, changes []*Change) (result *PushResult, err error) {
+	result, _, err = c.client.PushIfUnchanged(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	return
+}
+
+// PushRev is the same as Client.PushRev except that it does not return the HTTP status code.
+func (c *ClientV2) PushRev(ctx context.Context, projectName, repoName string, baseRevision Revision,
+	commitMessage *CommitMessage, changes []*Change) (result *PushResult, err error) {
+	result, _, err = c.client.PushRev(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	return
+}
+
+// PushWithOptions is the same as Client.PushWithOptions except that it does not return the HTTP status code.
+func (c *ClientV2) PushWithOptions(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, changes []*Change, opts *PushOptions) (result *PushResultDetail, err error) {
+	result, _, err = c.client.PushWithOptions(ctx, projectName, repoName, baseRevision, commitMessage, changes, opts)
+	return
+}
+
+// PushWithResultDetail is the same as Client.PushWithResultDetail except that it does not return the HTTP
+// status code.
+func (c *ClientV2) PushWithResultDetail(ctx context.Context, projectName, repoName, baseRevision string,
+	commitMessage *CommitMessage, changes []*Change) (result *PushResultDetail, err error) {
+	result, _, err = c.client.PushWithResultDetail(ctx, projectName, repoName, baseRevision, commitMessage, changes)
+	return
+}
+
+// RemoveCredential is the same as Client.RemoveCredential except that it does not return the HTTP status
+// code.
+func (c *ClientV2) RemoveCredential(ctx context.Context, projectName, id string) (err error) {
+	_, err = c.client.RemoveCredential(ctx, projectName, id)
+	return
+}
+
+// RemoveMember is the same as Client.RemoveMember except that it does not return the HTTP status code.
+func (c *ClientV2) RemoveMember(ctx context.Context, projectName, login string) (err error) {
+	_, err = c.client.RemoveMember(ctx, projectName, login)
+	return
+}
+
+// RemoveMirror is the same as Client.RemoveMirror except that it does not return the HTTP status code.
+func (c *ClientV2) RemoveMirror(ctx context.Context, projectName, id string) (err error) {
+	_, err = c.client.RemoveMirror(ctx, projectName, id)
+	return
+}
+
+// RemoveProject is the same as Client.RemoveProject except that it does not return the HTTP status code.
+func (c *ClientV2) RemoveProject(ctx context.Context, name string) (err error) {
+	_, err = c.client.RemoveProject(ctx, name)
+	return
+}
+
+// RemoveRepository is the same as Client.RemoveRepository except that it does not return the HTTP status
+// code.
+func (c *ClientV2) RemoveRepository(ctx context.Context, projectName, repoName string) (err error) {
+	_, err = c.client.RemoveRepository(ctx, projectName, repoName)
+	return
+}
+
+// RemoveTokenFromProject is the same as Client.RemoveTokenFromProject except that it does not return the
+// HTTP status code.
+func (c *ClientV2) RemoveTokenFromProject(ctx context.Context, projectName, appID string) (err error) {
+	_, err = c.client.RemoveTokenFromProject(ctx, projectName, appID)
+	return
+}
+
+// ServerHealthy is the same as Client.ServerHealthy except that it does not return the HTTP status code.
+func (c *ClientV2) ServerHealthy(ctx context.Context) (healthy bool, err error) {
+	healthy, _, err = c.client.ServerHealthy(ctx)
+	return
+}
+
+// ServerStatus is the same as Client.ServerStatus except that it does not return the HTTP status code.
+func (c *ClientV2) ServerStatus(ctx context.Context) (status *ServerStatus, err error) {
+	status, _, err = c.client.ServerStatus(ctx)
+	return
+}
+
+// ServerVersion is the same as Client.ServerVersion except that it does not return the HTTP status code.
+func (c *ClientV2) ServerVersion(ctx context.Context) (version *ServerVersion, err error) {
+	version, _, err = c.client.ServerVersion(ctx)
+	return
+}
+
+// SetRepoRolePermissions is the same as Client.SetRepoRolePermissions except that it does not return the
+// HTTP status code.
+func (c *ClientV2) SetRepoRolePermissions(ctx context.Context, projectName, repoName string,
+	perRolePermissions *PerRolePermissions) (err error) {
+	_, err = c.client.SetRepoRolePermissions(ctx, projectName, repoName, perRolePermissions)
+	return
+}
+
+// SetRepoTokenPermissions is the same as Client.SetRepoTokenPermissions except that it does not return the
+// HTTP status code.
+func (c *ClientV2) SetRepoTokenPermissions(ctx context.Context, projectName, repoName, appID string,
+	permissions []Permission) (err error) {
+	_, err = c.client.SetRepoTokenPermissions(ctx, projectName, repoName, appID, permissions)
+	return
+}
+
+// SetRepoUserPermissions is the same as Client.SetRepoUserPermissions except that it does not return the
+// HTTP status code.
+func (c *ClientV2) SetRepoUserPermissions(ctx context.Context, projectName, repoName, login string,
+	permissions []Permission) (err error) {
+	_, err = c.client.SetRepoUserPermissions(ctx, projectName, repoName, login, permissions)
+	return
+}
+
+// UnremoveProject is the same as Client.UnremoveProject except that it does not return the HTTP status code.
+func (c *ClientV2) UnremoveProject(ctx context.Context, name string) (pro *Project, err error) {
+	pro, _, err = c.client.UnremoveProject(ctx, name)
+	return
+}
+
+// UnremoveRepository is the same as Client.UnremoveRepository except that it does not return the HTTP status
+// code.
+func (c *ClientV2) UnremoveRepository(ctx context.Context, projectName, repoName string) (repo *Repository, err error) {
+	repo, _, err = c.client.UnremoveRepository(ctx, projectName, repoName)
+	return
+}
+
+// UpdateCredential is the same as Client.UpdateCredential except that it does not return the HTTP status
+// code.
+func (c *ClientV2) UpdateCredential(ctx context.Context, projectName string, credential *Credential) (err error) {
+	_, err = c.client.UpdateCredential(ctx, projectName, credential)
+	return
+}
+
+// UpdateMemberRole is the same as Client.UpdateMemberRole except that it does not return the HTTP status
+// code.
+func (c *ClientV2) UpdateMemberRole(ctx context.Context, projectName, login string, role ProjectRole) (err error) {
+	_, err = c.client.UpdateMemberRole(ctx, projectName, login, role)
+	return
+}
+
+// UpdateMirror is the same as Client.UpdateMirror except that it does not return the HTTP status code.
+func (c *ClientV2) UpdateMirror(ctx context.Context, projectName string, mirror *Mirror) (err error) {
+	_, err = c.client.UpdateMirror(ctx, projectName, mirror)
+	return
+}
+
+// WatchRepositoryOnce is the same as Client.WatchRepositoryOnce except that it does not return the HTTP
+// status code.
+func (c *ClientV2) WatchRepositoryOnce(ctx context.Context, projectName, repoName, pathPattern string,
+	lastKnownRevision int64, timeout time.Duration) (revision int64, err error) {
+	revision, _, err = c.client.WatchRepositoryOnce(ctx, projectName, repoName, pathPattern, lastKnownRevision, timeout)
+	return
+}
@@ -0,0 +1,100 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestClientV2_CreateProject(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"name":"foo", "creator":{"name":"minux", "email":"minux@m.x"}}`)
+	})
+
+	project, err := c.V2().CreateProject(context.Background(), "foo")
+	if err != nil {
+		t.Fatalf("CreateProject returned error: %v", err)
+	}
+	want := &Project{Name: "foo", Creator: Author{Name: "minux", Email: "minux@m.x"}}
+	if !reflect.DeepEqual(project, want) {
+		t.Errorf("CreateProject returned %+v, want %+v", project, want)
+	}
+}
+
+func TestClientV2_RemoveProject(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodDelete)
+		w.WriteHeader(http.StatusNoContent)
+	})
+
+	if err := c.V2().RemoveProject(context.Background(), "foo"); err != nil {
+		t.Errorf("RemoveProject returned error: %v", err)
+	}
+}
+
+func TestClientV2_APIError(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json",
+		func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.EntryNotFoundException",
+"message":"/a.json does not exist"}`)
+		})
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	ctx := ContextWithRequestID(context.Background(), "my-request")
+	entry, err := c.V2().GetFile(ctx, "foo", "bar", "-1", query)
+	if entry != nil {
+		t.Errorf("GetFile returned %+v, want nil", entry)
+	}
+	apiError, ok := err.(*APIError)
+	if !ok {
+		t.Fatalf("GetFile returned %T, want *APIError", err)
+	}
+	testStatusCode(t, apiError.StatusCode, http.StatusNotFound)
+	testString(t, apiError.RequestID, "my-request", "request id")
+	if !apiError.Is(ErrEntryNotFound) {
+		t.Errorf("APIError.Is(ErrEntryNotFound) returned false, want true")
+	}
+}
+
+func TestClientV2_NotModified(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/**", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotModified)
+	})
+
+	revision, err := c.V2().WatchRepositoryOnce(context.Background(), "foo", "bar", "/**", 2, time.Second)
+	if revision != 2 || err != nil {
+		t.Errorf("WatchRepositoryOnce returned %v, %v, want 2, nil", revision, err)
+	}
+}
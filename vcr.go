@@ -0,0 +1,336 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+)
+
+// VCRMode specifies how the transport installed by WithVCR uses its cassette.
+type VCRMode int
+
+const (
+	// VCRModeAuto replays the cassette if it exists. Otherwise, it sends the requests to the server and
+	// records the interactions into a new cassette.
+	VCRModeAuto VCRMode = iota
+	// VCRModeRecord always sends the requests to the server and overwrites the cassette.
+	VCRModeRecord
+	// VCRModeReplay always replays the cassette and never sends the requests to the server.
+	VCRModeReplay
+)
+
+// vcrRedacted replaces the secrets in a cassette.
+const vcrRedacted = "[REDACTED]"
+
+// vcrSecretHeaders are the headers whose values are redacted from a cassette.
+var vcrSecretHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// vcrSecretFields are the JSON properties and form fields whose values are redacted from a cassette.
+// The names are compared in lower case without '_' and '-'.
+var vcrSecretFields = map[string]bool{
+	"password":     true,
+	"secret":       true,
+	"token":        true,
+	"accesstoken":  true,
+	"refreshtoken": true,
+}
+
+// VCRInteraction is a pair of a request and its response recorded in a cassette.
+type VCRInteraction struct {
+	Request  VCRRequest  `json:"request"`
+	Response VCRResponse `json:"response"`
+}
+
+// VCRRequest is a recorded request. The URL consists of the path and the query only, so that a cassette can
+// be replayed against any server.
+type VCRRequest struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+// VCRResponse is a recorded response.
+type VCRResponse struct {
+	StatusCode int         `json:"statusCode"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body,omitempty"`
+}
+
+type cassette struct {
+	Interactions []*VCRInteraction `json:"interactions"`
+}
+
+// vcrTransport records the interactions with the server into a cassette file or replays them from it.
+// A request is replayed with the first interaction which has not been replayed yet and whose method, URL and
+// body are the same as the request's, so the same requests, e.g. watch requests, are replayed in the order
+// they were recorded.
+type vcrTransport struct {
+	base      http.RoundTripper
+	filename  string
+	recording bool
+	scrubbers []func(*VCRInteraction)
+
+	lock         sync.Mutex
+	interactions []*VCRInteraction
+	replayed     []bool
+}
+
+func newVCRClient(client *http.Client, filename string, mode VCRMode,
+	scrubbers []func(*VCRInteraction)) (*http.Client, error) {
+	base := client.Transport
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	transport, err := newVCRTransport(base, filename, mode, scrubbers)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{
+		Transport:     transport,
+		CheckRedirect: client.CheckRedirect,
+		Jar:           client.Jar,
+		Timeout:       client.Timeout,
+	}, nil
+}
+
+func newVCRTransport(base http.RoundTripper, filename string, mode VCRMode,
+	scrubbers []func(*VCRInteraction)) (*vcrTransport, error) {
+	t := &vcrTransport{base: base, filename: filename, scrubbers: scrubbers}
+	switch mode {
+	case VCRModeRecord:
+		t.recording = true
+		return t, nil
+	case VCRModeAuto, VCRModeReplay:
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			if mode == VCRModeAuto && os.IsNotExist(err) {
+				t.recording = true
+				return t, nil
+			}
+			return nil, err
+		}
+		var c cassette
+		if err = json.Unmarshal(content, &c); err != nil {
+			return nil, fmt.Errorf("failed to read the cassette %s: %v", filename, err)
+		}
+		t.interactions = c.Interactions
+		t.replayed = make([]bool, len(c.Interactions))
+		return t, nil
+	default:
+		return nil, fmt.Errorf("unknown VCR mode: %d", mode)
+	}
+}
+
+func (t *vcrTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	clone := cloneRequest(req)
+	body, err := readRequestBody(clone)
+	if err != nil {
+		return nil, err
+	}
+
+	header := cloneHeader(req.Header)
+	// The request IDs are generated randomly, which would change the cassette whenever it is recorded.
+	header.Del(RequestIDHeader)
+	interaction := &VCRInteraction{Request: VCRRequest{
+		Method: req.Method,
+		URL:    req.URL.RequestURI(),
+		Header: header,
+		Body:   string(body),
+	}}
+	if t.recording {
+		return t.record(clone, interaction)
+	}
+	return t.replay(req, interaction)
+}
+
+func (t *vcrTransport) record(req *http.Request, interaction *VCRInteraction) (*http.Response, error) {
+	res, err := t.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	interaction.Response = VCRResponse{
+		StatusCode: res.StatusCode,
+		Header:     cloneHeader(res.Header),
+		Body:       string(body),
+	}
+	t.scrub(interaction)
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.interactions = append(t.interactions, interaction)
+	content, err := json.MarshalIndent(&cassette{Interactions: t.interactions}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	if err = writeFileAtomically(t.filename, content); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (t *vcrTransport) replay(req *http.Request, interaction *VCRInteraction) (*http.Response, error) {
+	// Scrub the request in the same way as the recorded ones so that the redacted values are matched.
+	t.scrub(interaction)
+	want := &interaction.Request
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	for i, recorded := range t.interactions {
+		got := &recorded.Request
+		if t.replayed[i] || got.Method != want.Method || got.URL != want.URL || got.Body != want.Body {
+			continue
+		}
+		t.replayed[i] = true
+
+		response := &recorded.Response
+		return &http.Response{
+			Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+			StatusCode:    response.StatusCode,
+			Proto:         "HTTP/1.1",
+			ProtoMajor:    1,
+			ProtoMinor:    1,
+			Header:        cloneHeader(response.Header),
+			Body:          ioutil.NopCloser(strings.NewReader(response.Body)),
+			ContentLength: int64(len(response.Body)),
+			Request:       req,
+		}, nil
+	}
+	return nil, ErrVCRInteractionNotFound
+}
+
+func (t *vcrTransport) scrub(interaction *VCRInteraction) {
+	scrubHeader(interaction.Request.Header)
+	scrubHeader(interaction.Response.Header)
+	interaction.Request.Body = scrubBody(interaction.Request.Header, interaction.Request.Body)
+	interaction.Response.Body = scrubBody(interaction.Response.Header, interaction.Response.Body)
+	for _, scrubber := range t.scrubbers {
+		scrubber(interaction)
+	}
+}
+
+// readRequestBody reads the body of the request and replaces it with a new one which has the same content.
+func readRequestBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil
+	}
+	body, err := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, nil
+}
+
+func cloneHeader(header http.Header) http.Header {
+	if header == nil {
+		return nil
+	}
+	clone := make(http.Header, len(header))
+	for key, values := range header {
+		clone[key] = append([]string(nil), values...)
+	}
+	return clone
+}
+
+func scrubHeader(header http.Header) {
+	for _, name := range vcrSecretHeaders {
+		if values := header[name]; len(values) != 0 {
+			header[name] = []string{vcrRedacted}
+		}
+	}
+}
+
+// scrubBody redacts the values of vcrSecretFields from a JSON or form-encoded body. The other bodies are
+// returned as they are.
+func scrubBody(header http.Header, body string) string {
+	if len(body) == 0 {
+		return body
+	}
+	contentType := header.Get("Content-Type")
+	if strings.HasPrefix(contentType, "application/x-www-form-urlencoded") {
+		form, err := url.ParseQuery(body)
+		if err != nil {
+			return body
+		}
+		redacted := false
+		for key := range form {
+			if isVCRSecretField(key) {
+				form[key] = []string{vcrRedacted}
+				redacted = true
+			}
+		}
+		if !redacted {
+			return body
+		}
+		return form.Encode()
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || !scrubJSON(value) {
+		return body
+	}
+	scrubbed, err := json.Marshal(value)
+	if err != nil {
+		return body
+	}
+	return string(scrubbed)
+}
+
+// scrubJSON redacts the string values of vcrSecretFields in place and returns whether any value is redacted.
+func scrubJSON(value interface{}) bool {
+	redacted := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if _, ok := child.(string); ok && isVCRSecretField(key) {
+				v[key] = vcrRedacted
+				redacted = true
+			} else if scrubJSON(child) {
+				redacted = true
+			}
+		}
+	case []interface{}:
+		for _, child := range v {
+			if scrubJSON(child) {
+				redacted = true
+			}
+		}
+	}
+	return redacted
+}
+
+func isVCRSecretField(name string) bool {
+	name = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(name))
+	return vcrSecretFields[name]
+}
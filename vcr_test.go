@@ -0,0 +1,174 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestWithVCR(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassettePath := filepath.Join(dir, "testdata", "cassette.json")
+
+	numListed := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		numListed++
+		fmt.Fprintf(w, `[{"name":"foo%d"}]`, numListed)
+	})
+	mux.HandleFunc("/api/v1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodPost)
+		testBody(t, r, "appId=ci-bot&isAdmin=false")
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"appId":"ci-bot", "secret":"appToken-secret", "admin":false}`)
+	})
+	server := httptest.NewServer(mux)
+
+	// Record.
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithVCR(cassettePath),
+		WithHeader("Authorization", "Bearer my-token"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testVCRInteractions(t, c)
+	server.Close()
+
+	content, err := ioutil.ReadFile(cassettePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"my-token", "appToken-secret"} {
+		if strings.Contains(string(content), secret) {
+			t.Errorf("cassette contains %q:\n%s", secret, content)
+		}
+	}
+
+	// Replay without the server.
+	c, err = NewClient(server.URL, WithTransport(http.DefaultTransport), WithVCR(cassettePath),
+		WithVCRMode(VCRModeReplay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	testVCRInteractions(t, c)
+
+	_, _, err = c.ListProjects(context.Background())
+	if urlErr, ok := err.(*url.Error); !ok || urlErr.Err != ErrVCRInteractionNotFound {
+		t.Errorf("ListProjects returned %v, want %v", err, ErrVCRInteractionNotFound)
+	}
+}
+
+func testVCRInteractions(t *testing.T, c *Client) {
+	for i := 1; i <= 2; i++ {
+		projects, _, err := c.ListProjects(context.Background())
+		if err != nil {
+			t.Fatalf("ListProjects returned error: %v", err)
+		}
+		testString(t, projects[0].Name, fmt.Sprintf("foo%d", i), "name")
+	}
+
+	token, httpStatusCode, err := c.CreateToken(context.Background(), "ci-bot", false)
+	if err != nil {
+		t.Fatalf("CreateToken returned error: %v", err)
+	}
+	testStatusCode(t, httpStatusCode, http.StatusCreated)
+	testString(t, token.AppID, "ci-bot", "appId")
+}
+
+func TestWithVCR_ReplayWithoutCassette(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	_, err = NewClient("localhost:36462", WithVCR(filepath.Join(dir, "cassette.json")),
+		WithVCRMode(VCRModeReplay))
+	if !os.IsNotExist(err) {
+		t.Errorf("NewClient returned %v, want a not-exist error", err)
+	}
+}
+
+func TestWithVCRScrubber(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	cassettePath := filepath.Join(dir, "cassette.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"name":"internal-project"}]`)
+	}))
+	defer server.Close()
+
+	scrubber := func(interaction *VCRInteraction) {
+		interaction.Response.Body = strings.Replace(interaction.Response.Body, "internal", "public", -1)
+	}
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithVCR(cassettePath),
+		WithVCRMode(VCRModeRecord), WithVCRScrubber(scrubber))
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, _, err := c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	// The recorded response is scrubbed, but the response returned while recording is not.
+	testString(t, projects[0].Name, "internal-project", "name")
+
+	c, err = NewClient(server.URL, WithVCR(cassettePath), WithVCRMode(VCRModeReplay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	projects, _, err = c.ListProjects(context.Background())
+	if err != nil {
+		t.Fatalf("ListProjects returned error: %v", err)
+	}
+	testString(t, projects[0].Name, "public-project", "name")
+}
+
+func TestScrubBody(t *testing.T) {
+	formHeader := http.Header{"Content-Type": []string{"application/x-www-form-urlencoded"}}
+	tests := []struct {
+		header http.Header
+		body   string
+		want   string
+	}{
+		{formHeader, "username=foo&password=bar", "password=%5BREDACTED%5D&username=foo"},
+		{formHeader, "appId=foo", "appId=foo"},
+		{nil, `{"access_token":"a","nested":[{"refreshToken":"b","n":1.50}]}`,
+			`{"access_token":"[REDACTED]","nested":[{"n":1.50,"refreshToken":"[REDACTED]"}]}`},
+		{nil, `{"name":"foo", "secret":{"kept":"because it is not a string"}}`,
+			`{"name":"foo", "secret":{"kept":"because it is not a string"}}`},
+		{nil, "password=foo", "password=foo"},
+	}
+	for _, test := range tests {
+		testString(t, scrubBody(test.header, test.body), test.want, test.body)
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// watchMultiplexer shares one repository watch among the file watchers of the same repository. When
+// the repository is changed, each file watcher fetches its file at the new revision and notifies its listeners
+// only if the file is changed, so that the number of the long-polling requests does not grow with the number
+// of the watched files.
+type watchMultiplexer struct {
+	ws *watchService
+
+	lock  sync.Mutex
+	repos map[string]*sharedRepoWatch
+}
+
+func newWatchMultiplexer(ws *watchService) *watchMultiplexer {
+	return &watchMultiplexer{ws: ws, repos: make(map[string]*sharedRepoWatch)}
+}
+
+// sharedRepoWatch is a repository watch shared by the file watchers.
+type sharedRepoWatch struct {
+	watcher *Watcher
+	refs    int
+
+	lock     sync.Mutex
+	revision int64
+	changed  chan struct{} // closed when the revision is changed.
+}
+
+func (m *watchMultiplexer) acquire(projectName, repoName string) (*sharedRepoWatch, error) {
+	key := projectName + "/" + repoName
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if shared, ok := m.repos[key]; ok {
+		shared.refs++
+		return shared, nil
+	}
+
+	w, err := m.ws.repoWatcherWithTimeout(context.Background(), projectName, repoName, "/**",
+		defaultWatchTimeout)
+	if err != nil {
+		return nil, err
+	}
+	shared := &sharedRepoWatch{watcher: w, refs: 1, changed: make(chan struct{})}
+	if err = w.Watch(shared.onUpdate); err != nil {
+		return nil, err
+	}
+	w.start()
+	m.repos[key] = shared
+	return shared, nil
+}
+
+func (m *watchMultiplexer) release(projectName, repoName string) {
+	key := projectName + "/" + repoName
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	shared, ok := m.repos[key]
+	if !ok {
+		return
+	}
+	if shared.refs--; shared.refs == 0 {
+		shared.watcher.Close()
+		delete(m.repos, key)
+	}
+}
+
+func (s *sharedRepoWatch) onUpdate(result WatchResult) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if result.Revision > s.revision {
+		s.revision = result.Revision
+		close(s.changed)
+		s.changed = make(chan struct{})
+	}
+}
+
+// wait waits until the revision of the repository becomes greater than the lastKnownRevision and returns it.
+// The lastKnownRevision is returned if the timeout elapses first.
+func (s *sharedRepoWatch) wait(ctx context.Context, lastKnownRevision int64,
+	timeout time.Duration) (int64, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		s.lock.Lock()
+		revision, changed := s.revision, s.changed
+		s.lock.Unlock()
+		if revision > lastKnownRevision {
+			return revision, nil
+		}
+
+		select {
+		case <-changed:
+		case <-timer.C:
+			return lastKnownRevision, nil
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+}
+
+// fileWatchFunc returns the doWatchFunc of a file watcher which waits for the shared repository watch
+// instead of sending its own long-polling request.
+func (m *watchMultiplexer) fileWatchFunc(projectName, repoName string, query *Query,
+	timeout time.Duration, notifyEntryNotFound bool) func(ctx context.Context, lastKnownRevision int64) *WatchResult {
+	// The following variables are accessed only by the goroutine of the watcher.
+	var shared *sharedRepoWatch
+	var lastChecked int64
+	var lastEntry *Entry
+	removed := false
+
+	return func(ctx context.Context, lastKnownRevision int64) *WatchResult {
+		if shared == nil {
+			var err error
+			if shared, err = m.acquire(projectName, repoName); err != nil {
+				return &WatchResult{Err: err}
+			}
+			go func() {
+				<-ctx.Done()
+				m.release(projectName, repoName)
+			}()
+		}
+		if lastKnownRevision > lastChecked {
+			lastChecked = lastKnownRevision
+		}
+
+		revision, err := shared.wait(ctx, lastChecked, timeout)
+		if err != nil {
+			return &WatchResult{Err: err}
+		}
+		if revision == lastChecked {
+			return &WatchResult{HttpStatusCode: http.StatusNotModified}
+		}
+
+		entry, httpStatusCode, err := m.ws.client.content.getFile(ctx, projectName, repoName,
+			strconv.FormatInt(revision, 10), query)
+		if httpStatusCode == http.StatusNotFound {
+			// Wait until the file is added.
+			lastChecked = revision
+			if notifyEntryNotFound && !removed {
+				removed = true
+				lastEntry = nil
+				return &WatchResult{Revision: revision, HttpStatusCode: httpStatusCode,
+					Err: &EntryRemovedEvent{Path: query.Path}}
+			}
+			return &WatchResult{HttpStatusCode: http.StatusNotModified}
+		}
+		if err != nil {
+			return &WatchResult{HttpStatusCode: httpStatusCode, Err: err}
+		}
+		lastChecked = revision
+		if lastEntry != nil && lastEntry.Type == entry.Type && bytes.Equal(lastEntry.Content, entry.Content) {
+			return &WatchResult{HttpStatusCode: http.StatusNotModified}
+		}
+		lastEntry = entry
+		removed = false
+		return &WatchResult{Revision: revision, Entry: *entry, HttpStatusCode: httpStatusCode}
+	}
+}
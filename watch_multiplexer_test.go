@@ -0,0 +1,134 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithWatchMultiplexing(t *testing.T) {
+	var repoWatches, fileWatches int32
+	changed := make(chan struct{})
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/", func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasSuffix(r.URL.Path, "/**") {
+			atomic.AddInt32(&repoWatches, 1)
+			switch r.Header.Get("if-none-match") {
+			case "1":
+				fmt.Fprint(w, `{"revision":2}`)
+			case "2":
+				select {
+				case <-changed:
+					fmt.Fprint(w, `{"revision":3}`)
+				case <-time.After(time.Second):
+					w.WriteHeader(http.StatusNotModified)
+				}
+			default:
+				time.Sleep(100 * time.Millisecond)
+				w.WriteHeader(http.StatusNotModified)
+			}
+			return
+		}
+		if len(r.Header.Get("if-none-match")) != 0 {
+			atomic.AddInt32(&fileWatches, 1)
+		}
+
+		revision := r.URL.Query().Get("revision")
+		switch r.URL.Path {
+		case "/api/v1/projects/foo/repos/bar/contents/a.json":
+			fmt.Fprintf(w, `{"path":"/a.json", "type":"JSON", "content":{"a":%s}}`, revision)
+		case "/api/v1/projects/foo/repos/bar/contents/b.json":
+			fmt.Fprint(w, `{"path":"/b.json", "type":"JSON", "content":{"b":1}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithWatchMultiplexing(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var watchers []*Watcher
+	updates := make(map[string]chan WatchResult)
+	for _, path := range []string{"/a.json", "/b.json", "/c.json"} {
+		w, err := c.FileWatcher("foo", "bar", &Query{Path: path, Type: Identity})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer w.Close()
+		ch := make(chan WatchResult, 8)
+		if err = w.Watch(func(result WatchResult) { ch <- result }); err != nil {
+			t.Fatal(err)
+		}
+		watchers = append(watchers, w)
+		updates[path] = ch
+	}
+
+	expectUpdate := func(path string, revision int64, content string) {
+		select {
+		case result := <-updates[path]:
+			if result.Revision != revision || string(result.Entry.Content) != content {
+				t.Errorf("%s: got (%v, %s), want (%v, %s)",
+					path, result.Revision, result.Entry.Content, revision, content)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatalf("%s: no update, want (%v, %s)", path, revision, content)
+		}
+	}
+	expectUpdate("/a.json", 2, `{"a":2}`)
+	expectUpdate("/b.json", 2, `{"b":1}`)
+
+	// Only /a.json is changed at the revision 3.
+	close(changed)
+	expectUpdate("/a.json", 3, `{"a":3}`)
+	select {
+	case result := <-updates["/b.json"]:
+		t.Errorf("/b.json: unexpected update %+v", result)
+	case result := <-updates["/c.json"]:
+		t.Errorf("/c.json: unexpected update %+v", result)
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	if n := atomic.LoadInt32(&fileWatches); n != 0 {
+		t.Errorf("file watches: %v, want 0", n)
+	}
+
+	// The shared repository watch is closed with the last file watcher.
+	for _, w := range watchers {
+		w.Close()
+	}
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		c.watchMux.lock.Lock()
+		n := len(c.watchMux.repos)
+		c.watchMux.lock.Unlock()
+		if n == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("shared watches: %v, want 0", n)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
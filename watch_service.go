@@ -20,9 +20,11 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
+	"sync"
 	"sync/atomic"
 	"time"
 )
@@ -33,17 +35,51 @@ type watchService service
 
 // WatchResult represents a result from watch operation.
 type WatchResult struct {
-	Revision       int64 `json:"revision"`
-	Entry          Entry `json:"entry,omitempty"`
+	Revision       int64       `json:"revision"`
+	Entry          Entry       `json:"entry,omitempty"`
+	Value          interface{} `json:"-"` // the value mapped from the Entry by Watcher.Map.
+	Changes        []*Change   `json:"-"` // the changes of the entries matched by the patterns of WatchPaths.
 	HttpStatusCode int
 	Err            error
 }
 
+// WatcherOption configures a file watcher.
+type WatcherOption func(*watcherOptions)
+
+type watcherOptions struct {
+	notifyEntryNotFound bool
+}
+
+// WithNotifyEntryNotFound notifies the listeners of a WatchResult whose Err is an *EntryRemovedEvent when
+// the watched file does not exist or is removed, instead of waiting until the file is added. The listeners
+// are notified of the file again when it is added back.
+func WithNotifyEntryNotFound() WatcherOption {
+	return func(o *watcherOptions) {
+		o.notifyEntryNotFound = true
+	}
+}
+
+// EntryRemovedEvent is the Err of the WatchResult which is notified when the watched file does not exist
+// or is removed. See WithNotifyEntryNotFound.
+type EntryRemovedEvent struct {
+	Path string
+}
+
+func (e *EntryRemovedEvent) Error() string {
+	return fmt.Sprintf("entry not found: %s", e.Path)
+}
+
+func isEntryRemovedEvent(err error) bool {
+	_, ok := err.(*EntryRemovedEvent)
+	return ok
+}
+
 func (ws *watchService) watchFile(
 	ctx context.Context,
 	projectName, repoName, lastKnownRevision string,
 	query *Query,
 	timeout time.Duration,
+	notifyEntryNotFound bool,
 ) *WatchResult {
 
 	// validate query
@@ -69,7 +105,15 @@ func (ws *watchService) watchFile(
 	}
 	u.RawQuery = q.Encode()
 
-	return ws.watchRequest(ctx, u, lastKnownRevision, timeout)
+	result := ws.watchRequest(ctx, u, lastKnownRevision, timeout, notifyEntryNotFound)
+	if notifyEntryNotFound && result.HttpStatusCode == http.StatusNotFound {
+		return &WatchResult{HttpStatusCode: result.HttpStatusCode, Err: &EntryRemovedEvent{Path: query.Path}}
+	}
+	if result.Err == nil {
+		ws.client.normalizeEntry(&result.Entry)
+		ws.client.observeRevision(ctx, projectName, repoName, result.Revision)
+	}
+	return result
 }
 
 func (ws *watchService) watchRepo(
@@ -79,33 +123,46 @@ func (ws *watchService) watchRepo(
 	timeout time.Duration,
 ) *WatchResult {
 
-	// Normalize pathPattern
-	if len(pathPattern) == 0 {
-		pathPattern = "/**"
-	} else if strings.HasPrefix(pathPattern, "**") {
-		pathPattern = "/" + pathPattern
-	} else if !strings.HasPrefix(pathPattern, "/") {
-		pathPattern = "/**/" + pathPattern
-	}
-
 	// build relative url
 	u, err := url.Parse(path.Join(
 		defaultPathPrefix,
 		projects, projectName,
 		repos, repoName,
-		contents, pathPattern,
+		contents, normalizePathPattern(pathPattern),
 	))
 	if err != nil {
 		return &WatchResult{Err: err}
 	}
 
-	return ws.watchRequest(ctx, u, lastKnownRevision, timeout)
+	result := ws.watchRequest(ctx, u, lastKnownRevision, timeout, false)
+	if result.Err == nil {
+		ws.client.observeRevision(ctx, projectName, repoName, result.Revision)
+	}
+	return result
+}
+
+// normalizePathPattern normalizes each of the comma-separated patterns in the pathPattern.
+func normalizePathPattern(pathPattern string) string {
+	patterns := strings.Split(pathPattern, ",")
+	for i, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if len(pattern) == 0 {
+			pattern = "/**"
+		} else if strings.HasPrefix(pattern, "**") {
+			pattern = "/" + pattern
+		} else if !strings.HasPrefix(pattern, "/") {
+			pattern = "/**/" + pattern
+		}
+		patterns[i] = pattern
+	}
+	return strings.Join(patterns, ",")
 }
 
 func (ws *watchService) watchRequest(
 	ctx context.Context,
 	u *url.URL, lastKnownRevision string,
 	timeout time.Duration,
+	notifyEntryNotFound bool,
 ) *WatchResult {
 
 	// initialize request
@@ -118,8 +175,15 @@ func (ws *watchService) watchRequest(
 	} else {
 		req.Header.Set("if-none-match", "-1")
 	}
+	var preferences []string
 	if timeout != 0 {
-		req.Header.Set("prefer", fmt.Sprintf("wait=%v", timeout.Seconds()))
+		preferences = append(preferences, fmt.Sprintf("wait=%v", timeout.Seconds()))
+	}
+	if notifyEntryNotFound {
+		preferences = append(preferences, "notify-entry-not-found=true")
+	}
+	if len(preferences) != 0 {
+		req.Header.Set("prefer", strings.Join(preferences, ", "))
 	}
 
 	// create new request context with timeout
@@ -172,6 +236,16 @@ type Watcher struct {
 	pathPattern string
 
 	numAttemptsSoFar int
+	backoff          BackoffPolicy
+
+	instrumentation Instrumentation // nil if disabled.
+	logger          Logger
+	codec           Codec // decodes the entries for WatchJSONAs and BindConfig.
+
+	// routinesLock guards the state transition to stopped and routines.Add so that no goroutine is added
+	// after the watcher is stopped.
+	routinesLock sync.Mutex
+	routines     sync.WaitGroup // the watch loop and the notifiers
 }
 
 func newWatcher(ctx context.Context, projectName, repoName, pathPattern string) *Watcher {
@@ -184,6 +258,9 @@ func newWatcher(ctx context.Context, projectName, repoName, pathPattern string)
 		projectName:     projectName,
 		repoName:        repoName,
 		pathPattern:     pathPattern,
+		backoff:         DefaultBackoffPolicy(),
+		logger:          log,
+		codec:           jsonCodec{},
 	}
 }
 
@@ -207,6 +284,36 @@ func (w *Watcher) AwaitInitialValueWith(timeout time.Duration) *WatchResult {
 	}
 }
 
+// AwaitInitialValueWithContext awaits for the initial value to be available until the specified context is done.
+func (w *Watcher) AwaitInitialValueWithContext(ctx context.Context) *WatchResult {
+	select {
+	case latest := <-w.initialValueCh:
+		// Put it back to the channel so that this can return the value multiple times.
+		w.initialValueCh <- latest
+		return latest
+	case <-ctx.Done():
+		return &WatchResult{Err: fmt.Errorf("failed to get the initial value: %v", ctx.Err())}
+	}
+}
+
+// InitialValue awaits for the initial value to be available until the specified context is done. If the initial
+// value is not available by then, e.g. the server is unreachable, it returns a WatchResult with the fallback
+// entry whose Revision is 0, so that the caller can start with the default value. The watcher keeps watching
+// and notifies its listeners when the value becomes available. For example:
+//
+//	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+//	defer cancel()
+//	result := watcher.InitialValue(ctx, centraldogma.Entry{Type: centraldogma.JSON, Content: defaultConfig})
+func (w *Watcher) InitialValue(ctx context.Context, fallback Entry) *WatchResult {
+	result := w.AwaitInitialValueWithContext(ctx)
+	if result.Err == nil {
+		return result
+	}
+	w.logger.Warnf("Watcher uses the fallback value: %s/%s%s, err=%v",
+		w.projectName, w.repoName, w.pathPattern, result.Err)
+	return &WatchResult{Entry: fallback}
+}
+
 func (w *Watcher) getLatest() (lt *WatchResult) {
 	loaded := w.latest.Load()
 	if loaded != nil {
@@ -225,8 +332,11 @@ func (w *Watcher) Latest() *WatchResult {
 }
 
 // Close stops watching the file specified in the Query or the pathPattern in the repository.
+// The in-flight watch request is cancelled and the listeners are not notified anymore.
 func (w *Watcher) Close() {
+	w.routinesLock.Lock()
 	atomic.StoreInt32(&w.state, stopped)
+	w.routinesLock.Unlock()
 	latest := &WatchResult{Err: ErrWatcherClosed}
 	if atomic.CompareAndSwapInt32(&w.isInitialValueChSet, 0, 1) {
 		// The initial latest was not set before. So write the value to initialValueCh as well.
@@ -235,6 +345,40 @@ func (w *Watcher) Close() {
 	w.watchCancelFunc() // After the first call, subsequent calls to a CancelFunc do nothing.
 }
 
+// CloseAndWait closes the watcher and waits until the in-flight watch request is cancelled and the listeners
+// being invoked return, or the context is done. It must not be called from a listener of the watcher.
+func (w *Watcher) CloseAndWait(ctx context.Context) error {
+	w.Close()
+
+	done := make(chan struct{})
+	go func() {
+		w.routines.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// goRoutine runs the f in a new goroutine which is waited by CloseAndWait. It returns false without running
+// the f if the watcher is stopped.
+func (w *Watcher) goRoutine(f func()) bool {
+	w.routinesLock.Lock()
+	defer w.routinesLock.Unlock()
+	if w.isStopped() {
+		return false
+	}
+	w.routines.Add(1)
+	go func() {
+		defer w.routines.Done()
+		f()
+	}()
+	return true
+}
+
 func (w *Watcher) addListenerChan(ch chan *WatchResult) {
 	for {
 		// try to acquire write lock
@@ -261,26 +405,64 @@ func (w *Watcher) addListenerChan(ch chan *WatchResult) {
 	}
 }
 
+func (w *Watcher) removeListenerChan(ch chan *WatchResult) {
+	for {
+		// try to acquire write lock
+		if atomic.CompareAndSwapInt32(&w.listenerChansLock, 0, 1) {
+			chans, _ := w.updateListenerChans.Load().([]chan *WatchResult)
+
+			// copy-on-write without the ch
+			cow := make([]chan *WatchResult, 0, len(chans))
+			for _, c := range chans {
+				if c != ch {
+					cow = append(cow, c)
+				}
+			}
+
+			// store back
+			w.updateListenerChans.Store(cow)
+
+			// reset lock
+			atomic.CompareAndSwapInt32(&w.listenerChansLock, 1, 0)
+			return
+		}
+		runtime.Gosched()
+	}
+}
+
 // Watch registers a func that will be invoked when the value of the watched entry becomes available or changes.
 func (w *Watcher) Watch(listener WatchListener) error {
-	if listener == nil {
-		return nil // do nothing
-	}
+	_, err := w.watch(listener)
+	return err
+}
 
-	// check watcher is stopped
-	if w.isStopped() {
-		return ErrWatcherClosed
+// watch registers the listener like Watch does and returns the func which unregisters it. The listener is not
+// invoked anymore once the returned func is called.
+func (w *Watcher) watch(listener WatchListener) (unwatch func(), err error) {
+	if listener == nil {
+		return func() {}, nil // do nothing
 	}
 
-	// start notifier which notify on update
+	// start notifier which notify on update unless the watcher is stopped or the listener is unregistered
 	ch := make(chan *WatchResult, 32)
-	go w.notifier(listener, ch)
+	done := make(chan struct{})
+	if !w.goRoutine(func() { w.notifier(listener, ch, done) }) {
+		return nil, ErrWatcherClosed
+	}
+	var once sync.Once
+	unwatch = func() {
+		once.Do(func() {
+			w.removeListenerChan(ch)
+			close(done)
+		})
+	}
 
 	// check the latest value and give it to the notifier asap
 	if latest := w.Latest(); latest.Err == nil {
 		select {
 		case <-w.watchCTX.Done():
-			return w.watchCTX.Err()
+			unwatch()
+			return nil, w.watchCTX.Err()
 
 		case ch <- latest:
 		}
@@ -289,29 +471,74 @@ func (w *Watcher) Watch(listener WatchListener) error {
 	// add listener channel to managed collection
 	w.addListenerChan(ch)
 
-	return nil
+	return unwatch, nil
 }
 
 func (ws *watchService) fileWatcher(
 	ctx context.Context,
 	projectName, repoName string, query *Query,
+	opts ...WatcherOption,
 ) (*Watcher, error) {
-	return ws.fileWatcherWithTimeout(ctx, projectName, repoName, query, defaultWatchTimeout)
+	return ws.fileWatcherWithTimeout(ctx, projectName, repoName, query, defaultWatchTimeout, opts...)
 }
 
 func (ws *watchService) fileWatcherWithTimeout(
 	ctx context.Context,
 	projectName, repoName string, query *Query,
 	timeout time.Duration,
+	opts ...WatcherOption,
 ) (*Watcher, error) {
 	if query == nil {
 		return nil, ErrQueryMustBeSet
 	}
+	options := &watcherOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 
 	w := newWatcher(ctx, projectName, repoName, query.Path)
-	w.doWatchFunc = func(ctx context.Context, lastKnownRevision int64) *WatchResult {
-		return ws.watchFile(ctx, projectName, repoName, strconv.FormatInt(lastKnownRevision, 10),
-			query, timeout)
+	w.instrumentation = ws.client.instrumentation
+	w.logger = ws.client.logger
+	w.codec = ws.client.codec
+	if ws.client.backoffPolicy != nil {
+		w.backoff = ws.client.backoffPolicy
+	}
+	if ws.client.watchMux != nil {
+		w.doWatchFunc = ws.client.watchMux.fileWatchFunc(projectName, repoName, query, timeout,
+			options.notifyEntryNotFound)
+	} else {
+		// Once the removal is notified, wait until the file is added back.
+		removed := false
+		w.doWatchFunc = func(ctx context.Context, lastKnownRevision int64) *WatchResult {
+			result := ws.watchFile(ctx, projectName, repoName, strconv.FormatInt(lastKnownRevision, 10),
+				query, timeout, options.notifyEntryNotFound && !removed)
+			if isEntryRemovedEvent(result.Err) {
+				result.Revision = lastKnownRevision
+				removed = true
+			} else if result.Err == nil && result.HttpStatusCode != http.StatusNotModified {
+				removed = false
+			}
+			return result
+		}
+	}
+
+	if len(ws.client.watcherCacheDir) != 0 {
+		cache := newWatcherCache(ws.client.watcherCacheDir, projectName, repoName, query, w.logger)
+		if cached := cache.load(); cached != nil {
+			w.logger.Debugf("Watcher starts with the cached value: %s/%s%s, rev=%v",
+				projectName, repoName, query.Path, cached.Revision)
+			w.update(cached)
+		}
+		doWatchFunc := w.doWatchFunc
+		w.doWatchFunc = func(ctx context.Context, lastKnownRevision int64) *WatchResult {
+			result := doWatchFunc(ctx, lastKnownRevision)
+			if isEntryRemovedEvent(result.Err) {
+				cache.remove()
+			} else if result.Err == nil && result.HttpStatusCode != http.StatusNotModified {
+				cache.store(result)
+			}
+			return result
+		}
 	}
 	return w, nil
 }
@@ -329,6 +556,12 @@ func (ws *watchService) repoWatcherWithTimeout(
 	timeout time.Duration,
 ) (*Watcher, error) {
 	w := newWatcher(ctx, projectName, repoName, pathPattern)
+	w.instrumentation = ws.client.instrumentation
+	w.logger = ws.client.logger
+	w.codec = ws.client.codec
+	if ws.client.backoffPolicy != nil {
+		w.backoff = ws.client.backoffPolicy
+	}
 	w.doWatchFunc = func(ctx context.Context, lastKnownRevision int64) *WatchResult {
 		return ws.watchRepo(ctx, projectName, repoName, strconv.FormatInt(lastKnownRevision, 10),
 			pathPattern, timeout)
@@ -336,9 +569,47 @@ func (ws *watchService) repoWatcherWithTimeout(
 	return w, nil
 }
 
+func (ws *watchService) pathsWatcherWithTimeout(
+	ctx context.Context,
+	projectName, repoName string, patterns []string,
+	timeout time.Duration,
+) (*Watcher, error) {
+	pathPattern := normalizePathPattern(strings.Join(patterns, ","))
+	w := newWatcher(ctx, projectName, repoName, pathPattern)
+	w.instrumentation = ws.client.instrumentation
+	w.logger = ws.client.logger
+	w.codec = ws.client.codec
+	if ws.client.backoffPolicy != nil {
+		w.backoff = ws.client.backoffPolicy
+	}
+	w.doWatchFunc = func(ctx context.Context, lastKnownRevision int64) *WatchResult {
+		result := ws.watchRepo(ctx, projectName, repoName, strconv.FormatInt(lastKnownRevision, 10),
+			pathPattern, timeout)
+		if result.Err != nil || result.HttpStatusCode == http.StatusNotModified {
+			return result
+		}
+
+		// Find out which entries are changed. The watch will be retried from the lastKnownRevision on failure.
+		changes, httpStatusCode, err := ws.client.content.getDiffs(ctx, projectName, repoName,
+			strconv.FormatInt(lastKnownRevision, 10), strconv.FormatInt(result.Revision, 10), pathPattern)
+		if err != nil {
+			return &WatchResult{HttpStatusCode: httpStatusCode, Err: err}
+		}
+		result.Changes = changes
+		return result
+	}
+	return w, nil
+}
+
 func (w *Watcher) start() {
+	w.routinesLock.Lock()
+	defer w.routinesLock.Unlock()
 	if atomic.CompareAndSwapInt32(&w.state, initial, started) {
-		go w.scheduleWatch()
+		w.routines.Add(1)
+		go func() {
+			defer w.routines.Done()
+			w.scheduleWatch()
+		}()
 	}
 }
 
@@ -384,13 +655,23 @@ func (w *Watcher) doWatch() {
 		w.delay()
 		return
 	}
+	if isEntryRemovedEvent(watchResult.Err) {
+		w.update(watchResult)
+		w.numAttemptsSoFar = 0
+		w.delay()
+		return
+	}
 	if watchResult.Err != nil {
 		if watchResult.Err == context.Canceled {
 			// Cancelled by close()
 			return
 		}
 
-		log.Debug(watchResult.Err)
+		w.logger.Debugf("Watcher failed to watch: %s/%s%s, attempts=%v, err=%v",
+			w.projectName, w.repoName, w.pathPattern, w.numAttemptsSoFar+1, watchResult.Err)
+		if w.instrumentation != nil {
+			w.instrumentation.ObserveRetry(watcherOperation)
+		}
 
 		// wait for next attempt
 		w.numAttemptsSoFar++
@@ -399,21 +680,7 @@ func (w *Watcher) doWatch() {
 	}
 
 	if watchResult.HttpStatusCode != http.StatusNotModified {
-		// converting watch result and feed back to initial value channel if needed
-		if atomic.CompareAndSwapInt32(&w.isInitialValueChSet, 0, 1) {
-			// The initial latest is set for the first time. So write the value to initialValueCh as well.
-			w.initialValueCh <- watchResult
-		}
-
-		// store latest
-		w.latest.Store(watchResult)
-
-		// log latest revision
-		log.Debugf("Watcher noticed updated file: %s/%s%s, rev=%v",
-			w.projectName, w.repoName, w.pathPattern, watchResult.Revision)
-
-		// notify listener
-		w.notifyListeners()
+		w.update(watchResult)
 	}
 
 	// wait for next attempt
@@ -421,13 +688,82 @@ func (w *Watcher) doWatch() {
 	w.delay()
 }
 
+// update stores the result as the latest and notifies the listeners.
+func (w *Watcher) update(watchResult *WatchResult) {
+	// converting watch result and feed back to initial value channel if needed
+	if atomic.CompareAndSwapInt32(&w.isInitialValueChSet, 0, 1) {
+		// The initial latest is set for the first time. So write the value to initialValueCh as well.
+		w.initialValueCh <- watchResult
+	}
+
+	// store latest
+	w.latest.Store(watchResult)
+
+	// log latest revision
+	w.logger.Debugf("Watcher noticed updated file: %s/%s%s, rev=%v",
+		w.projectName, w.repoName, w.pathPattern, watchResult.Revision)
+
+	// notify listener
+	w.notifyListeners()
+}
+
+// Map returns a Watcher whose listeners receive the value mapped from the Entry of this Watcher by the mapper
+// in the Value of the WatchResult. The listeners are notified only when the mapped value changes. If the mapper
+// returns an error, the entry is ignored. The returned Watcher is closed when this Watcher is closed, but closing
+// the returned Watcher does not close this Watcher; it only stops mapping the entries of this Watcher. For example:
+//
+//	mapped := watcher.Map(func(entry Entry) (interface{}, error) {
+//	    var config MyConfig
+//	    err := entry.UnmarshalTo(&config)
+//	    return config, err
+//	})
+//	mapped.Watch(func(result WatchResult) {
+//	    config := result.Value.(MyConfig)
+//	    ...
+//	})
+func (w *Watcher) Map(mapper func(entry Entry) (interface{}, error)) *Watcher {
+	mapped := newWatcher(w.watchCTX, w.projectName, w.repoName, w.pathPattern)
+	mapped.state = started // The mapped watcher is driven by this watcher.
+	mapped.logger = w.logger
+	mapped.codec = w.codec
+
+	unwatch, err := w.watch(func(result WatchResult) {
+		if result.Err != nil { // EntryRemovedEvent
+			mapped.update(&result)
+			return
+		}
+		value, err := mapper(result.Entry)
+		if err != nil {
+			mapped.logger.Warnf("Watcher failed to map: %s/%s%s, rev=%v, err=%v",
+				w.projectName, w.repoName, w.pathPattern, result.Revision, err)
+			return
+		}
+		if latest := mapped.getLatest(); latest != nil && reflect.DeepEqual(latest.Value, value) {
+			return
+		}
+		result.Value = value
+		mapped.update(&result)
+	})
+	if err != nil {
+		mapped.Close()
+		return mapped
+	}
+	go func() {
+		<-mapped.watchCTX.Done() // closed by itself or with this watcher
+		mapped.Close()
+		unwatch() // Stops mapping the entries of this watcher.
+	}()
+	return mapped
+}
+
 func (w *Watcher) delay() {
 	var delay time.Duration
 
 	if w.numAttemptsSoFar == 0 {
 		delay = delayOnSuccess
 	} else {
-		delay = nextDelay(w.numAttemptsSoFar)
+		delay = w.backoff.NextDelay(w.numAttemptsSoFar)
+		w.logger.Debugf("Watcher reconnects in %v: %s/%s%s", delay, w.projectName, w.repoName, w.pathPattern)
 	}
 
 	if delay > 0 {
@@ -459,18 +795,26 @@ func (w *Watcher) notifyListeners() {
 	}
 }
 
-func (w *Watcher) notifier(listener WatchListener, ch <-chan *WatchResult) {
+func (w *Watcher) notifier(listener WatchListener, ch <-chan *WatchResult, done <-chan struct{}) {
 	for {
 		select {
 		case <-w.watchCTX.Done():
 			return
 
+		case <-done: // the listener is unregistered
+			return
+
 		case latest, ok := <-ch:
 			if !ok { // channel is closed
 				return
 			}
 
-			if latest != nil {
+			select {
+			case <-done: // unregistered while the result was pending
+				return
+			default:
+			}
+			if latest != nil && !w.isStopped() {
 				listener(*latest)
 			}
 		}
@@ -21,6 +21,7 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 )
@@ -341,3 +342,317 @@ func TestRepoWatcherInvalidPathPattern(t *testing.T) {
 		close(myCh)
 	}
 }
+
+func TestWatchPaths(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	wantPattern := "/a/*.json,/**/b.yaml"
+	revision := 1
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/projects/foo/repos/bar/contents"+wantPattern {
+			t.Errorf("path: %v, want %v", r.URL.Path, "/api/v1/projects/foo/repos/bar/contents"+wantPattern)
+		}
+		time.Sleep(100 * time.Millisecond)
+		revision++
+		fmt.Fprint(w, `{"revision":`+strconv.Itoa(revision)+`}`)
+	})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/compare", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testURLQuery(t, r, "pathPattern", wantPattern)
+		to := r.URL.Query().Get("to")
+		fmt.Fprint(w, `[{"path":"/a/`+to+`.json", "type":"UPSERT_JSON", "content":{"a":`+to+`}}]`)
+	})
+
+	changes, closer, err := c.WatchPaths(context.Background(), "foo", "bar",
+		[]string{"/a/*.json", "b.yaml"}, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer closer()
+
+	for want := 2; want < 4; want++ {
+		select {
+		case result := <-changes:
+			if result.Revision != int64(want) {
+				t.Errorf("watch returned: %v, want %v", result.Revision, want)
+			}
+			wantChanges := []*Change{{Path: "/a/" + strconv.Itoa(want) + ".json", Type: UpsertJSON,
+				Content: map[string]interface{}{"a": float64(want)}}}
+			if !reflect.DeepEqual(result.Changes, wantChanges) {
+				t.Errorf("changes: %+v, want %+v", result.Changes, wantChanges)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatal("failed to watch")
+		}
+	}
+}
+
+func TestWatcher_AwaitInitialValueWithContext(t *testing.T) {
+	c, _, teardown := setup()
+	defer teardown()
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	fw, _ := c.watch.fileWatcher(context.Background(), "foo", "bar", query)
+	defer fw.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	latest := fw.AwaitInitialValueWithContext(ctx)
+	want := "failed to get the initial value: context deadline exceeded"
+	if latest.Err == nil || latest.Err.Error() != want {
+		t.Errorf("latest from AwaitInitialValueWithContext: %+v, want %+v", latest.Err, want)
+	}
+}
+
+func TestWatchRepositoryOnce(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	notModifiedResponse := true
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/**", func(w http.ResponseWriter, r *http.Request) {
+		testMethod(t, r, http.MethodGet)
+		testHeader(t, r, "if-none-match", "2")
+		testHeader(t, r, "prefer", "wait=1")
+		if notModifiedResponse {
+			w.WriteHeader(http.StatusNotModified)
+			notModifiedResponse = false
+		} else {
+			fmt.Fprint(w, `{"revision":3}`)
+		}
+	})
+
+	revision, httpStatusCode, err := c.WatchRepositoryOnce(context.Background(), "foo", "bar", "/**", 2, time.Second)
+	if revision != 2 || err != nil {
+		t.Errorf("WatchRepositoryOnce returned %v, %v, want 2, nil", revision, err)
+	}
+	testStatusCode(t, httpStatusCode, http.StatusNotModified)
+
+	revision, _, err = c.WatchRepositoryOnce(context.Background(), "foo", "bar", "/**", 2, time.Second)
+	if revision != 3 || err != nil {
+		t.Errorf("WatchRepositoryOnce returned %v, %v, want 3, nil", revision, err)
+	}
+}
+
+func TestWatcher_Map(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	revision := 1
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+		revision++
+		// "a" is changed only at every other revision.
+		fmt.Fprintf(w, `{"revision":%d, "entry":{"path":"/a.json", "type":"JSON", "content": {"a":%d, "b":%d}}}`,
+			revision, revision/2, revision)
+	})
+
+	fw, _ := c.FileWatcher("foo", "bar", &Query{Path: "/a.json", Type: Identity})
+	defer fw.Close()
+	mapped := fw.Map(func(entry Entry) (interface{}, error) {
+		var value struct {
+			A int `json:"a"`
+		}
+		err := entry.UnmarshalTo(&value)
+		return value.A, err
+	})
+
+	myCh := make(chan WatchResult, 128)
+	_ = mapped.Watch(func(value WatchResult) { myCh <- value })
+
+	for want := 1; want <= 3; want++ {
+		select {
+		case value := <-myCh:
+			if value.Value != want {
+				t.Errorf("mapped value: %v, want %v", value.Value, want)
+			}
+		case <-time.After(3 * time.Second):
+			t.Fatal("failed to watch")
+		}
+	}
+
+	// The mapped watcher is closed with the parent.
+	fw.Close()
+	if result := mapped.AwaitInitialValue(); result.Err != nil {
+		t.Errorf("AwaitInitialValue returned error: %v", result.Err)
+	}
+	deadline := time.Now().Add(3 * time.Second)
+	for !mapped.isStopped() {
+		if time.Now().After(deadline) {
+			t.Fatal("the mapped watcher is not closed")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestWatcher_MapClose(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	revision := 1
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(20 * time.Millisecond)
+		revision++
+		fmt.Fprintf(w, `{"revision":%d, "entry":{"path":"/a.json", "type":"JSON", "content": {"a":%d}}}`,
+			revision, revision)
+	})
+
+	fw, _ := c.FileWatcher("foo", "bar", &Query{Path: "/a.json", Type: Identity})
+	defer fw.Close()
+	var calls int32
+	mapped := fw.Map(func(entry Entry) (interface{}, error) {
+		atomic.AddInt32(&calls, 1)
+		return entry.Revision, nil
+	})
+	if result := mapped.AwaitInitialValueWith(3 * time.Second); result.Err != nil {
+		t.Fatalf("AwaitInitialValue returned error: %v", result.Err)
+	}
+
+	// Closing the mapped watcher unregisters its listener from the parent.
+	if err := mapped.CloseAndWait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	deadline := time.Now().Add(3 * time.Second)
+	for {
+		chans, _ := fw.updateListenerChans.Load().([]chan *WatchResult)
+		if len(chans) == 0 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the listener of the mapped watcher is not unregistered")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	before := atomic.LoadInt32(&calls)
+	time.Sleep(200 * time.Millisecond) // The parent keeps receiving new revisions.
+	if after := atomic.LoadInt32(&calls); after != before {
+		t.Errorf("mapper calls after close: %v, want %v", after, before)
+	}
+	if fw.isStopped() {
+		t.Error("the parent watcher is closed with the mapped watcher")
+	}
+}
+
+func TestWatcher_CloseAndWait(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, response)
+	})
+
+	fw, _ := c.FileWatcher("foo", "bar", &Query{Path: "/a.json", Type: Identity})
+	invoked := make(chan struct{})
+	var returned int32
+	_ = fw.Watch(func(value WatchResult) {
+		close(invoked)
+		time.Sleep(200 * time.Millisecond)
+		atomic.StoreInt32(&returned, 1)
+	})
+	<-invoked
+
+	// The listener is still running.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := fw.CloseAndWait(ctx); err != context.DeadlineExceeded {
+		t.Errorf("CloseAndWait returned %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	if err := fw.CloseAndWait(context.Background()); err != nil {
+		t.Fatalf("CloseAndWait returned error: %v", err)
+	}
+	if atomic.LoadInt32(&returned) != 1 {
+		t.Errorf("CloseAndWait returned before the listener returns")
+	}
+	if err := fw.Watch(func(value WatchResult) {}); err != ErrWatcherClosed {
+		t.Errorf("Watch returned %v, want %v", err, ErrWatcherClosed)
+	}
+}
+
+func TestWatcher_WithNotifyEntryNotFound(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	var requests int32
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		switch atomic.AddInt32(&requests, 1) {
+		case 1:
+			fmt.Fprint(w, response)
+		case 2:
+			testHeader(t, r, "prefer", "wait=60, notify-entry-not-found=true")
+			testHeader(t, r, "if-none-match", "3")
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"exception":"com.linecorp.centraldogma.common.EntryNotFoundException"}`)
+		case 3:
+			// Waits until the file is added back.
+			testHeader(t, r, "prefer", "wait=60")
+			testHeader(t, r, "if-none-match", "3")
+			fmt.Fprint(w, `{"revision":5, "entry":{"path":"/a.json", "type":"JSON", "content": {"a":"c"}}}`)
+		default:
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusNotModified)
+		}
+	})
+
+	fw, _ := c.FileWatcher("foo", "bar", &Query{Path: "/a.json", Type: Identity}, WithNotifyEntryNotFound())
+	defer fw.Close()
+	myCh := make(chan WatchResult, 128)
+	_ = fw.Watch(func(value WatchResult) { myCh <- value })
+
+	expect := func(check func(result WatchResult)) {
+		select {
+		case result := <-myCh:
+			check(result)
+		case <-time.After(5 * time.Second):
+			t.Fatal("failed to watch")
+		}
+	}
+	expect(func(result WatchResult) {
+		if result.Err != nil || result.Revision != 3 {
+			t.Errorf("watch returned %+v, want the revision 3", result)
+		}
+	})
+	expect(func(result WatchResult) {
+		if removed, ok := result.Err.(*EntryRemovedEvent); !ok || removed.Path != "/a.json" {
+			t.Errorf("watch returned %+v, want EntryRemovedEvent", result)
+		}
+	})
+	expect(func(result WatchResult) {
+		if result.Err != nil || result.Revision != 5 {
+			t.Errorf("watch returned %+v, want the revision 5", result)
+		}
+	})
+}
+
+func TestWatcher_InitialValue(t *testing.T) {
+	c, mux, teardown := setup()
+	defer teardown()
+
+	available := make(chan struct{})
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-available:
+			fmt.Fprint(w, response)
+		case <-r.Context().Done():
+		}
+	})
+
+	fw, _ := c.FileWatcher("foo", "bar", &Query{Path: "/a.json", Type: Identity})
+	defer fw.Close()
+
+	// The server does not respond in time.
+	fallback := Entry{Path: "/a.json", Type: JSON, Content: EntryContent(`{"a":"default"}`)}
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	result := fw.InitialValue(ctx, fallback)
+	if result.Err != nil || result.Revision != 0 || !reflect.DeepEqual(result.Entry, fallback) {
+		t.Errorf("InitialValue returned %+v, want the fallback", result)
+	}
+
+	close(available)
+	result = fw.InitialValue(context.Background(), fallback)
+	if result.Err != nil || result.Revision != 3 {
+		t.Errorf("InitialValue returned %+v, want the revision 3", result)
+	}
+}
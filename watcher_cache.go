@@ -0,0 +1,135 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// watcherCache persists the latest value of a file watcher to a file, so that a restarted process can start
+// with the last known value while the watcher reconnects to the server.
+type watcherCache struct {
+	filename    string
+	projectName string
+	repoName    string
+	path        string
+	logger      Logger
+}
+
+// cachedWatchResult is the content of the cache file. The content of the entry is encoded in base64 so that
+// both JSON and text contents are restored as they are.
+type cachedWatchResult struct {
+	ProjectName string `json:"projectName"`
+	RepoName    string `json:"repoName"`
+	Path        string `json:"path"`
+	Revision    int64  `json:"revision"`
+	Type        string `json:"type"`
+	Content     []byte `json:"content"`
+}
+
+func newWatcherCache(dir, projectName, repoName string, query *Query, logger Logger) *watcherCache {
+	// The JSON path expressions are a part of the key because they change the value.
+	key := strings.Join(append([]string{projectName, repoName, query.Path}, query.Expressions...), "\n")
+	hash := sha256.Sum256([]byte(key))
+	return &watcherCache{
+		filename:    filepath.Join(dir, hex.EncodeToString(hash[:16])+".json"),
+		projectName: projectName,
+		repoName:    repoName,
+		path:        query.Path,
+		logger:      logger,
+	}
+}
+
+// load returns the cached result or nil if there is no valid cache.
+func (c *watcherCache) load() *WatchResult {
+	content, err := ioutil.ReadFile(c.filename)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			c.logger.Warnf("Failed to read the watcher cache: %s, err=%v", c.filename, err)
+		}
+		return nil
+	}
+
+	var cached cachedWatchResult
+	if err = json.Unmarshal(content, &cached); err != nil {
+		c.logger.Warnf("Failed to decode the watcher cache: %s, err=%v", c.filename, err)
+		return nil
+	}
+	if cached.ProjectName != c.projectName || cached.RepoName != c.repoName || cached.Path != c.path {
+		return nil // hash collision
+	}
+	return &WatchResult{
+		Revision: cached.Revision,
+		Entry: Entry{
+			Path:     cached.Path,
+			Type:     entryTypeMap[cached.Type],
+			Content:  EntryContent(cached.Content),
+			Revision: cached.Revision,
+		},
+	}
+}
+
+// store writes the result to the cache file atomically by renaming a temporary file.
+func (c *watcherCache) store(result *WatchResult) {
+	content, err := json.Marshal(&cachedWatchResult{
+		ProjectName: c.projectName,
+		RepoName:    c.repoName,
+		Path:        c.path,
+		Revision:    result.Revision,
+		Type:        result.Entry.Type.String(),
+		Content:     result.Entry.Content,
+	})
+	if err == nil {
+		err = writeFileAtomically(c.filename, content)
+	}
+	if err != nil {
+		c.logger.Warnf("Failed to write the watcher cache: %s, err=%v", c.filename, err)
+	}
+}
+
+func (c *watcherCache) remove() {
+	if err := os.Remove(c.filename); err != nil && !os.IsNotExist(err) {
+		c.logger.Warnf("Failed to remove the watcher cache: %s, err=%v", c.filename, err)
+	}
+}
+
+func writeFileAtomically(filename string, content []byte) error {
+	dir := filepath.Dir(filename)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(dir, ".tmp-"+filepath.Base(filename)+"-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name()) // no-op after the rename succeeds
+
+	if _, err = tmp.Write(content); err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filename)
+}
@@ -0,0 +1,91 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestWithWatcherCacheDir(t *testing.T) {
+	dir, err := ioutil.TempDir("", "watcher-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	var unavailable int32
+	lastKnownRevisions := make(chan string, 128)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/projects/foo/repos/bar/contents/a.json", func(w http.ResponseWriter, r *http.Request) {
+		lastKnownRevisions <- r.Header.Get("if-none-match")
+		if atomic.LoadInt32(&unavailable) == 0 {
+			fmt.Fprint(w, response)
+			return
+		}
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	query := &Query{Path: "/a.json", Type: Identity}
+	c, err := NewClient(server.URL, WithTransport(http.DefaultTransport), WithWatcherCacheDir(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw, _ := c.FileWatcher("foo", "bar", query)
+	if result := fw.AwaitInitialValueWith(3 * time.Second); result.Err != nil {
+		t.Fatal(result.Err)
+	}
+	cache := newWatcherCache(dir, "foo", "bar", query, log)
+	if err = fw.CloseAndWait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if cache.load() == nil {
+		t.Fatal("the value is not cached")
+	}
+
+	// The server is down when the process restarts.
+	atomic.StoreInt32(&unavailable, 1)
+	for len(lastKnownRevisions) > 0 {
+		<-lastKnownRevisions
+	}
+	c, err = NewClient(server.URL, WithTransport(http.DefaultTransport), WithWatcherCacheDir(dir))
+	if err != nil {
+		t.Fatal(err)
+	}
+	fw, _ = c.FileWatcher("foo", "bar", query)
+	defer fw.Close()
+
+	latest := fw.Latest()
+	if latest.Err != nil || latest.Revision != 3 || string(latest.Entry.Content) != `{"a":"b"}` ||
+		latest.Entry.Type != JSON {
+		t.Errorf("Latest returned %+v, want the cached value", latest)
+	}
+	// The watcher resumes from the cached revision.
+	select {
+	case revision := <-lastKnownRevisions:
+		testString(t, revision, "3", "if-none-match")
+	case <-time.After(3 * time.Second):
+		t.Error("the watcher did not reconnect")
+	}
+}
@@ -0,0 +1,238 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// NewUpsertYAML returns a Change which adds or replaces the JSON file at the path with the YAML content
+// converted into JSON. The order of the keys in the YAML mappings is preserved. The content can be converted
+// back into YAML using Entry.YAML.
+func NewUpsertYAML(path string, yamlContent []byte) (*Change, error) {
+	if err := validateJSONPath(path); err != nil {
+		return nil, err
+	}
+	content, err := yamlToJSON(yamlContent)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert the YAML content into JSON (path: %v): %v", path, err)
+	}
+	return NewUpsertJSON(path, content)
+}
+
+// YAML returns the content of the JSON entry converted into YAML. The order of the keys in the JSON objects is
+// preserved. It fails if the entry is not a JSON entry.
+func (c *Entry) YAML() ([]byte, error) {
+	raw, err := c.RawJSON()
+	if err != nil {
+		return nil, err
+	}
+	return jsonToYAML(raw)
+}
+
+func yamlToJSON(yamlContent []byte) (json.RawMessage, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(yamlContent, &doc); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if len(doc.Content) == 0 {
+		// an empty document
+		buf.WriteString("null")
+	} else if err := writeYAMLNodeAsJSON(&buf, doc.Content[0]); err != nil {
+		return nil, err
+	}
+	return json.RawMessage(buf.Bytes()), nil
+}
+
+func writeYAMLNodeAsJSON(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return writeYAMLNodeAsJSON(buf, node.Alias)
+
+	case yaml.MappingNode:
+		buf.WriteByte('{')
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Kind == yaml.AliasNode {
+				key = key.Alias
+			}
+			if key.Kind != yaml.ScalarNode {
+				return fmt.Errorf("unsupported non-scalar key at line %d", key.Line)
+			}
+			if key.ShortTag() == "!!merge" {
+				return fmt.Errorf("unsupported merge key at line %d", key.Line)
+			}
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeJSONString(buf, key.Value); err != nil {
+				return err
+			}
+			buf.WriteByte(':')
+			if err := writeYAMLNodeAsJSON(buf, value); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte('}')
+		return nil
+
+	case yaml.SequenceNode:
+		buf.WriteByte('[')
+		for i, element := range node.Content {
+			if i > 0 {
+				buf.WriteByte(',')
+			}
+			if err := writeYAMLNodeAsJSON(buf, element); err != nil {
+				return err
+			}
+		}
+		buf.WriteByte(']')
+		return nil
+
+	case yaml.ScalarNode:
+		return writeYAMLScalarAsJSON(buf, node)
+
+	default:
+		return fmt.Errorf("unsupported YAML node at line %d", node.Line)
+	}
+}
+
+func writeYAMLScalarAsJSON(buf *bytes.Buffer, node *yaml.Node) error {
+	switch node.ShortTag() {
+	case "!!null":
+		buf.WriteString("null")
+	case "!!bool":
+		var b bool
+		if err := node.Decode(&b); err != nil {
+			return err
+		}
+		buf.WriteString(strconv.FormatBool(b))
+	case "!!int":
+		var i int64
+		if err := node.Decode(&i); err != nil {
+			return err
+		}
+		buf.WriteString(strconv.FormatInt(i, 10))
+	case "!!float":
+		var f float64
+		if err := node.Decode(&f); err != nil {
+			return err
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return fmt.Errorf("unsupported float value %q at line %d", node.Value, node.Line)
+		}
+		buf.WriteString(strconv.FormatFloat(f, 'g', -1, 64))
+	default:
+		// strings, timestamps and binaries are converted into JSON strings as they are.
+		return writeJSONString(buf, node.Value)
+	}
+	return nil
+}
+
+func writeJSONString(buf *bytes.Buffer, s string) error {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	buf.Write(b)
+	return nil
+}
+
+func jsonToYAML(raw json.RawMessage) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	dec.UseNumber()
+	node, err := readJSONAsYAMLNode(dec)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err = enc.Encode(node); err != nil {
+		return nil, err
+	}
+	if err = enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func readJSONAsYAMLNode(dec *json.Decoder) (*yaml.Node, error) {
+	token, err := dec.Token()
+	if err != nil {
+		if err == io.EOF {
+			err = io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	switch v := token.(type) {
+	case json.Delim:
+		switch v {
+		case '{':
+			node := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			for dec.More() {
+				key, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := readJSONAsYAMLNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content,
+					&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key.(string)}, value)
+			}
+			_, err = dec.Token() // '}'
+			return node, err
+		case '[':
+			node := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			for dec.More() {
+				element, err := readJSONAsYAMLNode(dec)
+				if err != nil {
+					return nil, err
+				}
+				node.Content = append(node.Content, element)
+			}
+			_, err = dec.Token() // ']'
+			return node, err
+		default:
+			return nil, fmt.Errorf("unexpected JSON delimiter: %v", v)
+		}
+	case string:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}, nil
+	case json.Number:
+		tag := "!!int"
+		if strings.ContainsAny(v.String(), ".eE") {
+			tag = "!!float"
+		}
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: tag, Value: v.String()}, nil
+	case bool:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(v)}, nil
+	default:
+		return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"}, nil
+	}
+}
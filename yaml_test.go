@@ -0,0 +1,88 @@
+// Copyright 2026 LINE Corporation
+//
+// LINE Corporation licenses this file to you under the Apache License,
+// version 2.0 (the "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at:
+//
+//   https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package centraldogma
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestNewUpsertYAML(t *testing.T) {
+	yamlContent := `
+zoo: 1
+apple:
+  - a
+  - "true"
+  - 1.5
+defaults: &defaults
+  enabled: yes
+  timeout: null
+alias: *defaults
+date: 2026-01-01
+`
+	change, err := NewUpsertYAML("/a.json", []byte(yamlContent))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"zoo":1,"apple":["a","true",1.5],"defaults":{"enabled":"yes","timeout":null},` +
+		`"alias":{"enabled":"yes","timeout":null},"date":"2026-01-01"}`
+	if got := string(change.Content.(json.RawMessage)); got != want {
+		t.Errorf("content: %v, want %v", got, want)
+	}
+	if change.Type != UpsertJSON {
+		t.Errorf("type: %v, want %v", change.Type, UpsertJSON)
+	}
+
+	if _, err = NewUpsertYAML("/a.yaml", []byte(yamlContent)); err == nil {
+		t.Error("NewUpsertYAML should fail for a non-JSON path")
+	}
+	if _, err = NewUpsertYAML("/a.json", []byte("a: [")); err == nil {
+		t.Error("NewUpsertYAML should fail for an invalid YAML")
+	}
+}
+
+func TestEntry_YAML(t *testing.T) {
+	entry := &Entry{Path: "/a.json", Type: JSON,
+		Content: EntryContent(`{"zoo":1,"apple":["a","true",1.5],"nested":{"b":null,"a":false}}`)}
+	got, err := entry.YAML()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `zoo: 1
+apple:
+  - a
+  - "true"
+  - 1.5
+nested:
+  b: null
+  a: false
+`
+	if string(got) != want {
+		t.Errorf("YAML returned %q, want %q", got, want)
+	}
+
+	// The conversion should be reversible.
+	change, err := NewUpsertYAML("/a.json", got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(change.Content.(json.RawMessage)) != string(entry.Content) {
+		t.Errorf("content: %s, want %s", change.Content, entry.Content)
+	}
+
+	if _, err = (&Entry{Path: "/a.txt", Type: Text}).YAML(); err == nil {
+		t.Error("YAML should fail for a text entry")
+	}
+}